@@ -12,6 +12,7 @@ import (
 	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/krusoe"
 	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/lightbits"
 	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/purestorage"
+	"gitlab.com/crusoeenergy/island/storage/storms/pkg/redact"
 )
 
 var errUnsupportedVendor = errors.New("unsupported vendor")
@@ -21,7 +22,14 @@ type Client interface {
 	GetVolume(ctx context.Context, req *models.GetVolumeRequest) (*models.GetVolumeResponse, error)
 	GetVolumes(ctx context.Context, req *models.GetVolumesRequest) (*models.GetVolumesResponse, error)
 	CreateVolume(ctx context.Context, req *models.CreateVolumeRequest) (*models.CreateVolumeResponse, error)
+	// CloneVolume creates a volume with the contents of another volume on the same cluster, leaving no
+	// intermediate resources behind.
+	CloneVolume(ctx context.Context, req *models.CloneVolumeRequest) (*models.CloneVolumeResponse, error)
 	ResizeVolume(ctx context.Context, req *models.ResizeVolumeRequest) (*models.ResizeVolumeResponse, error)
+	// RevertVolumeToSnapshot overwrites a volume in place with the contents of one of its snapshots. It fails with
+	// models.ErrFailedPrecondition if the snapshot is not of the volume or the volume is attached.
+	RevertVolumeToSnapshot(ctx context.Context, req *models.RevertVolumeToSnapshotRequest,
+	) (*models.RevertVolumeToSnapshotResponse, error)
 	DeleteVolume(ctx context.Context, req *models.DeleteVolumeRequest) (*models.DeleteVolumeResponse, error)
 	AttachVolume(ctx context.Context, req *models.AttachVolumeRequest) (*models.AttachVolumeResponse, error)
 	DetachVolume(ctx context.Context, req *models.DetachVolumeRequest) (*models.DetachVolumeResponse, error)
@@ -31,8 +39,35 @@ type Client interface {
 	GetSnapshots(ctx context.Context, req *models.GetSnapshotsRequest) (*models.GetSnapshotsResponse, error)
 	CreateSnapshot(ctx context.Context, req *models.CreateSnapshotRequest) (*models.CreateSnapshotResponse, error)
 	DeleteSnapshot(ctx context.Context, req *models.DeleteSnapshotRequest) (*models.DeleteSnapshotResponse, error)
+
+	// Cluster operations
+	GetCapacity(ctx context.Context, req *models.GetCapacityRequest) (*models.GetCapacityResponse, error)
+}
+
+// SnapshotCopier is implemented by clients that can copy snapshots to other clusters of the same vendor. Copies run
+// as workflows on the backend.
+type SnapshotCopier interface {
+	// CopyTarget returns how other clusters address this one as the destination of a copy.
+	CopyTarget(ctx context.Context) (*models.CopyTarget, error)
+	CopySnapshotToVolume(ctx context.Context, req *models.CopySnapshotRequest) (*models.CopySnapshotResponse, error)
+	CopySnapshotToSnapshot(ctx context.Context, req *models.CopySnapshotRequest) (*models.CopySnapshotResponse, error)
+	GetWorkflow(ctx context.Context, req *models.GetWorkflowRequest) (*models.GetWorkflowResponse, error)
+}
+
+var _ SnapshotCopier = (*lightbits.ClientAdapter)(nil)
+
+// Labeler is implemented by clients that can store user-defined labels on volumes and snapshots, such as tags on the
+// vendor object. Labels of resources on other clusters are stored by StorMS.
+type Labeler interface {
+	GetLabels(ctx context.Context, req *models.GetLabelsRequest) (*models.GetLabelsResponse, error)
+	SetLabels(ctx context.Context, req *models.SetLabelsRequest) (*models.SetLabelsResponse, error)
 }
 
+var (
+	_ Labeler = (*krusoe.Client)(nil)
+	_ Labeler = (*purestorage.Client)(nil)
+)
+
 //nolint:cyclop // multipliex function
 func NewClient(vendor string, cfg map[string]interface{}) (Client, error) {
 	cfgBytes, err := yaml.Marshal(cfg)
@@ -47,7 +82,7 @@ func NewClient(vendor string, cfg map[string]interface{}) (Client, error) {
 			return nil, fmt.Errorf("failed to parse Krusoe config: %w", err)
 		}
 
-		log.Info().Msgf("Creating new Lightbits client: %#v", cfg)
+		log.Info().Msgf("Creating new Lightbits client: %#v", redact.Struct(cfg))
 
 		clientAdapter, err := lightbits.NewClientAdapter(&cfg)
 		if err != nil {
@@ -61,7 +96,7 @@ func NewClient(vendor string, cfg map[string]interface{}) (Client, error) {
 		if err := purestorage.ParseConfig(cfgBytes, &cfg); err != nil {
 			return nil, fmt.Errorf("failed to parse PureStorage config: %w", err)
 		}
-		log.Info().Msgf("Creating new PureStorage client: %#v", cfg)
+		log.Info().Msgf("Creating new PureStorage client: %#v", redact.Struct(cfg))
 
 		client, err := purestorage.NewClient(&cfg)
 		if err != nil {
@@ -18,8 +18,12 @@ type MockClient struct {
 	) (*models.GetVolumesResponse, error)
 	MockCreateVolume func(ctx context.Context, req *models.CreateVolumeRequest,
 	) (*models.CreateVolumeResponse, error)
+	MockCloneVolume func(ctx context.Context, req *models.CloneVolumeRequest,
+	) (*models.CloneVolumeResponse, error)
 	MockResizeVolume func(ctx context.Context, req *models.ResizeVolumeRequest,
 	) (*models.ResizeVolumeResponse, error)
+	MockRevertVolumeToSnapshot func(ctx context.Context, req *models.RevertVolumeToSnapshotRequest,
+	) (*models.RevertVolumeToSnapshotResponse, error)
 	MockDeleteVolume func(ctx context.Context, req *models.DeleteVolumeRequest,
 	) (*models.DeleteVolumeResponse, error)
 	MockAttachVolume func(ctx context.Context, req *models.AttachVolumeRequest,
@@ -34,6 +38,8 @@ type MockClient struct {
 	) (*models.CreateSnapshotResponse, error)
 	MockDeleteSnapshot func(ctx context.Context, req *models.DeleteSnapshotRequest,
 	) (*models.DeleteSnapshotResponse, error)
+	MockGetCapacity func(ctx context.Context, req *models.GetCapacityRequest,
+	) (*models.GetCapacityResponse, error)
 }
 
 func (m *MockClient) GetVolume(
@@ -54,12 +60,24 @@ func (m *MockClient) CreateVolume(
 	return m.MockCreateVolume(ctx, req)
 }
 
+func (m *MockClient) CloneVolume(
+	ctx context.Context, req *models.CloneVolumeRequest,
+) (*models.CloneVolumeResponse, error) {
+	return m.MockCloneVolume(ctx, req)
+}
+
 func (m *MockClient) ResizeVolume(
 	ctx context.Context, req *models.ResizeVolumeRequest,
 ) (*models.ResizeVolumeResponse, error) {
 	return m.MockResizeVolume(ctx, req)
 }
 
+func (m *MockClient) RevertVolumeToSnapshot(
+	ctx context.Context, req *models.RevertVolumeToSnapshotRequest,
+) (*models.RevertVolumeToSnapshotResponse, error) {
+	return m.MockRevertVolumeToSnapshot(ctx, req)
+}
+
 func (m *MockClient) DeleteVolume(
 	ctx context.Context, req *models.DeleteVolumeRequest,
 ) (*models.DeleteVolumeResponse, error) {
@@ -101,3 +119,9 @@ func (m *MockClient) DeleteSnapshot(
 ) (*models.DeleteSnapshotResponse, error) {
 	return m.MockDeleteSnapshot(ctx, req)
 }
+
+func (m *MockClient) GetCapacity(
+	ctx context.Context, req *models.GetCapacityRequest,
+) (*models.GetCapacityResponse, error) {
+	return m.MockGetCapacity(ctx, req)
+}
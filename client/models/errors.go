@@ -0,0 +1,48 @@
+package models
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Errors returned by clients, so that callers can tell failures apart regardless of vendor. Clients wrap them with
+// vendor detail, so check for them with errors.Is.
+var (
+	// The resource does not exist on the cluster.
+	ErrNotFound = errors.New("not found")
+	// A resource with the same name already exists on the cluster.
+	ErrAlreadyExists = errors.New("already exists")
+	// The request is malformed or unsupported by the vendor.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// The resource is not in a state that allows the operation, e.g. deleting an attached volume.
+	ErrFailedPrecondition = errors.New("failed precondition")
+	// The cluster is out of capacity or another quota.
+	ErrResourceExhausted = errors.New("resource exhausted")
+	// The cluster could not be reached or is temporarily unable to serve; the request may be retried.
+	ErrUnavailable = errors.New("unavailable")
+	// The cluster rejected the credentials of the client.
+	ErrUnauthenticated = errors.New("unauthenticated")
+)
+
+// ErrorFromHTTPStatus returns the error matching an HTTP status code returned by a vendor API, or nil if the status
+// code has no specific meaning.
+func ErrorFromHTTPStatus(code int) error {
+	switch code {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrAlreadyExists
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrInvalidArgument
+	case http.StatusPreconditionFailed, http.StatusLocked:
+		return ErrFailedPrecondition
+	case http.StatusInsufficientStorage, http.StatusRequestEntityTooLarge:
+		return ErrResourceExhausted
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return ErrUnavailable
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthenticated
+	default:
+		return nil
+	}
+}
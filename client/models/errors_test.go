@@ -0,0 +1,33 @@
+package models
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ErrorFromHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code   int
+		expect error
+	}{
+		{code: http.StatusNotFound, expect: ErrNotFound},
+		{code: http.StatusConflict, expect: ErrAlreadyExists},
+		{code: http.StatusBadRequest, expect: ErrInvalidArgument},
+		{code: http.StatusUnprocessableEntity, expect: ErrInvalidArgument},
+		{code: http.StatusPreconditionFailed, expect: ErrFailedPrecondition},
+		{code: http.StatusInsufficientStorage, expect: ErrResourceExhausted},
+		{code: http.StatusServiceUnavailable, expect: ErrUnavailable},
+		{code: http.StatusTooManyRequests, expect: ErrUnavailable},
+		{code: http.StatusUnauthorized, expect: ErrUnauthenticated},
+		{code: http.StatusForbidden, expect: ErrUnauthenticated},
+		{code: http.StatusInternalServerError, expect: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.code), func(t *testing.T) {
+			require.Equal(t, tt.expect, ErrorFromHTTPStatus(tt.code))
+		})
+	}
+}
@@ -13,6 +13,7 @@ type Volume struct {
 	SectorSize         uint32
 	ACL                []string
 	IsAvailable        bool
+	IsFailed           bool
 	SourceSnapshotUUID string
 	CreatedAt          time.Time
 }
@@ -23,12 +24,69 @@ type Snapshot struct {
 	Size             uint64
 	SectorSize       uint32
 	IsAvailable      bool
+	IsFailed         bool
 	SourceVolumeUUID string
 	CreatedAt        time.Time
 }
 
+// Capacity of a storage cluster, in bytes.
+type Capacity struct {
+	TotalBytes       uint64 // Usable capacity of the cluster
+	UsedBytes        uint64 // Capacity consumed by data on the cluster
+	ProvisionedBytes uint64 // Sum of the sizes of all volumes on the cluster
+}
+
+// FreeBytes returns the capacity still available for new data.
+func (c *Capacity) FreeBytes() uint64 {
+	if c.UsedBytes >= c.TotalBytes {
+		return 0
+	}
+
+	return c.TotalBytes - c.UsedBytes
+}
+
+// Workflow is a long-running task run by the storage backend, such as a copy between clusters.
+type Workflow struct {
+	ID        string
+	State     WorkflowState
+	Message   string // Detail on the state, mainly set on failure
+	Progress  int32  // Percent complete
+	CreatedAt time.Time
+	EndedAt   time.Time
+}
+
+type WorkflowState string
+
+const (
+	WorkflowStateRunning   WorkflowState = "running"
+	WorkflowStateSucceeded WorkflowState = "succeeded"
+	WorkflowStateFailed    WorkflowState = "failed"
+	WorkflowStateCancelled WorkflowState = "cancelled"
+)
+
+// Done reports whether the workflow has stopped running.
+func (s WorkflowState) Done() bool {
+	return s != WorkflowStateRunning
+}
+
+// ResourceType tells volumes and snapshots apart in requests that apply to both.
+type ResourceType string
+
+const (
+	ResourceTypeVolume   ResourceType = "volume"
+	ResourceTypeSnapshot ResourceType = "snapshot"
+)
+
 // --- Begin requests and responses
 
+type GetCapacityRequest struct {
+	// Empty
+}
+
+type GetCapacityResponse struct {
+	Capacity *Capacity
+}
+
 type GetVolumeRequest struct {
 	UUID string
 }
@@ -75,6 +133,24 @@ type CreateVolumeResponse struct {
 	Volume *Volume
 }
 
+type CloneVolumeRequest struct {
+	UUID             string
+	SourceVolumeUUID string
+}
+
+type CloneVolumeResponse struct {
+	Volume *Volume
+}
+
+type RevertVolumeToSnapshotRequest struct {
+	UUID         string
+	SnapshotUUID string // Snapshot of the volume to revert it to
+}
+
+type RevertVolumeToSnapshotResponse struct {
+	Volume *Volume
+}
+
 type ResizeVolumeRequest struct {
 	UUID string
 	Size uint64 // Size of volume (unit: bytes)
@@ -142,3 +218,47 @@ type DeleteSnapshotRequest struct {
 type DeleteSnapshotResponse struct {
 	// Empty; ACK
 }
+
+// Identifies a cluster as the destination of a copy from another cluster of the same vendor.
+type CopyTarget struct {
+	ClusterID string // Vendor ID of the cluster
+	Project   string // Project new resources are created in, if the vendor has projects
+}
+
+// Copies a snapshot on this cluster to another cluster of the same vendor, as a new volume or snapshot.
+type CopySnapshotRequest struct {
+	SnapshotUUID         string // Source snapshot on this cluster
+	DestinationUUID      string // UUID of the volume or snapshot created on the destination cluster
+	DestinationClusterID string // Vendor ID of the destination cluster
+	DestinationProject   string // Optional; defaults to the project of the source snapshot
+}
+
+type CopySnapshotResponse struct {
+	Workflow *Workflow
+}
+
+type GetWorkflowRequest struct {
+	ID string
+}
+
+type GetWorkflowResponse struct {
+	Workflow *Workflow
+}
+
+type GetLabelsRequest struct {
+	ResourceType ResourceType
+	UUIDs        []string // Optional; defaults to every resource of the type
+}
+
+type GetLabelsResponse struct {
+	Labels map[string]map[string]string // Labels by resource UUID; resources without labels may be missing
+}
+
+// Replaces the labels of a resource.
+type SetLabelsRequest struct {
+	ResourceType ResourceType
+	UUID         string
+	Labels       map[string]string
+}
+
+type SetLabelsResponse struct{}
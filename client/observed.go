@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+)
+
+const tracerName = "gitlab.com/crusoeenergy/island/storage/storms/client"
+
+// Observer is called after every call of an observed client, with the name of the method called, how long the call
+// took and the error it returned.
+type Observer func(method string, duration time.Duration, err error)
+
+// Observe returns a client that reports every call of c to observe, and traces it in a span named after the method
+// with the given attributes. The returned client implements SnapshotCopier and Labeler if and only if c does.
+func Observe(c Client, observe Observer, attrs ...attribute.KeyValue) Client {
+	o := &observer{observe: observe, attrs: attrs}
+	oc := &observedClient{Client: c, observer: o}
+	copier, isCopier := c.(SnapshotCopier)
+	labeler, isLabeler := c.(Labeler)
+
+	switch {
+	case isCopier && isLabeler:
+		return &struct {
+			*observedClient
+			*observedCopier
+			*observedLabeler
+		}{oc, &observedCopier{copier, o}, &observedLabeler{labeler, o}}
+	case isCopier:
+		return &struct {
+			*observedClient
+			*observedCopier
+		}{oc, &observedCopier{copier, o}}
+	case isLabeler:
+		return &struct {
+			*observedClient
+			*observedLabeler
+		}{oc, &observedLabeler{labeler, o}}
+	default:
+		return oc
+	}
+}
+
+type observer struct {
+	observe Observer
+	attrs   []attribute.KeyValue
+}
+
+// Times and traces a call, and reports it.
+func observed[Req, Resp any](ctx context.Context, o *observer, method string,
+	call func(context.Context, Req) (Resp, error), req Req,
+) (Resp, error) {
+	// The global provider is looked up on every call, so that tracing may be set up after clients are created.
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "client."+method,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(o.attrs...))
+	defer span.End()
+
+	start := time.Now()
+	resp, err := call(ctx, req)
+	o.observe(method, time.Since(start), err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return resp, err
+}
+
+type observedClient struct {
+	Client
+	observer *observer
+}
+
+func (c *observedClient) GetVolume(ctx context.Context, req *models.GetVolumeRequest,
+) (*models.GetVolumeResponse, error) {
+	return observed(ctx, c.observer, "GetVolume", c.Client.GetVolume, req)
+}
+
+func (c *observedClient) GetVolumes(ctx context.Context, req *models.GetVolumesRequest,
+) (*models.GetVolumesResponse, error) {
+	return observed(ctx, c.observer, "GetVolumes", c.Client.GetVolumes, req)
+}
+
+func (c *observedClient) CreateVolume(ctx context.Context, req *models.CreateVolumeRequest,
+) (*models.CreateVolumeResponse, error) {
+	return observed(ctx, c.observer, "CreateVolume", c.Client.CreateVolume, req)
+}
+
+func (c *observedClient) CloneVolume(ctx context.Context, req *models.CloneVolumeRequest,
+) (*models.CloneVolumeResponse, error) {
+	return observed(ctx, c.observer, "CloneVolume", c.Client.CloneVolume, req)
+}
+
+func (c *observedClient) ResizeVolume(ctx context.Context, req *models.ResizeVolumeRequest,
+) (*models.ResizeVolumeResponse, error) {
+	return observed(ctx, c.observer, "ResizeVolume", c.Client.ResizeVolume, req)
+}
+
+func (c *observedClient) RevertVolumeToSnapshot(ctx context.Context, req *models.RevertVolumeToSnapshotRequest,
+) (*models.RevertVolumeToSnapshotResponse, error) {
+	return observed(ctx, c.observer, "RevertVolumeToSnapshot", c.Client.RevertVolumeToSnapshot, req)
+}
+
+func (c *observedClient) DeleteVolume(ctx context.Context, req *models.DeleteVolumeRequest,
+) (*models.DeleteVolumeResponse, error) {
+	return observed(ctx, c.observer, "DeleteVolume", c.Client.DeleteVolume, req)
+}
+
+func (c *observedClient) AttachVolume(ctx context.Context, req *models.AttachVolumeRequest,
+) (*models.AttachVolumeResponse, error) {
+	return observed(ctx, c.observer, "AttachVolume", c.Client.AttachVolume, req)
+}
+
+func (c *observedClient) DetachVolume(ctx context.Context, req *models.DetachVolumeRequest,
+) (*models.DetachVolumeResponse, error) {
+	return observed(ctx, c.observer, "DetachVolume", c.Client.DetachVolume, req)
+}
+
+func (c *observedClient) GetSnapshot(ctx context.Context, req *models.GetSnapshotRequest,
+) (*models.GetSnapshotResponse, error) {
+	return observed(ctx, c.observer, "GetSnapshot", c.Client.GetSnapshot, req)
+}
+
+func (c *observedClient) GetSnapshots(ctx context.Context, req *models.GetSnapshotsRequest,
+) (*models.GetSnapshotsResponse, error) {
+	return observed(ctx, c.observer, "GetSnapshots", c.Client.GetSnapshots, req)
+}
+
+func (c *observedClient) CreateSnapshot(ctx context.Context, req *models.CreateSnapshotRequest,
+) (*models.CreateSnapshotResponse, error) {
+	return observed(ctx, c.observer, "CreateSnapshot", c.Client.CreateSnapshot, req)
+}
+
+func (c *observedClient) DeleteSnapshot(ctx context.Context, req *models.DeleteSnapshotRequest,
+) (*models.DeleteSnapshotResponse, error) {
+	return observed(ctx, c.observer, "DeleteSnapshot", c.Client.DeleteSnapshot, req)
+}
+
+func (c *observedClient) GetCapacity(ctx context.Context, req *models.GetCapacityRequest,
+) (*models.GetCapacityResponse, error) {
+	return observed(ctx, c.observer, "GetCapacity", c.Client.GetCapacity, req)
+}
+
+type observedCopier struct {
+	copier   SnapshotCopier
+	observer *observer
+}
+
+func (c *observedCopier) CopyTarget(ctx context.Context) (*models.CopyTarget, error) {
+	// CopyTarget takes no request.
+	copyTarget := func(ctx context.Context, _ struct{}) (*models.CopyTarget, error) {
+		return c.copier.CopyTarget(ctx)
+	}
+
+	return observed(ctx, c.observer, "CopyTarget", copyTarget, struct{}{})
+}
+
+func (c *observedCopier) CopySnapshotToVolume(ctx context.Context, req *models.CopySnapshotRequest,
+) (*models.CopySnapshotResponse, error) {
+	return observed(ctx, c.observer, "CopySnapshotToVolume", c.copier.CopySnapshotToVolume, req)
+}
+
+func (c *observedCopier) CopySnapshotToSnapshot(ctx context.Context, req *models.CopySnapshotRequest,
+) (*models.CopySnapshotResponse, error) {
+	return observed(ctx, c.observer, "CopySnapshotToSnapshot", c.copier.CopySnapshotToSnapshot, req)
+}
+
+func (c *observedCopier) GetWorkflow(ctx context.Context, req *models.GetWorkflowRequest,
+) (*models.GetWorkflowResponse, error) {
+	return observed(ctx, c.observer, "GetWorkflow", c.copier.GetWorkflow, req)
+}
+
+type observedLabeler struct {
+	labeler  Labeler
+	observer *observer
+}
+
+func (c *observedLabeler) GetLabels(ctx context.Context, req *models.GetLabelsRequest,
+) (*models.GetLabelsResponse, error) {
+	return observed(ctx, c.observer, "GetLabels", c.labeler.GetLabels, req)
+}
+
+func (c *observedLabeler) SetLabels(ctx context.Context, req *models.SetLabelsRequest,
+) (*models.SetLabelsResponse, error) {
+	return observed(ctx, c.observer, "SetLabels", c.labeler.SetLabels, req)
+}
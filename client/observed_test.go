@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/krusoe"
+)
+
+var errCopyTarget = errors.New("no copy target")
+
+// A client that can only copy snapshots; every other call panics.
+type copierClient struct {
+	Client
+	SnapshotCopier
+}
+
+func (c *copierClient) CopyTarget(context.Context) (*models.CopyTarget, error) {
+	return nil, errCopyTarget
+}
+
+type observation struct {
+	method string
+	err    error
+}
+
+func Test_Observe(t *testing.T) {
+	var observations []observation
+	observe := func(method string, duration time.Duration, err error) {
+		require.GreaterOrEqual(t, duration, time.Duration(0))
+		observations = append(observations, observation{method: method, err: err})
+	}
+
+	// Krusoe stores labels but cannot copy snapshots.
+	c := Observe(krusoe.NewClient(krusoe.Config{APIKey: "krusoe"}), observe)
+	_, isCopier := c.(SnapshotCopier)
+	require.False(t, isCopier)
+	labeler, isLabeler := c.(Labeler)
+	require.True(t, isLabeler)
+
+	_, err := c.CreateVolume(context.Background(), &models.CreateVolumeRequest{
+		UUID:   "vol-1",
+		Source: &models.NewVolumeSpec{Size: 1 << 30, SectorSize: 4096},
+	})
+	require.NoError(t, err)
+	_, getErr := c.GetVolume(context.Background(), &models.GetVolumeRequest{UUID: "vol-2"})
+	require.Error(t, getErr)
+	_, err = labeler.GetLabels(context.Background(), &models.GetLabelsRequest{ResourceType: models.ResourceTypeVolume})
+	require.NoError(t, err)
+
+	copier, isCopier := Observe(&copierClient{}, observe).(SnapshotCopier)
+	require.True(t, isCopier)
+	_, err = copier.CopyTarget(context.Background())
+	require.ErrorIs(t, err, errCopyTarget)
+
+	require.Equal(t, []observation{
+		{method: "CreateVolume"},
+		{method: "GetVolume", err: getErr},
+		{method: "GetLabels"},
+		{method: "CopyTarget", err: errCopyTarget},
+	}, observations)
+}
+
+func Test_Observe_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(prev)
+
+	c := Observe(krusoe.NewClient(krusoe.Config{APIKey: "krusoe"}), func(string, time.Duration, error) {},
+		attribute.String("cluster_id", "cluster-1"))
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "rpc")
+	_, err := c.GetVolume(ctx, &models.GetVolumeRequest{UUID: "vol-1"})
+	require.Error(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	span := spans[0]
+	require.Equal(t, "client.GetVolume", span.Name())
+	require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+	require.Contains(t, span.Attributes(), attribute.String("cluster_id", "cluster-1"))
+	require.Equal(t, codes.Error, span.Status().Code)
+}
@@ -1,39 +1,87 @@
 package krusoe
 
 import (
-	"errors"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 )
 
 const (
 	secretAPIKey = "krusoe"
+
+	defaultCapacityBytes = 100 << 40 // 100 TiB
 )
 
 var (
-	errAuth = errors.New("incorrect api key")
+	errAuth = fmt.Errorf("incorrect api key: %w", models.ErrUnauthenticated)
+
+	errResourceNotFound = fmt.Errorf("resource %w", models.ErrNotFound)
+	errAlreadyExists    = fmt.Errorf("resource %w", models.ErrAlreadyExists)
+	errCannotResizeDown = fmt.Errorf("cannot resize to a smaller size: %w", models.ErrFailedPrecondition)
+	errVolDetached      = fmt.Errorf("volume is detached: %w", models.ErrFailedPrecondition)
+	errVolAttached      = fmt.Errorf("volume is attached: %w", models.ErrFailedPrecondition)
+	errNoCapacity       = fmt.Errorf("insufficient capacity: %w", models.ErrResourceExhausted)
+	errNotVolSnapshot   = fmt.Errorf("snapshot is not of the volume: %w", models.ErrFailedPrecondition)
 
-	errResourceNotFound = errors.New("resource not found")
-	errCannotResizeDown = errors.New("cannot resize to a smaller size")
-	errVolDetached      = errors.New("volume is detached")
-	errVolAttached      = errors.New("volume is attached")
+	errUnsupportedResourceType = fmt.Errorf("unsupported resource type: %w", models.ErrInvalidArgument)
 )
 
 type backend struct {
+	capacity  uint
 	volumes   map[string]*Volume   // mapping of krusoe volume name to volume
 	snapshots map[string]*Snapshot // mapping of krusoe snapshot name to snapshot
 }
 
-func newBackend() *backend {
+func newBackend(capacity uint) *backend {
+	if capacity == 0 {
+		capacity = defaultCapacityBytes
+	}
+
 	return &backend{
+		capacity:  capacity,
 		volumes:   make(map[string]*Volume),
 		snapshots: make(map[string]*Snapshot),
 	}
 }
 
+// Returns the bytes consumed by volumes and snapshots, and the bytes provisioned to volumes alone. Volumes are
+// thick-provisioned, so every provisioned byte is also used.
+func (b *backend) usage() (used, provisioned uint) {
+	for _, v := range b.volumes {
+		provisioned += v.size
+	}
+	used = provisioned
+	for _, s := range b.snapshots {
+		used += s.size
+	}
+
+	return used, provisioned
+}
+
+func (b *backend) ensureCapacity(extra uint) error {
+	used, _ := b.usage()
+	if used+extra > b.capacity {
+		return errNoCapacity
+	}
+
+	return nil
+}
+
+func (b *backend) getCapacity(apiKey string) (total, used, provisioned uint, err error) {
+	if apiKey != secretAPIKey {
+		return 0, 0, 0, errAuth
+	}
+
+	used, provisioned = b.usage()
+
+	return b.capacity, used, provisioned, nil
+}
+
 func (b *backend) getVolume(apiKey, name string) (*Volume, error) {
 	if apiKey != secretAPIKey {
 		return nil, errAuth
@@ -60,6 +108,14 @@ func (b *backend) createNewVolume(apiKey, name string, size, sectorSize uint) (*
 		return nil, errAuth
 	}
 
+	if _, ok := b.volumes[name]; ok {
+		return nil, errAlreadyExists
+	}
+
+	if err := b.ensureCapacity(size); err != nil {
+		return nil, err
+	}
+
 	v := &Volume{
 		name:       name,
 		id:         uuid.NewString(),
@@ -79,11 +135,19 @@ func (b *backend) createVolumeFromSnapshot(apiKey, name, srcSnapshotName string)
 		return nil, errAuth
 	}
 
+	if _, ok := b.volumes[name]; ok {
+		return nil, errAlreadyExists
+	}
+
 	s, err := b.getSnapshot(apiKey, srcSnapshotName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshot: %w", err)
 	}
 
+	if err := b.ensureCapacity(s.size); err != nil {
+		return nil, err
+	}
+
 	v := &Volume{
 		name:       name,
 		id:         uuid.NewString(),
@@ -98,6 +162,38 @@ func (b *backend) createVolumeFromSnapshot(apiKey, name, srcSnapshotName string)
 	return v, nil
 }
 
+func (b *backend) cloneVolume(apiKey, name, srcVolumeName string) (*Volume, error) {
+	if apiKey != secretAPIKey {
+		return nil, errAuth
+	}
+
+	if _, ok := b.volumes[name]; ok {
+		return nil, errAlreadyExists
+	}
+
+	src, err := b.getVolume(apiKey, srcVolumeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume: %w", err)
+	}
+
+	if err := b.ensureCapacity(src.size); err != nil {
+		return nil, err
+	}
+
+	v := &Volume{
+		name:       name,
+		id:         uuid.NewString(),
+		size:       src.size,
+		sectorSize: src.sectorSize,
+		acl:        []string{},
+		CreatedAt:  time.Now(),
+	}
+
+	b.volumes[v.name] = v
+
+	return v, nil
+}
+
 func (b *backend) resizeVolume(apiKey, id string, size uint) (*Volume, error) {
 	if apiKey != secretAPIKey {
 		return nil, errAuth
@@ -110,12 +206,43 @@ func (b *backend) resizeVolume(apiKey, id string, size uint) (*Volume, error) {
 	if size <= v.size {
 		return nil, errCannotResizeDown
 	}
+	if err := b.ensureCapacity(size - v.size); err != nil {
+		return nil, err
+	}
 
 	v.size = size
 
 	return v, nil
 }
 
+// Reverts a volume to a snapshot of it by taking on the size of the snapshot; krusoe volumes hold no data.
+func (b *backend) revertVolume(apiKey, id, snapshotName string) (*Volume, error) {
+	if apiKey != secretAPIKey {
+		return nil, errAuth
+	}
+
+	v, ok := b.volumes[id]
+	if !ok {
+		return nil, errResourceNotFound
+	}
+
+	s, err := b.getSnapshot(apiKey, snapshotName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	if s.sourceVolumeID != id {
+		return nil, errNotVolSnapshot
+	}
+	if len(v.acl) != 0 {
+		return nil, errVolAttached
+	}
+
+	v.size = s.size
+	v.sectorSize = s.sectorSize
+
+	return v, nil
+}
+
 func (b *backend) deleteVolume(apiKey, id string) error {
 	if apiKey != secretAPIKey {
 		return errAuth
@@ -195,11 +322,19 @@ func (b *backend) createSnapshot(apiKey, name, sourceVolumeID string) (*Snapshot
 		return nil, errAuth
 	}
 
+	if _, ok := b.snapshots[name]; ok {
+		return nil, errAlreadyExists
+	}
+
 	v, err := b.getVolume(apiKey, sourceVolumeID)
 	if err != nil {
 		return nil, errResourceNotFound
 	}
 
+	if err := b.ensureCapacity(v.size); err != nil {
+		return nil, err
+	}
+
 	s := &Snapshot{
 		name:           name,
 		id:             uuid.NewString(),
@@ -228,3 +363,57 @@ func (b *backend) deleteSnapshot(apiKey, id string) error {
 
 	return nil
 }
+
+// Returns the labels of volumes or snapshots by name. Resources without labels are left out.
+func (b *backend) getLabels(apiKey string, resourceType models.ResourceType) (map[string]map[string]string, error) {
+	if apiKey != secretAPIKey {
+		return nil, errAuth
+	}
+
+	out := map[string]map[string]string{}
+	switch resourceType {
+	case models.ResourceTypeVolume:
+		for name, v := range b.volumes {
+			if len(v.labels) > 0 {
+				out[name] = maps.Clone(v.labels)
+			}
+		}
+	case models.ResourceTypeSnapshot:
+		for name, s := range b.snapshots {
+			if len(s.labels) > 0 {
+				out[name] = maps.Clone(s.labels)
+			}
+		}
+	default:
+		return nil, errUnsupportedResourceType
+	}
+
+	return out, nil
+}
+
+func (b *backend) setLabels(apiKey string, resourceType models.ResourceType, name string,
+	labels map[string]string,
+) error {
+	if apiKey != secretAPIKey {
+		return errAuth
+	}
+
+	switch resourceType {
+	case models.ResourceTypeVolume:
+		v, ok := b.volumes[name]
+		if !ok {
+			return errResourceNotFound
+		}
+		v.labels = maps.Clone(labels)
+	case models.ResourceTypeSnapshot:
+		s, ok := b.snapshots[name]
+		if !ok {
+			return errResourceNotFound
+		}
+		s.labels = maps.Clone(labels)
+	default:
+		return errUnsupportedResourceType
+	}
+
+	return nil
+}
@@ -12,7 +12,7 @@ import (
 	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 )
 
-var errUnsupportVolumeSource = errors.New("unsupport volume source")
+var errUnsupportVolumeSource = fmt.Errorf("unsupport volume source: %w", models.ErrInvalidArgument)
 
 type Client struct {
 	apiKey  string
@@ -22,7 +22,7 @@ type Client struct {
 func NewClient(cfg Config) *Client {
 	return &Client{
 		apiKey:  cfg.APIKey,
-		backend: newBackend(),
+		backend: newBackend(uint(cfg.CapacityBytes)),
 	}
 }
 
@@ -93,12 +93,12 @@ func (c *Client) CreateVolume(_ context.Context, req *models.CreateVolumeRequest
 	var err error
 
 	switch source := req.Source.(type) {
-	case models.NewVolumeSpec:
+	case *models.NewVolumeSpec:
 		v, err = c.backend.createNewVolume(c.apiKey, req.UUID, uint(source.Size), uint(source.SectorSize))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create new volume: %w", err)
 		}
-	case models.SnapshotSource:
+	case *models.SnapshotSource:
 		v, err = c.backend.createVolumeFromSnapshot(c.apiKey, req.UUID, source.SnapshotUUID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create volume from snapshot: %w", err)
@@ -128,6 +128,31 @@ func (c *Client) CreateVolume(_ context.Context, req *models.CreateVolumeRequest
 	}, nil
 }
 
+func (c *Client) CloneVolume(_ context.Context, req *models.CloneVolumeRequest,
+) (*models.CloneVolumeResponse, error) {
+	v, err := c.backend.cloneVolume(c.apiKey, req.UUID, req.SourceVolumeUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone volume: %w", err)
+	}
+
+	sectorSize, err := uintToUint32Checked(v.sectorSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert uint to uint32: %w", err)
+	}
+
+	return &models.CloneVolumeResponse{
+		Volume: &models.Volume{
+			UUID:           v.name,
+			VendorVolumeID: v.id,
+			Size:           uint64(v.size),
+			SectorSize:     sectorSize,
+			ACL:            v.acl,
+			IsAvailable:    true,
+			CreatedAt:      v.CreatedAt,
+		},
+	}, nil
+}
+
 func (c *Client) ResizeVolume(_ context.Context, req *models.ResizeVolumeRequest,
 ) (*models.ResizeVolumeResponse, error) {
 	_, err := c.backend.resizeVolume(c.apiKey, req.UUID, uint(req.Size))
@@ -138,6 +163,32 @@ func (c *Client) ResizeVolume(_ context.Context, req *models.ResizeVolumeRequest
 	return &models.ResizeVolumeResponse{}, nil
 }
 
+func (c *Client) RevertVolumeToSnapshot(_ context.Context, req *models.RevertVolumeToSnapshotRequest,
+) (*models.RevertVolumeToSnapshotResponse, error) {
+	v, err := c.backend.revertVolume(c.apiKey, req.UUID, req.SnapshotUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert volume: %w", err)
+	}
+
+	sectorSize, err := uintToUint32Checked(v.sectorSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert uint to uint32: %w", err)
+	}
+
+	return &models.RevertVolumeToSnapshotResponse{
+		Volume: &models.Volume{
+			UUID:               v.name,
+			VendorVolumeID:     v.id,
+			Size:               uint64(v.size),
+			SectorSize:         sectorSize,
+			ACL:                v.acl,
+			IsAvailable:        true,
+			SourceSnapshotUUID: v.srcSnapshotID,
+			CreatedAt:          v.CreatedAt,
+		},
+	}, nil
+}
+
 func (c *Client) DeleteVolume(_ context.Context, req *models.DeleteVolumeRequest,
 ) (*models.DeleteVolumeResponse, error) {
 	err := c.backend.deleteVolume(c.apiKey, req.UUID)
@@ -266,6 +317,45 @@ func (c *Client) DeleteSnapshot(_ context.Context, req *models.DeleteSnapshotReq
 	return &models.DeleteSnapshotResponse{}, nil
 }
 
+func (c *Client) GetCapacity(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+	total, used, provisioned, err := c.backend.getCapacity(c.apiKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get capacity: %w", err)
+	}
+
+	return &models.GetCapacityResponse{
+		Capacity: &models.Capacity{
+			TotalBytes:       uint64(total),
+			UsedBytes:        uint64(used),
+			ProvisionedBytes: uint64(provisioned),
+		},
+	}, nil
+}
+
+func (c *Client) GetLabels(_ context.Context, req *models.GetLabelsRequest) (*models.GetLabelsResponse, error) {
+	labels, err := c.backend.getLabels(c.apiKey, req.ResourceType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	if len(req.UUIDs) > 0 {
+		labels = lo.PickByKeys(labels, req.UUIDs)
+	}
+
+	return &models.GetLabelsResponse{
+		Labels: labels,
+	}, nil
+}
+
+func (c *Client) SetLabels(_ context.Context, req *models.SetLabelsRequest) (*models.SetLabelsResponse, error) {
+	err := c.backend.setLabels(c.apiKey, req.ResourceType, req.UUID, req.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set labels: %w", err)
+	}
+
+	return &models.SetLabelsResponse{}, nil
+}
+
 var errUint32OutOfRange = errors.New("uint32 out of range")
 
 func uintToUint32Checked(u uint) (uint32, error) {
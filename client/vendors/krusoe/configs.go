@@ -10,7 +10,9 @@ var errConfigParse = errors.New("failed to parse config")
 
 //nolint:tagliatelle // using snake case for YAML
 type Config struct {
-	APIKey string `yaml:"api_key"`
+	APIKey string `yaml:"api_key" sensitive:"true"`
+	// Simulated capacity of the backend. Defaults to defaultCapacityBytes when unset.
+	CapacityBytes uint64 `yaml:"capacity_bytes"`
 }
 
 func ParseConfig(bytes []byte, cfg *Config) error {
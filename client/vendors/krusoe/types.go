@@ -12,6 +12,7 @@ type Volume struct {
 	acl           []string
 	srcSnapshotID string
 	CreatedAt     time.Time
+	labels        map[string]string
 }
 
 type Snapshot struct {
@@ -21,4 +22,5 @@ type Snapshot struct {
 	sectorSize     uint
 	sourceVolumeID string
 	createdAt      time.Time
+	labels         map[string]string
 }
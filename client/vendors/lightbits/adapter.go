@@ -2,25 +2,31 @@ package lightbits
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/samber/lo"
 
 	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/lightbits/dms"
 )
 
 var (
-	errMustHaveOneACL        = errors.New("must have exactly 1 ACL")
-	errUnsupportVolumeSource = errors.New("unsupport volume source")
+	errMustHaveOneACL        = fmt.Errorf("%w: must have exactly 1 ACL", models.ErrInvalidArgument)
+	errUnsupportVolumeSource = fmt.Errorf("%w: unsupport volume source", models.ErrInvalidArgument)
+	errNotVolumeSnapshot     = fmt.Errorf("%w: snapshot is not of the volume", models.ErrFailedPrecondition)
+	errVolumeAttached        = fmt.Errorf("%w: volume is attached", models.ErrFailedPrecondition)
 )
 
 // The Lightbits adapter is a wrapper around the Lightbits client that translates generic federation-level
 // requests to Lightbits-specific API requests.
 type ClientAdapter struct {
 	client *Client
+	// Nil unless DMS is enabled in the config.
+	dms          dmsClient
+	dmsClusterID string
 }
 
 func NewClientAdapter(cfg *ClientConfig) (*ClientAdapter, error) {
@@ -29,14 +35,24 @@ func NewClientAdapter(cfg *ClientConfig) (*ClientAdapter, error) {
 		return nil, fmt.Errorf("failed to create new lightbits client: %w", err)
 	}
 
-	return &ClientAdapter{
+	a := &ClientAdapter{
 		client: c,
-	}, nil
+	}
+	if cfg.DMS.Enabled {
+		d, err := dms.NewClientWithLoadBalancer(cfg.DMS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new lightbits dms client: %w", err)
+		}
+		a.dms = d
+		a.dmsClusterID = cfg.DMS.ClusterID
+	}
+
+	return a, nil
 }
 
-func (a *ClientAdapter) GetVolume(_ context.Context, req *models.GetVolumeRequest,
+func (a *ClientAdapter) GetVolume(ctx context.Context, req *models.GetVolumeRequest,
 ) (*models.GetVolumeResponse, error) {
-	lbResp, err := a.client.GetVolume(req.UUID) // Note: volume UUID is lightbits volume name
+	lbResp, err := a.client.GetVolume(ctx, req.UUID) // Note: volume UUID is lightbits volume name
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volume: %w", err)
 	}
@@ -59,15 +75,16 @@ func (a *ClientAdapter) GetVolume(_ context.Context, req *models.GetVolumeReques
 			SectorSize:         sectorSz,
 			ACL:                lbResp.ACL.Values,
 			IsAvailable:        volumeStateToIsAvail(lbResp.State),
+			IsFailed:           lbResp.State == VolumeStateFailed,
 			SourceSnapshotUUID: lbResp.SourceSnapshotName,
 			CreatedAt:          lbResp.CreationTime,
 		},
 	}, nil
 }
 
-func (a *ClientAdapter) GetVolumes(_ context.Context, _ *models.GetVolumesRequest,
+func (a *ClientAdapter) GetVolumes(ctx context.Context, _ *models.GetVolumesRequest,
 ) (*models.GetVolumesResponse, error) {
-	lbResp, err := a.client.GetVolumes() // Note: volume UUID is lightbits volume name
+	lbResp, err := a.client.GetVolumes(ctx) // Note: volume UUID is lightbits volume name
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volume: %w", err)
 	}
@@ -94,6 +111,7 @@ func (a *ClientAdapter) GetVolumes(_ context.Context, _ *models.GetVolumesReques
 			SectorSize:         sectorSz,
 			ACL:                v.ACL.Values,
 			IsAvailable:        volumeStateToIsAvail(v.State),
+			IsFailed:           v.State == VolumeStateFailed,
 			SourceSnapshotUUID: v.SourceSnapshotName,
 			CreatedAt:          v.CreationTime,
 		}
@@ -104,7 +122,7 @@ func (a *ClientAdapter) GetVolumes(_ context.Context, _ *models.GetVolumesReques
 	}, nil
 }
 
-func (a *ClientAdapter) CreateVolume(_ context.Context, req *models.CreateVolumeRequest,
+func (a *ClientAdapter) CreateVolume(ctx context.Context, req *models.CreateVolumeRequest,
 ) (*models.CreateVolumeResponse, error) {
 	// Required args: name, acl, repica count, size
 	var v *Volume
@@ -118,7 +136,7 @@ func (a *ClientAdapter) CreateVolume(_ context.Context, req *models.CreateVolume
 		}
 
 	case *models.SnapshotSource:
-		lbSnapshot, err1 := a.client.GetSnapshot(source.SnapshotUUID)
+		lbSnapshot, err1 := a.client.GetSnapshot(ctx, source.SnapshotUUID)
 		if err1 != nil {
 			return nil, fmt.Errorf("failed to get snapshot: %w", err1)
 		}
@@ -129,7 +147,7 @@ func (a *ClientAdapter) CreateVolume(_ context.Context, req *models.CreateVolume
 		return nil, errUnsupportVolumeSource
 	}
 
-	lbVol, err := a.client.CreateVolume(v)
+	lbVol, err := a.client.CreateVolume(ctx, v)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create volume: %w", err)
 	}
@@ -144,6 +162,50 @@ func (a *ClientAdapter) CreateVolume(_ context.Context, req *models.CreateVolume
 	}, nil
 }
 
+// Lightbits clones volumes from snapshots only, so the volume is cloned through a temporary snapshot, which is deleted
+// once the clone is created or fails.
+func (a *ClientAdapter) CloneVolume(ctx context.Context, req *models.CloneVolumeRequest,
+) (*models.CloneVolumeResponse, error) {
+	lbSnapshot, err := a.client.CreateSnapshot(ctx, &CreateSnapshotRequest{
+		Name:             cloneSnapshotName(req.UUID),
+		SourceVolumeName: req.SourceVolumeUUID,
+		ProjectName:      a.client.projectName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot to clone: %w", err)
+	}
+	defer func() {
+		// The snapshot is deleted even if ctx is done, so that a cancelled clone does not leave it behind.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*RequestTimeoutSeconds)
+		defer cancel()
+		if err := a.client.DeleteSnapshot(ctx, lbSnapshot.Name); err != nil {
+			log.Warn().Err(err).Str("snapshot", lbSnapshot.Name).Msg("failed to delete temporary snapshot")
+		}
+	}()
+
+	lbVol, err := a.client.CreateVolume(ctx, createVolFromSnapshotHelper(req.UUID, lbSnapshot))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume: %w", err)
+	}
+
+	genericVol, err := translateLBVolToGenericVolHelper(lbVol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate lightbits volume to generic volume: %w", err)
+	}
+	// The temporary snapshot is not a source callers can refer to.
+	genericVol.SourceSnapshotUUID = ""
+
+	return &models.CloneVolumeResponse{
+		Volume: genericVol,
+	}, nil
+}
+
+// Returns the name of the temporary snapshot a volume is cloned through. It is derived from the UUID of the clone, so
+// that a leftover snapshot can be traced back to it.
+func cloneSnapshotName(id string) string {
+	return "clone-" + id
+}
+
 func createEmptyVolHelper(id string, rf int, size uint64, sectorSize uint32) (*Volume, error) {
 	sectorSz, err := uint32ToIntChecked(sectorSize)
 	if err != nil {
@@ -195,22 +257,23 @@ func translateLBVolToGenericVolHelper(vol *Volume) (*models.Volume, error) {
 		SectorSize:         sectorSize,
 		ACL:                vol.ACL.Values,
 		IsAvailable:        volumeStateToIsAvail(vol.State),
+		IsFailed:           vol.State == VolumeStateFailed,
 		SourceSnapshotUUID: vol.SourceSnapshotName,
 		CreatedAt:          vol.CreationTime,
 	}, nil
 }
 
-func (a *ClientAdapter) ResizeVolume(_ context.Context, req *models.ResizeVolumeRequest,
+func (a *ClientAdapter) ResizeVolume(ctx context.Context, req *models.ResizeVolumeRequest,
 ) (*models.ResizeVolumeResponse, error) {
 	name := req.UUID
-	getVolResp, err := a.client.GetVolume(name)
+	getVolResp, err := a.client.GetVolume(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volume for resize: %w", err)
 	}
 
 	id := getVolResp.UUID // Note: volume UUID is lightbits volume name
 	size := bytesToGiBString(req.Size)
-	err = a.client.UpdateVolume(id, &UpdateVolumeRequest{Size: size})
+	err = a.client.UpdateVolume(ctx, id, &UpdateVolumeRequest{Size: size})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update volume: %w", err)
 	}
@@ -220,10 +283,46 @@ func (a *ClientAdapter) ResizeVolume(_ context.Context, req *models.ResizeVolume
 	}, nil
 }
 
-func (a *ClientAdapter) DeleteVolume(_ context.Context, req *models.DeleteVolumeRequest,
+func (a *ClientAdapter) RevertVolumeToSnapshot(ctx context.Context, req *models.RevertVolumeToSnapshotRequest,
+) (*models.RevertVolumeToSnapshotResponse, error) {
+	lbVol, err := a.client.GetVolume(ctx, req.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume to revert: %w", err)
+	}
+	lbSnapshot, err := a.client.GetSnapshot(ctx, req.SnapshotUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	if lbSnapshot.SourceVolumeUUID != lbVol.UUID {
+		return nil, errNotVolumeSnapshot
+	}
+	if aclIsAttached(lbVol.ACL.Values) {
+		return nil, errVolumeAttached
+	}
+
+	err = a.client.RollbackVolume(ctx, lbVol.UUID, &RollbackVolumeRequest{SrcSnapshotUUID: lbSnapshot.UUID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert volume: %w", err)
+	}
+
+	lbVol, err = a.client.GetVolume(ctx, req.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reverted volume: %w", err)
+	}
+	genericVol, err := translateLBVolToGenericVolHelper(lbVol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate lightbits volume to generic volume: %w", err)
+	}
+
+	return &models.RevertVolumeToSnapshotResponse{
+		Volume: genericVol,
+	}, nil
+}
+
+func (a *ClientAdapter) DeleteVolume(ctx context.Context, req *models.DeleteVolumeRequest,
 ) (*models.DeleteVolumeResponse, error) {
 	name := req.UUID // Note: volume UUID is lightbits volume name
-	err := a.client.DeleteVolume(name)
+	err := a.client.DeleteVolume(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete volume: %w", err)
 	}
@@ -233,10 +332,10 @@ func (a *ClientAdapter) DeleteVolume(_ context.Context, req *models.DeleteVolume
 	}, nil
 }
 
-func (a *ClientAdapter) AttachVolume(_ context.Context, req *models.AttachVolumeRequest,
+func (a *ClientAdapter) AttachVolume(ctx context.Context, req *models.AttachVolumeRequest,
 ) (*models.AttachVolumeResponse, error) {
 	name := req.UUID
-	getVolResp, err := a.client.GetVolume(name)
+	getVolResp, err := a.client.GetVolume(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volume for attachment: %w", err)
 	}
@@ -248,7 +347,7 @@ func (a *ClientAdapter) AttachVolume(_ context.Context, req *models.AttachVolume
 	addNodes := req.ACL
 	removeNodes := []string{}
 	acl := constructACLSet(getVolResp.ACL.Values, addNodes, removeNodes)
-	err = a.client.UpdateVolume(getVolResp.UUID, &UpdateVolumeRequest{
+	err = a.client.UpdateVolume(ctx, getVolResp.UUID, &UpdateVolumeRequest{
 		ACL: &ACL{
 			Values: acl,
 		},
@@ -262,10 +361,10 @@ func (a *ClientAdapter) AttachVolume(_ context.Context, req *models.AttachVolume
 	}, nil
 }
 
-func (a *ClientAdapter) DetachVolume(_ context.Context, req *models.DetachVolumeRequest,
+func (a *ClientAdapter) DetachVolume(ctx context.Context, req *models.DetachVolumeRequest,
 ) (*models.DetachVolumeResponse, error) {
 	name := req.UUID
-	getVolResp, err := a.client.GetVolume(name)
+	getVolResp, err := a.client.GetVolume(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volume for detachment: %w", err)
 	}
@@ -277,7 +376,7 @@ func (a *ClientAdapter) DetachVolume(_ context.Context, req *models.DetachVolume
 	addNodes := []string{}
 	removeNodes := req.ACL
 	acl := constructACLSet(getVolResp.ACL.Values, addNodes, removeNodes)
-	err = a.client.UpdateVolume(getVolResp.UUID, &UpdateVolumeRequest{
+	err = a.client.UpdateVolume(ctx, getVolResp.UUID, &UpdateVolumeRequest{
 		ACL: &ACL{
 			Values: acl,
 		},
@@ -292,42 +391,52 @@ func (a *ClientAdapter) DetachVolume(_ context.Context, req *models.DetachVolume
 	}, nil
 }
 
-func (a *ClientAdapter) GetSnapshot(_ context.Context, req *models.GetSnapshotRequest,
+func (a *ClientAdapter) GetSnapshot(ctx context.Context, req *models.GetSnapshotRequest,
 ) (*models.GetSnapshotResponse, error) {
 	name := req.UUID
-	lbResp, err := a.client.GetSnapshot(name)
+	lbResp, err := a.client.GetSnapshot(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshot: %w", err)
 	}
 
-	sz, err := stringToUint64(lbResp.Size)
+	snapshot, err := translateLBSnapshotToGenericSnapshotHelper(lbResp)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse snapshot size: %w", err)
+		return nil, fmt.Errorf("failed to translate lightbits snapshot to generic snapshot: %w", err)
 	}
 
-	sectorSz, err := intToUint32Checked(lbResp.SectorSize)
+	resp := &models.GetSnapshotResponse{
+		Snapshot: snapshot,
+	}
+
+	return resp, nil
+}
+
+func translateLBSnapshotToGenericSnapshotHelper(snapshot *Snapshot) (*models.Snapshot, error) {
+	sz, err := stringToUint64(snapshot.Size)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse sector size: %w", err)
+		return nil, fmt.Errorf("failed to parse snapshot size: %w", err)
 	}
 
-	resp := &models.GetSnapshotResponse{
-		Snapshot: &models.Snapshot{
-			UUID:             lbResp.Name,
-			VendorSnapshotID: lbResp.UUID.String(),
-			Size:             sz,
-			SectorSize:       sectorSz,
-			IsAvailable:      snapshotStateToIsAvail(lbResp.State),
-			SourceVolumeUUID: lbResp.SourceVolumeName,
-			CreatedAt:        lbResp.CreationTime,
-		},
+	sectorSz, err := intToUint32Checked(snapshot.SectorSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sector size: %w", err)
 	}
 
-	return resp, nil
+	return &models.Snapshot{
+		UUID:             snapshot.Name,
+		VendorSnapshotID: snapshot.UUID.String(),
+		Size:             sz,
+		SectorSize:       sectorSz,
+		IsAvailable:      snapshotStateToIsAvail(snapshot.State),
+		IsFailed:         snapshot.State == SnapshotStateFailed,
+		SourceVolumeUUID: snapshot.SourceVolumeName,
+		CreatedAt:        snapshot.CreationTime,
+	}, nil
 }
 
-func (a *ClientAdapter) GetSnapshots(_ context.Context, _ *models.GetSnapshotsRequest,
+func (a *ClientAdapter) GetSnapshots(ctx context.Context, _ *models.GetSnapshotsRequest,
 ) (*models.GetSnapshotsResponse, error) {
-	lbResp, err := a.client.GetSnapshots()
+	lbResp, err := a.client.GetSnapshots(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshots: %w", err)
 	}
@@ -353,6 +462,7 @@ func (a *ClientAdapter) GetSnapshots(_ context.Context, _ *models.GetSnapshotsRe
 			Size:             sz,
 			SectorSize:       sectorSz,
 			IsAvailable:      snapshotStateToIsAvail(s.State),
+			IsFailed:         s.State == SnapshotStateFailed,
 			SourceVolumeUUID: s.SourceVolumeName,
 			CreatedAt:        s.CreationTime,
 		}
@@ -365,7 +475,7 @@ func (a *ClientAdapter) GetSnapshots(_ context.Context, _ *models.GetSnapshotsRe
 	return resp, nil
 }
 
-func (a *ClientAdapter) CreateSnapshot(_ context.Context, req *models.CreateSnapshotRequest,
+func (a *ClientAdapter) CreateSnapshot(ctx context.Context, req *models.CreateSnapshotRequest,
 ) (*models.CreateSnapshotResponse, error) {
 	lbReq := &CreateSnapshotRequest{
 		Name:             req.UUID,
@@ -373,20 +483,25 @@ func (a *ClientAdapter) CreateSnapshot(_ context.Context, req *models.CreateSnap
 		ProjectName:      a.client.projectName,
 	}
 
-	_, err := a.client.CreateSnapshot(lbReq)
+	lbSnapshot, err := a.client.CreateSnapshot(ctx, lbReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snapshot: %w", err)
 	}
 
+	snapshot, err := translateLBSnapshotToGenericSnapshotHelper(lbSnapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate lightbits snapshot to generic snapshot: %w", err)
+	}
+
 	return &models.CreateSnapshotResponse{
-		// Empty; ACK
+		Snapshot: snapshot,
 	}, nil
 }
 
-func (a *ClientAdapter) DeleteSnapshot(_ context.Context, req *models.DeleteSnapshotRequest,
+func (a *ClientAdapter) DeleteSnapshot(ctx context.Context, req *models.DeleteSnapshotRequest,
 ) (*models.DeleteSnapshotResponse, error) {
 	name := req.UUID
-	err := a.client.DeleteSnapshot(name)
+	err := a.client.DeleteSnapshot(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete snapshot: %w", err)
 	}
@@ -395,3 +510,50 @@ func (a *ClientAdapter) DeleteSnapshot(_ context.Context, req *models.DeleteSnap
 		// Empty; ACK
 	}, nil
 }
+
+func (a *ClientAdapter) GetCapacity(ctx context.Context, _ *models.GetCapacityRequest,
+) (*models.GetCapacityResponse, error) {
+	lbCluster, err := a.client.GetCluster(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	capacity, err := translateLBClusterStatsToCapacityHelper(&lbCluster.Statistics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate cluster statistics: %w", err)
+	}
+
+	return &models.GetCapacityResponse{
+		Capacity: capacity,
+	}, nil
+}
+
+// Lightbits reports logical capacity estimated from replication and compression, which is what volume sizes
+// are measured against.
+func translateLBClusterStatsToCapacityHelper(stats *ClusterStatistics) (*models.Capacity, error) {
+	total, err := stringToUint64(stats.EstimatedLogicalStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse estimated logical storage: %w", err)
+	}
+
+	free, err := stringToUint64(stats.EstimatedFreeLogicalStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse estimated free logical storage: %w", err)
+	}
+
+	provisioned, err := stringToUint64(stats.LogicalStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse logical storage: %w", err)
+	}
+
+	used := uint64(0)
+	if total > free {
+		used = total - free
+	}
+
+	return &models.Capacity{
+		TotalBytes:       total,
+		UsedBytes:        used,
+		ProvisionedBytes: provisioned,
+	}, nil
+}
@@ -0,0 +1,180 @@
+package lightbits
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/lightbits/dms"
+)
+
+var errDMSDisabled = fmt.Errorf("%w: %w", models.ErrFailedPrecondition, dms.ErrDMSDisabled)
+
+// The subset of the DMS client used by the adapter.
+type dmsClient interface {
+	CloneVolume(ctx context.Context, req *dms.ThickCloneVolumeRequest) (*dms.ThickCloneVolumeResponse, error)
+	CloneSnapshot(ctx context.Context, req *dms.ThickCloneSnapshotRequest) (*dms.ThickCloneSnapshotResponse, error)
+	GetWorkflow(ctx context.Context, workflowID string) (*dms.GetWorkflowResponse, error)
+}
+
+// DMSEnabled reports whether copies to other Lightbits clusters are available.
+func (a *ClientAdapter) DMSEnabled() bool {
+	return a.dms != nil
+}
+
+// VendorClusterID returns the Lightbits ID of the cluster, which DMS uses to address it. The ID is taken from the
+// DMS config, or from the cluster itself if the config leaves it out.
+func (a *ClientAdapter) VendorClusterID(ctx context.Context) (string, error) {
+	if a.dmsClusterID != "" {
+		return a.dmsClusterID, nil
+	}
+
+	lbCluster, err := a.client.GetCluster(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	return lbCluster.UUID.String(), nil
+}
+
+// CopyTarget returns the cluster ID and project DMS copies from other clusters should be sent to.
+func (a *ClientAdapter) CopyTarget(ctx context.Context) (*models.CopyTarget, error) {
+	clusterID, err := a.VendorClusterID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CopyTarget{
+		ClusterID: clusterID,
+		Project:   a.client.projectName,
+	}, nil
+}
+
+// CopySnapshotToVolume starts a DMS workflow creating a volume on another Lightbits cluster from a snapshot on this
+// one.
+func (a *ClientAdapter) CopySnapshotToVolume(ctx context.Context, req *models.CopySnapshotRequest,
+) (*models.CopySnapshotResponse, error) {
+	if a.dms == nil {
+		return nil, errDMSDisabled
+	}
+
+	src, err := a.dmsSnapshotSource(ctx, req.SnapshotUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.dms.CloneVolume(ctx, &dms.ThickCloneVolumeRequest{
+		Src: src,
+		Dst: &dms.DstVolumeInfo{
+			Name:        req.DestinationUUID,
+			ProjectName: req.DestinationProject,
+			ClusterID:   req.DestinationClusterID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone volume: %w", err)
+	}
+
+	return &models.CopySnapshotResponse{
+		Workflow: &models.Workflow{ID: resp.WorkflowID, State: models.WorkflowStateRunning},
+	}, nil
+}
+
+// CopySnapshotToSnapshot starts a DMS workflow creating a snapshot on another Lightbits cluster from a snapshot on
+// this one.
+func (a *ClientAdapter) CopySnapshotToSnapshot(ctx context.Context, req *models.CopySnapshotRequest,
+) (*models.CopySnapshotResponse, error) {
+	if a.dms == nil {
+		return nil, errDMSDisabled
+	}
+
+	src, err := a.dmsSnapshotSource(ctx, req.SnapshotUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.dms.CloneSnapshot(ctx, &dms.ThickCloneSnapshotRequest{
+		Src: src,
+		Dst: &dms.DstSnapshotInfo{
+			Name:        req.DestinationUUID,
+			ProjectName: req.DestinationProject,
+			ClusterID:   req.DestinationClusterID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone snapshot: %w", err)
+	}
+
+	return &models.CopySnapshotResponse{
+		Workflow: &models.Workflow{ID: resp.WorkflowID, State: models.WorkflowStateRunning},
+	}, nil
+}
+
+// GetWorkflow returns the state of a DMS workflow.
+func (a *ClientAdapter) GetWorkflow(ctx context.Context, req *models.GetWorkflowRequest,
+) (*models.GetWorkflowResponse, error) {
+	if a.dms == nil {
+		return nil, errDMSDisabled
+	}
+
+	resp, err := a.dms.GetWorkflow(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+	if resp.Workflow == nil {
+		return nil, fmt.Errorf("workflow %s %w", req.ID, models.ErrNotFound)
+	}
+
+	return &models.GetWorkflowResponse{
+		Workflow: translateDMSWorkflowHelper(resp.Workflow),
+	}, nil
+}
+
+// DMS addresses snapshots by their Lightbits UUID rather than their name, which StorMS uses as the snapshot UUID.
+func (a *ClientAdapter) dmsSnapshotSource(ctx context.Context, snapshotUUID string) (*dms.SrcSnapshotInfo, error) {
+	lbSnapshot, err := a.client.GetSnapshot(ctx, snapshotUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+
+	clusterID, err := a.VendorClusterID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dms.SrcSnapshotInfo{
+		SnapID:      lbSnapshot.UUID.String(),
+		ProjectName: a.client.projectName,
+		ClusterID:   clusterID,
+	}, nil
+}
+
+func translateDMSWorkflowHelper(w *dms.Workflow) *models.Workflow {
+	workflow := &models.Workflow{
+		ID:        w.ID,
+		State:     translateDMSWorkflowStateHelper(w.State),
+		Message:   w.Msg,
+		CreatedAt: w.CreatedAt,
+		EndedAt:   w.EndedAt,
+	}
+	if w.Progress != nil {
+		workflow.Progress = w.Progress.Percent
+	}
+
+	return workflow
+}
+
+// Workflows in any state other than the terminal ones are reported as running.
+func translateDMSWorkflowStateHelper(state string) models.WorkflowState {
+	switch strings.ToLower(state) {
+	case "completed", "succeeded", "success":
+		return models.WorkflowStateSucceeded
+	case "failed", "error":
+		return models.WorkflowStateFailed
+	case "canceled", "cancelled":
+		return models.WorkflowStateCancelled
+	default:
+		return models.WorkflowStateRunning
+	}
+}
@@ -0,0 +1,145 @@
+package lightbits
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/lightbits/dms"
+)
+
+type mockDMSClient struct {
+	cloneVolumeReq   *dms.ThickCloneVolumeRequest
+	cloneSnapshotReq *dms.ThickCloneSnapshotRequest
+	workflow         *dms.Workflow
+}
+
+func (m *mockDMSClient) CloneVolume(_ context.Context, req *dms.ThickCloneVolumeRequest,
+) (*dms.ThickCloneVolumeResponse, error) {
+	m.cloneVolumeReq = req
+
+	return &dms.ThickCloneVolumeResponse{WorkflowID: "volume-workflow"}, nil
+}
+
+func (m *mockDMSClient) CloneSnapshot(_ context.Context, req *dms.ThickCloneSnapshotRequest,
+) (*dms.ThickCloneSnapshotResponse, error) {
+	m.cloneSnapshotReq = req
+
+	return &dms.ThickCloneSnapshotResponse{WorkflowID: "snapshot-workflow"}, nil
+}
+
+func (m *mockDMSClient) GetWorkflow(_ context.Context, _ string) (*dms.GetWorkflowResponse, error) {
+	return &dms.GetWorkflowResponse{Workflow: m.workflow}, nil
+}
+
+func newDMSTestAdapter(d dmsClient) *ClientAdapter {
+	c := &Client{addr: "lightbits.test", projectName: "unit-test"}
+	c.doFunc = func(_ context.Context, _, _ string, _, respBody interface{}) error {
+		return json.Unmarshal([]byte(`{
+			"uuid": "0c8a9a7e-5f5d-4d0e-8f0f-6f3b9c1d2e4a",
+			"name": "9d7e2f4c-1a3b-4c5d-8e6f-7a8b9c0d1e2f"
+		}`), respBody)
+	}
+
+	return &ClientAdapter{client: c, dms: d, dmsClusterID: "6f1c7e0a-3b9d-4c51-9a8e-2d7f0b4e1c35"}
+}
+
+func Test_ClientAdapter_CopySnapshot(t *testing.T) {
+	d := &mockDMSClient{}
+	a := newDMSTestAdapter(d)
+	req := &models.CopySnapshotRequest{
+		SnapshotUUID:         "9d7e2f4c-1a3b-4c5d-8e6f-7a8b9c0d1e2f",
+		DestinationUUID:      "2b4d6f8a-0c1e-4a3b-9d5f-7e9a1b3c5d7f",
+		DestinationClusterID: "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+	}
+	expectSrc := &dms.SrcSnapshotInfo{
+		SnapID:      "0c8a9a7e-5f5d-4d0e-8f0f-6f3b9c1d2e4a",
+		ProjectName: "unit-test",
+		ClusterID:   "6f1c7e0a-3b9d-4c51-9a8e-2d7f0b4e1c35",
+	}
+
+	resp, err := a.CopySnapshotToVolume(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "volume-workflow", resp.Workflow.ID)
+	require.Equal(t, expectSrc, d.cloneVolumeReq.Src)
+	require.Equal(t, req.DestinationUUID, d.cloneVolumeReq.Dst.Name)
+	require.Equal(t, req.DestinationClusterID, d.cloneVolumeReq.Dst.ClusterID)
+
+	resp, err = a.CopySnapshotToSnapshot(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "snapshot-workflow", resp.Workflow.ID)
+	require.Equal(t, expectSrc, d.cloneSnapshotReq.Src)
+	require.Equal(t, req.DestinationUUID, d.cloneSnapshotReq.Dst.Name)
+	require.Equal(t, req.DestinationClusterID, d.cloneSnapshotReq.Dst.ClusterID)
+}
+
+func Test_ClientAdapter_CopyTarget(t *testing.T) {
+	a := newDMSTestAdapter(&mockDMSClient{})
+
+	target, err := a.CopyTarget(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &models.CopyTarget{
+		ClusterID: "6f1c7e0a-3b9d-4c51-9a8e-2d7f0b4e1c35",
+		Project:   "unit-test",
+	}, target)
+}
+
+func Test_ClientAdapter_GetWorkflow(t *testing.T) {
+	endedAt := time.Date(2025, 11, 20, 14, 45, 0, 0, time.UTC)
+	d := &mockDMSClient{workflow: &dms.Workflow{
+		ID:       "volume-workflow",
+		State:    "Failed",
+		Msg:      "destination cluster is full",
+		EndedAt:  endedAt,
+		Progress: &dms.Progress{Percent: 40},
+	}}
+	a := newDMSTestAdapter(d)
+
+	resp, err := a.GetWorkflow(context.Background(), &models.GetWorkflowRequest{ID: "volume-workflow"})
+	require.NoError(t, err)
+	require.Equal(t, &models.Workflow{
+		ID:       "volume-workflow",
+		State:    models.WorkflowStateFailed,
+		Message:  "destination cluster is full",
+		Progress: 40,
+		EndedAt:  endedAt,
+	}, resp.Workflow)
+
+	d.workflow = nil
+	_, err = a.GetWorkflow(context.Background(), &models.GetWorkflowRequest{ID: "volume-workflow"})
+	require.True(t, errors.Is(err, models.ErrNotFound))
+}
+
+func Test_ClientAdapter_DMSDisabled(t *testing.T) {
+	a := newDMSTestAdapter(nil)
+	require.False(t, a.DMSEnabled())
+
+	_, err := a.CopySnapshotToVolume(context.Background(), &models.CopySnapshotRequest{})
+	require.True(t, errors.Is(err, models.ErrFailedPrecondition))
+	_, err = a.GetWorkflow(context.Background(), &models.GetWorkflowRequest{})
+	require.True(t, errors.Is(err, models.ErrFailedPrecondition))
+}
+
+func Test_translateDMSWorkflowStateHelper(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected models.WorkflowState
+	}{
+		{input: "Completed", expected: models.WorkflowStateSucceeded},
+		{input: "Failed", expected: models.WorkflowStateFailed},
+		{input: "Canceled", expected: models.WorkflowStateCancelled},
+		{input: "Running", expected: models.WorkflowStateRunning},
+		{input: "", expected: models.WorkflowStateRunning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			require.Equal(t, tt.expected, translateDMSWorkflowStateHelper(tt.input))
+		})
+	}
+}
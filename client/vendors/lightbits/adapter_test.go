@@ -0,0 +1,318 @@
+package lightbits
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+)
+
+func Test_translateLBClusterStatsToCapacityHelper(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *ClusterStatistics
+		expected  *models.Capacity
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			input: &ClusterStatistics{
+				EstimatedLogicalStorage:     "1000",
+				EstimatedFreeLogicalStorage: "400",
+				LogicalStorage:              "2500",
+			},
+			expected: &models.Capacity{
+				TotalBytes:       1000,
+				UsedBytes:        600,
+				ProvisionedBytes: 2500,
+			},
+		},
+		{
+			name: "free exceeds total",
+			input: &ClusterStatistics{
+				EstimatedLogicalStorage:     "1000",
+				EstimatedFreeLogicalStorage: "1200",
+				LogicalStorage:              "0",
+			},
+			expected: &models.Capacity{
+				TotalBytes: 1000,
+			},
+		},
+		{
+			name: "missing statistics",
+			input: &ClusterStatistics{
+				EstimatedLogicalStorage: "1000",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := translateLBClusterStatsToCapacityHelper(tt.input)
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_translateLBVolToGenericVolHelper_State(t *testing.T) {
+	tests := []struct {
+		name            string
+		state           VolumeState
+		expectAvailable bool
+		expectFailed    bool
+	}{
+		{name: "creating", state: VolumeStateCreating},
+		{name: "available", state: VolumeStateAvailable, expectAvailable: true},
+		{name: "failed", state: VolumeStateFailed, expectFailed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := translateLBVolToGenericVolHelper(&Volume{Size: "1024", State: tt.state})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectAvailable, actual.IsAvailable)
+			require.Equal(t, tt.expectFailed, actual.IsFailed)
+
+			snapshot, err := translateLBSnapshotToGenericSnapshotHelper(&Snapshot{
+				Size:  "1024",
+				State: SnapshotState(tt.state),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectAvailable, snapshot.IsAvailable)
+			require.Equal(t, tt.expectFailed, snapshot.IsFailed)
+		})
+	}
+}
+
+func Test_ClientAdapter_GetCapacity(t *testing.T) {
+	c := &Client{addr: "lightbits.test"}
+	c.doFunc = func(_ context.Context, method, url string, _, respBody interface{}) error {
+		require.Equal(t, http.MethodGet, method)
+		require.Equal(t, "https://lightbits.test/api/v2/cluster", url)
+
+		return json.Unmarshal([]byte(`{
+			"UUID": "4a0c3b40-6d32-4f4e-a1d4-5a6bb0f1a8d2",
+			"statistics": {
+				"logicalStorage": "5000",
+				"estimatedLogicalStorage": "8000",
+				"estimatedFreeLogicalStorage": "6000"
+			}
+		}`), respBody)
+	}
+	a := &ClientAdapter{client: c}
+
+	resp, err := a.GetCapacity(context.Background(), &models.GetCapacityRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8000), resp.Capacity.TotalBytes)
+	require.Equal(t, uint64(2000), resp.Capacity.UsedBytes)
+	require.Equal(t, uint64(5000), resp.Capacity.ProvisionedBytes)
+	require.Equal(t, uint64(6000), resp.Capacity.FreeBytes())
+}
+
+func Test_ClientAdapter_CloneVolume(t *testing.T) {
+	tests := []struct {
+		name      string
+		createErr error
+		expectErr bool
+	}{
+		{
+			name: "cloned",
+		},
+		{
+			name:      "volume creation fails",
+			createErr: models.ErrResourceExhausted,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []string
+			c := &Client{addr: "lightbits.test", projectName: "unit-test"}
+			c.doFunc = func(_ context.Context, method, url string, reqBody, respBody interface{}) error {
+				switch {
+				case method == http.MethodPost && url == "https://lightbits.test/api/v2/projects/unit-test/snapshots":
+					require.Equal(t, "source-volume", reqBody.(*CreateSnapshotRequest).SourceVolumeName)
+
+					return json.Unmarshal([]byte(`{
+						"uuid": "0c8a9a7e-5f5d-4d0e-8f0f-6f3b9c1d2e4a",
+						"name": "clone-clone-volume",
+						"size": "1073741824",
+						"sectorSize": 4096,
+						"replicaCount": 2
+					}`), respBody)
+				case method == http.MethodPost && url == "https://lightbits.test/api/v2/projects/unit-test/volumes":
+					if tt.createErr != nil {
+						return tt.createErr
+					}
+					v := reqBody.(*Volume)
+					require.Equal(t, "0c8a9a7e-5f5d-4d0e-8f0f-6f3b9c1d2e4a", v.SourceSnapshotUUID)
+
+					return json.Unmarshal([]byte(`{
+						"uuid": "5b0e2c7d-9a41-4f3e-8d6b-1c2a3b4d5e6f",
+						"name": "clone-volume",
+						"size": "1073741824",
+						"sectorSize": 4096,
+						"sourceSnapshotName": "clone-clone-volume"
+					}`), respBody)
+				case method == http.MethodDelete:
+					deleted = append(deleted, url)
+
+					return nil
+				}
+				t.Fatalf("unexpected request: %s %s", method, url)
+
+				return nil
+			}
+			a := &ClientAdapter{client: c}
+
+			resp, err := a.CloneVolume(context.Background(), &models.CloneVolumeRequest{
+				UUID:             "clone-volume",
+				SourceVolumeUUID: "source-volume",
+			})
+			// The temporary snapshot is deleted whether or not the clone succeeds.
+			require.Equal(t, []string{
+				"https://lightbits.test/api/v2/projects/unit-test/snapshots/?name=clone-clone-volume",
+			}, deleted)
+			if tt.expectErr {
+				require.ErrorIs(t, err, tt.createErr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "clone-volume", resp.Volume.UUID)
+			require.Equal(t, uint64(1073741824), resp.Volume.Size)
+			require.Empty(t, resp.Volume.SourceSnapshotUUID)
+		})
+	}
+}
+
+func Test_ClientAdapter_CloneVolume_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var deleted []string
+	c := &Client{addr: "lightbits.test", projectName: "unit-test"}
+	c.doFunc = func(reqCtx context.Context, method, url string, _, respBody interface{}) error {
+		switch method {
+		case http.MethodPost:
+			if strings.HasSuffix(url, "/snapshots") {
+				return json.Unmarshal([]byte(`{"uuid": "0c8a9a7e-5f5d-4d0e-8f0f-6f3b9c1d2e4a",
+					"name": "clone-clone-volume"}`), respBody)
+			}
+			// The caller gives up while the volume is being created.
+			cancel()
+
+			return reqCtx.Err()
+		case http.MethodDelete:
+			require.NoError(t, reqCtx.Err())
+			_, ok := reqCtx.Deadline()
+			require.True(t, ok)
+			deleted = append(deleted, url)
+
+			return nil
+		}
+		t.Fatalf("unexpected request: %s %s", method, url)
+
+		return nil
+	}
+	a := &ClientAdapter{client: c}
+
+	_, err := a.CloneVolume(ctx, &models.CloneVolumeRequest{UUID: "clone-volume", SourceVolumeUUID: "source-volume"})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []string{
+		"https://lightbits.test/api/v2/projects/unit-test/snapshots/?name=clone-clone-volume",
+	}, deleted)
+}
+
+func Test_ClientAdapter_RevertVolumeToSnapshot(t *testing.T) {
+	const (
+		volUUID   = "5b0e2c7d-9a41-4f3e-8d6b-1c2a3b4d5e6f"
+		otherUUID = "7c1f3d8e-0b52-4a4f-9e7c-2d3b4c5e6f70"
+	)
+
+	tests := []struct {
+		name           string
+		snapshotSource string
+		acl            string
+		expectErr      error
+	}{
+		{
+			name:           "reverted",
+			snapshotSource: volUUID,
+			acl:            ACLNone,
+		},
+		{
+			name:           "snapshot of another volume",
+			snapshotSource: otherUUID,
+			acl:            ACLNone,
+			expectErr:      models.ErrFailedPrecondition,
+		},
+		{
+			name:           "volume attached",
+			snapshotSource: volUUID,
+			acl:            "nqn.2014-08.org.nvmexpress:uuid:077f1a5f-3240-45c8-a996-4ee013c3f418",
+			expectErr:      models.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rolledBack bool
+			c := &Client{addr: "lightbits.test", projectName: "unit-test"}
+			c.doFunc = func(_ context.Context, method, url string, reqBody, respBody interface{}) error {
+				switch {
+				case method == http.MethodGet && url == "https://lightbits.test/api/v2/projects/unit-test/volumes/?name=volume":
+					return json.Unmarshal([]byte(`{
+						"uuid": "`+volUUID+`",
+						"name": "volume",
+						"size": "1073741824",
+						"sectorSize": 4096,
+						"acl": {"values": ["`+tt.acl+`"]}
+					}`), respBody)
+				case method == http.MethodGet:
+					return json.Unmarshal([]byte(`{
+						"uuid": "0c8a9a7e-5f5d-4d0e-8f0f-6f3b9c1d2e4a",
+						"name": "snapshot",
+						"sourceVolumeUUID": "`+tt.snapshotSource+`"
+					}`), respBody)
+				case method == http.MethodPost:
+					require.Equal(t, "https://lightbits.test/api/v2/projects/unit-test/volumes/"+volUUID+"/rollback", url)
+					require.Equal(t, "0c8a9a7e-5f5d-4d0e-8f0f-6f3b9c1d2e4a",
+						reqBody.(*RollbackVolumeRequest).SrcSnapshotUUID.String())
+					rolledBack = true
+
+					return nil
+				}
+				t.Fatalf("unexpected request: %s %s", method, url)
+
+				return nil
+			}
+			a := &ClientAdapter{client: c}
+
+			resp, err := a.RevertVolumeToSnapshot(context.Background(), &models.RevertVolumeToSnapshotRequest{
+				UUID:         "volume",
+				SnapshotUUID: "snapshot",
+			})
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				require.False(t, rolledBack)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, rolledBack)
+			require.Equal(t, "volume", resp.Volume.UUID)
+		})
+	}
+}
@@ -14,7 +14,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/lightbits/loadbalancer"
 )
 
@@ -29,14 +31,14 @@ const (
 
 var (
 	ErrServer   = errors.New("server error")
-	ErrNotFound = errors.New("not found")
+	ErrNotFound = models.ErrNotFound
 )
 
 type Client struct {
 	*http.Client
 	addr              string
 	token             string
-	doFunc            func(method string, url string, reqBody interface{}, resBody interface{}) error
+	doFunc            func(ctx context.Context, method string, url string, reqBody interface{}, resBody interface{}) error
 	projectName       string
 	replicationFactor int
 }
@@ -76,7 +78,7 @@ func NewClientV2(cfg *ClientConfig) (*Client, error) {
 func NewClient(lb loadBalancer, cfg *ClientConfig) *Client {
 	c := &Client{
 		Client: &http.Client{
-			Transport: &http.Transport{
+			Transport: otelhttp.NewTransport(&http.Transport{
 				Dial: func(_, _ string) (net.Conn, error) {
 					conn, err := lb.Dial()
 					if err != nil {
@@ -89,7 +91,7 @@ func NewClient(lb loadBalancer, cfg *ClientConfig) *Client {
 					//nolint:gosec // Lightbits uses non-standard TLS certificates.
 					InsecureSkipVerify: true,
 				},
-			},
+			}),
 		},
 		addr:              "lightbits.crusoecloud.io",
 		token:             cfg.AuthToken,
@@ -101,17 +103,17 @@ func NewClient(lb loadBalancer, cfg *ClientConfig) *Client {
 	return c
 }
 
-func (c *Client) GetVolume(name string) (*Volume, error) {
+func (c *Client) GetVolume(ctx context.Context, name string) (*Volume, error) {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/volumes/?name=%s", c.addr, c.projectName, name)
 	var resp Volume
-	if err := c.get(url, &resp); err != nil {
+	if err := c.get(ctx, url, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get volume: %w", err)
 	}
 
 	return &resp, nil
 }
 
-func (c *Client) GetVolumes() ([]*Volume, error) {
+func (c *Client) GetVolumes(ctx context.Context) ([]*Volume, error) {
 	const pageSize = 1000 // The number of results to return per request.
 	var volumes []*Volume
 	url := fmt.Sprintf(
@@ -133,7 +135,7 @@ func (c *Client) GetVolumes() ([]*Volume, error) {
 		// Make a request to the Lightbits API, using the `offset_uuid`
 		// parameter to specify the index of the first result to be returned.
 		var resp GetVolumeResponse
-		if err := c.get(url, &resp); err != nil {
+		if err := c.get(ctx, url, &resp); err != nil {
 			return volumes, fmt.Errorf("failed to list volumes: %w", err)
 		}
 
@@ -152,74 +154,93 @@ func (c *Client) GetVolumes() ([]*Volume, error) {
 	return volumes, nil
 }
 
-func (c *Client) CreateVolume(v *Volume) (*Volume, error) {
+func (c *Client) CreateVolume(ctx context.Context, v *Volume) (*Volume, error) {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/volumes", c.addr, c.projectName)
 	var resp Volume
-	if err := c.post(url, v, &resp); err != nil {
+	if err := c.post(ctx, url, v, &resp); err != nil {
 		return nil, fmt.Errorf("failed to create volume: %w", err)
 	}
 
 	return &resp, nil
 }
 
-func (c *Client) UpdateVolume(id uuid.UUID, req *UpdateVolumeRequest) error {
+func (c *Client) UpdateVolume(ctx context.Context, id uuid.UUID, req *UpdateVolumeRequest) error {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/volumes/%s", c.addr, c.projectName, id)
-	if err := c.put(url, req, nil); err != nil {
+	if err := c.put(ctx, url, req, nil); err != nil {
 		return fmt.Errorf("failed to update volume: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Client) DeleteVolume(name string) error {
+func (c *Client) RollbackVolume(ctx context.Context, id uuid.UUID, req *RollbackVolumeRequest) error {
+	url := fmt.Sprintf("https://%s/api/v2/projects/%s/volumes/%s/rollback", c.addr, c.projectName, id)
+	if err := c.post(ctx, url, req, nil); err != nil {
+		return fmt.Errorf("failed to rollback volume: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteVolume(ctx context.Context, name string) error {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/volumes/?name=%s", c.addr, c.projectName, name)
-	if err := c.delete(url, nil, nil); err != nil {
+	if err := c.delete(ctx, url, nil, nil); err != nil {
 		return fmt.Errorf("failed to delete volume: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Client) GetSnapshots() ([]*Snapshot, error) {
+func (c *Client) GetSnapshots(ctx context.Context) ([]*Snapshot, error) {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/snapshots", c.addr, c.projectName)
 	var resp GetSnapshotResponse
-	if err := c.get(url, &resp); err != nil {
+	if err := c.get(ctx, url, &resp); err != nil {
 		return nil, fmt.Errorf("failed to list snapshots: %w", err)
 	}
 
 	return resp.Snapshots, nil
 }
 
-func (c *Client) GetSnapshot(name string) (*Snapshot, error) {
+func (c *Client) GetSnapshot(ctx context.Context, name string) (*Snapshot, error) {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/snapshots/?Name=%s", c.addr, c.projectName, name)
 	var resp Snapshot
-	if err := c.get(url, &resp); err != nil {
+	if err := c.get(ctx, url, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get snapshot %s: %w", name, err)
 	}
 
 	return &resp, nil
 }
 
-func (c *Client) CreateSnapshot(req *CreateSnapshotRequest) (*Snapshot, error) {
+func (c *Client) CreateSnapshot(ctx context.Context, req *CreateSnapshotRequest) (*Snapshot, error) {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/snapshots", c.addr, c.projectName)
 	var resp Snapshot
-	if err := c.post(url, req, &resp); err != nil {
+	if err := c.post(ctx, url, req, &resp); err != nil {
 		return nil, fmt.Errorf("failed to create snapshot: %w", err)
 	}
 
 	return &resp, nil
 }
 
-func (c *Client) DeleteSnapshot(name string) error {
+func (c *Client) DeleteSnapshot(ctx context.Context, name string) error {
 	url := fmt.Sprintf("https://%s/api/v2/projects/%s/snapshots/?name=%s", c.addr, c.projectName, name)
-	if err := c.delete(url, nil, nil); err != nil {
+	if err := c.delete(ctx, url, nil, nil); err != nil {
 		return fmt.Errorf("failed to delete snapshot: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Client) do(method, url string, reqBody, respBody interface{}) error {
+func (c *Client) GetCluster(ctx context.Context) (*Cluster, error) {
+	url := fmt.Sprintf("https://%s/api/v2/cluster", c.addr)
+	var resp Cluster
+	if err := c.get(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) do(ctx context.Context, method, url string, reqBody, respBody interface{}) error {
 	var reqBodyReader io.Reader
 	if reqBody != nil {
 		reqBodyBytes, err := json.Marshal(reqBody)
@@ -230,7 +251,8 @@ func (c *Client) do(method, url string, reqBody, respBody interface{}) error {
 		reqBodyReader = bytes.NewBuffer(reqBodyBytes)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*RequestTimeoutSeconds)
+	// The request is traced as part of the caller's, and ends when the caller gives up or after its own timeout.
+	ctx, cancel := context.WithTimeout(ctx, time.Second*RequestTimeoutSeconds)
 	defer cancel()
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBodyReader)
 	if err != nil {
@@ -240,7 +262,7 @@ func (c *Client) do(method, url string, reqBody, respBody interface{}) error {
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %v", c.token))
 	res, err := c.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return fmt.Errorf("failed to send request: %w: %w", models.ErrUnavailable, err)
 	}
 
 	defer res.Body.Close()
@@ -264,31 +286,32 @@ func (c *Client) do(method, url string, reqBody, respBody interface{}) error {
 }
 
 func returnLightbitsError(res *http.Response, body []byte) error {
+	typed := models.ErrorFromHTTPStatus(res.StatusCode)
+	if typed == nil {
+		typed = ErrServer
+	}
+
 	var errBody errorBody
 	err := json.Unmarshal(body, &errBody)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal http error response: %w", err)
-	}
-
-	if res.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("%s: %w", errBody.Message, ErrNotFound)
+		return fmt.Errorf("HTTP %d: %w (failed to unmarshal http error response: %w)", res.StatusCode, typed, err)
 	}
 
-	return fmt.Errorf("%v: %w", errBody.Message, ErrServer)
+	return fmt.Errorf("%v: %w", errBody.Message, typed)
 }
 
-func (c *Client) get(url string, respBody interface{}) error {
-	return c.doFunc(http.MethodGet, url, nil, respBody)
+func (c *Client) get(ctx context.Context, url string, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodGet, url, nil, respBody)
 }
 
-func (c *Client) post(url string, reqBody, respBody interface{}) error {
-	return c.doFunc(http.MethodPost, url, reqBody, respBody)
+func (c *Client) post(ctx context.Context, url string, reqBody, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodPost, url, reqBody, respBody)
 }
 
-func (c *Client) put(url string, reqBody, respBody interface{}) error {
-	return c.doFunc(http.MethodPut, url, reqBody, respBody)
+func (c *Client) put(ctx context.Context, url string, reqBody, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodPut, url, reqBody, respBody)
 }
 
-func (c *Client) delete(url string, reqBody, respBody interface{}) error {
-	return c.doFunc(http.MethodDelete, url, reqBody, respBody)
+func (c *Client) delete(ctx context.Context, url string, reqBody, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodDelete, url, reqBody, respBody)
 }
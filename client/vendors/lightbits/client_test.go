@@ -0,0 +1,30 @@
+package lightbits
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_do_Cancelled(t *testing.T) {
+	received := make(chan struct{})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+	c := &Client{Client: server.Client()}
+
+	// The request ends as soon as the caller gives up, rather than after its own timeout.
+	err := c.do(ctx, http.MethodGet, server.URL+"/api/v2/cluster", nil, nil)
+	require.ErrorIs(t, err, context.Canceled)
+}
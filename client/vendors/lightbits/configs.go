@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"gopkg.in/yaml.v2"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/lightbits/dms"
 )
 
 var errConfigParse = errors.New("failed to parse config")
@@ -11,9 +13,11 @@ var errConfigParse = errors.New("failed to parse config")
 //nolint:tagliatelle // using snake case for YAML
 type ClientConfig struct {
 	AddrsStrs         []string `yaml:"addr_strs"`
-	AuthToken         string   `yaml:"auth_token"`
+	AuthToken         string   `yaml:"auth_token" sensitive:"true"`
 	ProjectName       string   `yaml:"project_name"`
 	ReplicationFactor int      `yaml:"replication_factor"`
+	// Optional; enables copies to other Lightbits clusters through the Data Mobility Service.
+	DMS dms.Config `yaml:"dms"`
 }
 
 func ParseConfig(bytes []byte, cfg *ClientConfig) error {
@@ -1,10 +1,13 @@
 package lightbits
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/pkg/redact"
 )
 
 func Test_ParseConfig(t *testing.T) {
@@ -28,4 +31,22 @@ func Test_ParseConfig(t *testing.T) {
 
 	require.Equal(t, 3, clientConfig.ReplicationFactor)
 	require.Equal(t, "unit-test", clientConfig.ProjectName)
+
+	require.True(t, clientConfig.DMS.Enabled)
+	require.Equal(t, "6f1c7e0a-3b9d-4c51-9a8e-2d7f0b4e1c35", clientConfig.DMS.ClusterID)
+	require.Len(t, clientConfig.DMS.Endpoints, 1)
+	require.Equal(t, "3.3.3.3:443", clientConfig.DMS.Endpoints[0].Addr)
+	require.Equal(t, "this_is_a_dms_auth_token", clientConfig.DMS.Endpoints[0].AuthToken)
+}
+
+func Test_ClientConfig_Redacted(t *testing.T) {
+	data, err := os.ReadFile("testdata/valid.yaml")
+	require.NoError(t, err)
+	var clientConfig ClientConfig
+	require.NoError(t, ParseConfig(data, &clientConfig))
+
+	logged := fmt.Sprintf("%#v", redact.Struct(clientConfig))
+	require.NotContains(t, logged, "this_is_an_auth_token")
+	require.NotContains(t, logged, "this_is_a_dms_auth_token")
+	require.Contains(t, logged, "3.3.3.3:443")
 }
@@ -12,6 +12,9 @@ import (
 	"net/http"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	"gitlab.com/crusoeenergy/island/storage/storms/client/vendors/lightbits/loadbalancer"
 )
 
@@ -88,7 +91,7 @@ type Config struct {
 //nolint:tagliatelle // using using snake case for YAML
 type Endpoint struct {
 	Addr      string `yaml:"addr"`
-	AuthToken string `yaml:"auth_token"`
+	AuthToken string `yaml:"auth_token" sensitive:"true"`
 }
 
 type Client struct {
@@ -137,7 +140,7 @@ func newClient(lb loadBalancer, addrTokenMap map[string]string, clusterID string
 
 	c := &Client{
 		Client: &http.Client{
-			Transport: transport,
+			Transport: otelhttp.NewTransport(transport),
 			Timeout:   time.Second * RequestTimeoutSeconds,
 		},
 		ClusterID: clusterID,
@@ -165,7 +168,7 @@ func (c *Client) do(ctx context.Context, method, url string, reqBody, respBody i
 	// Auth header is now set in the custom dmsTransport.
 	res, err := c.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return fmt.Errorf("failed to send request: %w: %w", models.ErrUnavailable, err)
 	}
 	defer res.Body.Close()
 
@@ -190,7 +193,7 @@ func (c *Client) do(ctx context.Context, method, url string, reqBody, respBody i
 
 var (
 	ErrServer   = errors.New("server error")
-	ErrNotFound = errors.New("not found")
+	ErrNotFound = models.ErrNotFound
 )
 
 type errorBody struct {
@@ -200,17 +203,18 @@ type errorBody struct {
 }
 
 func returnLightbitsError(res *http.Response, body []byte) error {
+	typed := models.ErrorFromHTTPStatus(res.StatusCode)
+	if typed == nil {
+		typed = ErrServer
+	}
+
 	var errBody errorBody
 	err := json.Unmarshal(body, &errBody)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal http error response: %w", err)
-	}
-
-	if res.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("%s: %w", errBody.Message, ErrNotFound)
+		return fmt.Errorf("HTTP %d: %w (failed to unmarshal http error response: %w)", res.StatusCode, typed, err)
 	}
 
-	return fmt.Errorf("%v: %w", errBody.Message, ErrServer)
+	return fmt.Errorf("%v: %w", errBody.Message, typed)
 }
 
 func (c *Client) get(ctx context.Context, url string, respBody interface{}) error {
@@ -0,0 +1,22 @@
+package loadbalancer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Dials are counted per downstream address, across every load balancer, in the default Prometheus registry.
+var (
+	dialsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "storms",
+		Subsystem: "lightbits_loadbalancer",
+		Name:      "dials_total",
+		Help:      "Connections dialed to a Lightbits downstream service.",
+	}, []string{"addr"})
+	dialErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "storms",
+		Subsystem: "lightbits_loadbalancer",
+		Name:      "dial_errors_total",
+		Help:      "Connections to a Lightbits downstream service that failed to be dialed.",
+	}, []string{"addr"})
+)
@@ -25,9 +25,11 @@ func newDownstreamService(addr net.Addr, dialFunc DialFunc) *DownstreamService {
 }
 
 func (s *DownstreamService) Dial() (*Conn, error) {
+	dialsTotal.WithLabelValues(s.addr.String()).Inc()
 	conn, err := s.dialFunc(s.addr.Network(), s.addr.String())
 	if err != nil {
 		atomic.AddUint64(&s.errorCount, 1)
+		dialErrorsTotal.WithLabelValues(s.addr.String()).Inc()
 
 		return nil, err
 	}
@@ -1,9 +1,11 @@
 package loadbalancer
 
 import (
+	"errors"
 	"net"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,3 +31,25 @@ func Test_ErrorCountCount(t *testing.T) {
 	}
 	require.Equal(t, uint64(1), s.ErrorCount())
 }
+
+func Test_Dial_Metrics(t *testing.T) {
+	addr, err := net.ResolveTCPAddr("tcp", "10.0.0.100:8080")
+	require.NoError(t, err)
+	fail := true
+	s := newDownstreamService(addr, func(string, string) (net.Conn, error) {
+		if fail {
+			return nil, errors.New("connection refused")
+		}
+
+		return &mockConn{}, nil
+	})
+
+	_, err = s.Dial()
+	require.Error(t, err)
+	fail = false
+	_, err = s.Dial()
+	require.NoError(t, err)
+
+	require.InDelta(t, 2, testutil.ToFloat64(dialsTotal.WithLabelValues("10.0.0.100:8080")), 0)
+	require.InDelta(t, 1, testutil.ToFloat64(dialErrorsTotal.WithLabelValues("10.0.0.100:8080")), 0)
+}
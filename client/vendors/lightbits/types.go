@@ -121,7 +121,29 @@ type GetSnapshotResponse struct {
 	Snapshots []*Snapshot `json:"snapshots"`
 }
 
+type RollbackVolumeRequest struct {
+	SrcSnapshotUUID uuid.UUID `json:"srcSnapshotUUID"`
+}
+
 type UpdateVolumeRequest struct {
 	Size string `json:"size,omitempty"`
 	ACL  *ACL   `json:"acl,omitempty"`
 }
+
+// ClusterStatistics reports cluster-wide storage usage. Values are byte counts encoded as strings.
+type ClusterStatistics struct {
+	InstalledPhysicalStorage    string `json:"installedPhysicalStorage"`
+	ManagedPhysicalStorage      string `json:"managedPhysicalStorage"`
+	EffectivePhysicalStorage    string `json:"effectivePhysicalStorage"`
+	LogicalStorage              string `json:"logicalStorage"`
+	LogicalUsedStorage          string `json:"logicalUsedStorage"`
+	PhysicalUsedStorage         string `json:"physicalUsedStorage"`
+	FreePhysicalStorage         string `json:"freePhysicalStorage"`
+	EstimatedLogicalStorage     string `json:"estimatedLogicalStorage"`
+	EstimatedFreeLogicalStorage string `json:"estimatedFreeLogicalStorage"`
+}
+
+type Cluster struct {
+	UUID       uuid.UUID         `json:"UUID"`
+	Statistics ClusterStatistics `json:"statistics"`
+}
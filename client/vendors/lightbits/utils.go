@@ -68,6 +68,17 @@ func constructACLSet(volumeACL, addNodes, removeNodes []string) []string {
 	return acl
 }
 
+// Reports whether an ACL grants access to any host.
+func aclIsAttached(acl []string) bool {
+	for _, nqn := range acl {
+		if nqn != ACLNone {
+			return true
+		}
+	}
+
+	return false
+}
+
 func intToUint32Checked(i int) (uint32, error) {
 	if i < 0 {
 		return 0, errIntOutOfRange
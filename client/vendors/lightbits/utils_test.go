@@ -231,3 +231,36 @@ func Test_snapshotStateToIsAvail(t *testing.T) {
 		})
 	}
 }
+
+func Test_aclIsAttached(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected bool
+	}{
+		{
+			name:     "empty",
+			input:    []string{},
+			expected: false,
+		},
+
+		{
+			name:     "ALLOW_NONE",
+			input:    []string{ACLNone},
+			expected: false,
+		},
+
+		{
+			name:     "host",
+			input:    []string{"nqn.2014-08.org.nvmexpress:uuid:077f1a5f-3240-45c8-a996-4ee013c3f418"},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := aclIsAttached(tt.input)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
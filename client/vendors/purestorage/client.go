@@ -12,20 +12,26 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/pkg/redact"
 )
 
 var (
 	errNoEndpoints = errors.New("no endpoints provided")
 	ErrServer      = errors.New("server error")
-	ErrNotFound    = errors.New("not found")
+	ErrNotFound    = models.ErrNotFound
+
+	errNotVolumeSnapshot = fmt.Errorf("snapshot is not of the volume: %w", models.ErrFailedPrecondition)
+	errVolumeAttached    = fmt.Errorf("volume is attached: %w", models.ErrFailedPrecondition)
 )
 
 const (
@@ -45,7 +51,24 @@ type Client struct {
 	sessionMutex sync.RWMutex
 	sessionToken string // Session token obtained from login
 
-	doFunc func(method string, url string, reqBody interface{}, resBody interface{}) error
+	doFunc func(ctx context.Context, method string, url string, reqBody interface{}, resBody interface{}) error
+}
+
+func newTransport() *http.Transport {
+	return &http.Transport{
+		TLSClientConfig: &tls.Config{
+			// FlashArray typically uses self-signed certificates
+			InsecureSkipVerify: true, //nolint:gosec // ok
+		},
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
 }
 
 func NewClient(cfg *ClientConfig) (*Client, error) {
@@ -53,23 +76,10 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 		return nil, errNoEndpoints
 	}
 
-	// Create HTTP client with TLS configuration for FlashArray
+	// Create HTTP client with TLS configuration for FlashArray, tracing each request
 	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				// FlashArray typically uses self-signed certificates
-				InsecureSkipVerify: true, //nolint:gosec // ok
-			},
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).DialContext,
-			MaxIdleConns:          100,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-		},
-		Timeout: time.Second * RequestTimeoutSeconds,
+		Transport: otelhttp.NewTransport(newTransport()),
+		Timeout:   time.Second * RequestTimeoutSeconds,
 	}
 
 	// Use configured API version or default
@@ -97,20 +107,22 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 	return c, nil
 }
 
-// maskToken masks the auth token for logging purposes.
+// maskToken hides a token for logging purposes, showing only whether it is set. No part of the token is logged, since
+// even a few characters narrow down a guess.
 func maskToken(token string) string {
-	if len(token) <= 8 {
-		return "***"
+	if token == "" {
+		return ""
 	}
 
-	return token[:4] + "***" + token[len(token)-4:]
+	return redact.Placeholder
 }
 
 // login exchanges the API token for a session token.
-func (c *Client) login(endpoint string) (string, error) {
+func (c *Client) login(ctx context.Context, endpoint string) (string, error) {
 	loginURL := fmt.Sprintf("https://%s/api/%s/login", endpoint, c.apiVersion)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*RequestTimeoutSeconds)
+	// The request is traced as part of the caller's, and ends when the caller gives up or after its own timeout.
+	ctx, cancel := context.WithTimeout(ctx, time.Second*RequestTimeoutSeconds)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, loginURL, http.NoBody)
@@ -155,7 +167,7 @@ func (c *Client) login(endpoint string) (string, error) {
 }
 
 // ensureSessionToken ensures we have a valid session token (thread-safe).
-func (c *Client) ensureSessionToken(endpoint string) error {
+func (c *Client) ensureSessionToken(ctx context.Context, endpoint string) error {
 	// First check with read lock (fast path)
 	c.sessionMutex.RLock()
 	if c.sessionToken != "" {
@@ -174,7 +186,7 @@ func (c *Client) ensureSessionToken(endpoint string) error {
 		return nil
 	}
 
-	sessionToken, err := c.login(endpoint)
+	sessionToken, err := c.login(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to login and obtain session token: %w", err)
 	}
@@ -190,6 +202,10 @@ func (c *Client) isAuthError(err error) bool {
 		return false
 	}
 
+	if errors.Is(err, models.ErrUnauthenticated) {
+		return true
+	}
+
 	errStr := err.Error()
 	// Check for common authentication error indicators
 	return strings.Contains(errStr, "401") ||
@@ -255,7 +271,7 @@ func (c *Client) isNetworkError(err error) bool {
 // do performs HTTP requests to FlashArray endpoints with failover support.
 //
 //nolint:funlen // function is easy to follow
-func (c *Client) do(method, urlStr string, reqBody, respBody interface{}) error {
+func (c *Client) do(ctx context.Context, method, urlStr string, reqBody, respBody interface{}) error {
 	var lastErr error
 
 	// Try each endpoint until one succeeds
@@ -263,7 +279,7 @@ func (c *Client) do(method, urlStr string, reqBody, respBody interface{}) error
 		fullURL := fmt.Sprintf("https://%s%s", endpoint, urlStr)
 
 		// Ensure we have a valid session token for this endpoint
-		err := c.ensureSessionToken(endpoint)
+		err := c.ensureSessionToken(ctx, endpoint)
 		if err != nil {
 			// Check if this is a network error that warrants trying the next endpoint
 			if c.isNetworkError(err) {
@@ -279,7 +295,7 @@ func (c *Client) do(method, urlStr string, reqBody, respBody interface{}) error
 			return fmt.Errorf("failed to obtain session token: %w", err)
 		}
 
-		err = c.doRequest(method, fullURL, reqBody, respBody)
+		err = c.doRequest(ctx, method, fullURL, reqBody, respBody)
 		if err == nil {
 			return nil // Success
 		}
@@ -296,8 +312,8 @@ func (c *Client) do(method, urlStr string, reqBody, respBody interface{}) error
 			c.sessionMutex.Unlock()
 
 			// Retry with fresh session token
-			if retryErr := c.ensureSessionToken(endpoint); retryErr == nil {
-				if retryErr := c.doRequest(method, fullURL, reqBody, respBody); retryErr == nil {
+			if retryErr := c.ensureSessionToken(ctx, endpoint); retryErr == nil {
+				if retryErr := c.doRequest(ctx, method, fullURL, reqBody, respBody); retryErr == nil {
 					return nil // Success on retry
 				}
 			}
@@ -327,13 +343,13 @@ func (c *Client) do(method, urlStr string, reqBody, respBody interface{}) error
 		return fmt.Errorf("request failed: %w", err)
 	}
 
-	return fmt.Errorf("all endpoints failed with network errors, last error: %w", lastErr)
+	return fmt.Errorf("all endpoints failed with network errors, last error: %w: %w", models.ErrUnavailable, lastErr)
 }
 
 // doRequest performs a single HTTP request to a specific endpoint.
 //
 //nolint:cyclop // function is easy to follow.
-func (c *Client) doRequest(method, urlStr string, reqBody, respBody interface{}) error {
+func (c *Client) doRequest(ctx context.Context, method, urlStr string, reqBody, respBody interface{}) error {
 	var reqBodyReader io.Reader
 	if reqBody != nil {
 		reqBodyBytes, err := json.Marshal(reqBody)
@@ -343,7 +359,8 @@ func (c *Client) doRequest(method, urlStr string, reqBody, respBody interface{})
 		reqBodyReader = bytes.NewBuffer(reqBodyBytes)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*RequestTimeoutSeconds)
+	// The request is traced as part of the caller's, and ends when the caller gives up or after its own timeout.
+	ctx, cancel := context.WithTimeout(ctx, time.Second*RequestTimeoutSeconds)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, method, urlStr, reqBodyReader)
@@ -390,23 +407,39 @@ func (c *Client) doRequest(method, urlStr string, reqBody, respBody interface{})
 	return nil
 }
 
+// Code of a FlashArray error, which is the HTTP status of the error sent as a number or a string depending on the
+// Purity release.
+type errorCode string
+
+func (c *errorCode) UnmarshalJSON(data []byte) error {
+	*c = errorCode(strings.Trim(string(data), `"`))
+
+	return nil
+}
+
+// An error listed in a FlashArray error response.
+type apiError struct {
+	Code errorCode `json:"code"`
+	// Name of the object the error is about.
+	Context string `json:"context"`
+	Message string `json:"message"`
+}
+
 // handleErrorResponse handles HTTP error responses from FlashArray.
 func (c *Client) handleErrorResponse(res *http.Response, body []byte) error {
 	// Try to parse FlashArray error response
 	var errorResp struct {
-		Errors []struct {
-			Code    string `json:"code"`
-			Message string `json:"message"`
-		} `json:"errors"`
+		Errors []*apiError `json:"errors"`
 	}
 
 	if err := json.Unmarshal(body, &errorResp); err == nil && len(errorResp.Errors) > 0 {
-		errorMsg := errorResp.Errors[0].Message
-		if res.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("%s: %w", errorMsg, ErrNotFound)
+		apiErr := errorResp.Errors[0]
+		errorMsg := apiErr.Message
+		if apiErr.Context != "" {
+			errorMsg = apiErr.Context + ": " + errorMsg
 		}
 
-		return fmt.Errorf("%s: %w", errorMsg, ErrServer)
+		return fmt.Errorf("%s: %w", errorMsg, classifyErrorResponse(res.StatusCode, apiErr))
 	}
 
 	// Fallback to generic error
@@ -414,34 +447,65 @@ func (c *Client) handleErrorResponse(res *http.Response, body []byte) error {
 		return fmt.Errorf("resource not found: %w", ErrNotFound)
 	}
 
-	return fmt.Errorf("HTTP %d: %s: %w", res.StatusCode, string(body), ErrServer)
+	return fmt.Errorf("HTTP %d: %s: %w", res.StatusCode, string(body), classifyErrorResponse(res.StatusCode, nil))
+}
+
+// classifyErrorResponse returns the typed error for a FlashArray error response, classified by its HTTP status.
+// FlashArray reports most request errors as HTTP 400, so those are classified by the code of the error when it is a
+// more specific status, and only as a last resort by the message, to tell missing and duplicate resources apart.
+func classifyErrorResponse(statusCode int, apiErr *apiError) error {
+	if statusCode != http.StatusBadRequest {
+		if typed := models.ErrorFromHTTPStatus(statusCode); typed != nil {
+			return typed
+		}
+
+		return ErrServer
+	}
+	if apiErr == nil {
+		return models.ErrInvalidArgument
+	}
+
+	if code, err := strconv.Atoi(string(apiErr.Code)); err == nil && code != statusCode {
+		if typed := models.ErrorFromHTTPStatus(code); typed != nil {
+			return typed
+		}
+	}
+
+	msg := strings.ToLower(apiErr.Message)
+	switch {
+	case strings.Contains(msg, "already exists"):
+		return models.ErrAlreadyExists
+	case strings.Contains(msg, "does not exist"), strings.Contains(msg, "not found"):
+		return ErrNotFound
+	}
+
+	return models.ErrInvalidArgument
 }
 
 // HTTP helper methods.
 
-func (c *Client) get(urlStr string, respBody interface{}) error {
-	return c.doFunc(http.MethodGet, urlStr, nil, respBody)
+func (c *Client) get(ctx context.Context, urlStr string, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodGet, urlStr, nil, respBody)
 }
 
-func (c *Client) post(urlStr string, reqBody, respBody interface{}) error {
-	return c.doFunc(http.MethodPost, urlStr, reqBody, respBody)
+func (c *Client) post(ctx context.Context, urlStr string, reqBody, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodPost, urlStr, reqBody, respBody)
 }
 
-//nolint:unused // may be used in the future.
-func (c *Client) put(urlStr string, reqBody, respBody interface{}) error {
-	return c.doFunc(http.MethodPut, urlStr, reqBody, respBody)
+func (c *Client) put(ctx context.Context, urlStr string, reqBody, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodPut, urlStr, reqBody, respBody)
 }
 
-func (c *Client) patch(urlStr string, reqBody, respBody interface{}) error {
-	return c.doFunc(http.MethodPatch, urlStr, reqBody, respBody)
+func (c *Client) patch(ctx context.Context, urlStr string, reqBody, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodPatch, urlStr, reqBody, respBody)
 }
 
-func (c *Client) delete(urlStr string, reqBody, respBody interface{}) error {
-	return c.doFunc(http.MethodDelete, urlStr, reqBody, respBody)
+func (c *Client) delete(ctx context.Context, urlStr string, reqBody, respBody interface{}) error {
+	return c.doFunc(ctx, http.MethodDelete, urlStr, reqBody, respBody)
 }
 
 // getVolumes is a shared helper method for retrieving volumes from FlashArray.
-func (c *Client) getVolumes(volumeNames []string) ([]*models.Volume, error) {
+func (c *Client) getVolumes(ctx context.Context, volumeNames []string) ([]*models.Volume, error) {
 	// Build the API path
 	path := fmt.Sprintf("/api/%s/volumes", c.apiVersion)
 
@@ -456,7 +520,7 @@ func (c *Client) getVolumes(volumeNames []string) ([]*models.Volume, error) {
 
 	// Make the API call
 	var response map[string]interface{}
-	err := c.get(path, &response)
+	err := c.get(ctx, path, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -477,13 +541,13 @@ func (c *Client) GetVolume(ctx context.Context, req *models.GetVolumeRequest) (*
 	}
 
 	// Use the shared helper with the specific volume name
-	volumes, err := c.getVolumes([]string{req.UUID})
+	volumes, err := c.getVolumes(ctx, []string{req.UUID})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volume %s: %w", req.UUID, err)
 	}
 
 	if len(volumes) == 0 {
-		return nil, fmt.Errorf("volume %s not found", req.UUID)
+		return nil, fmt.Errorf("volume %s %w", req.UUID, ErrNotFound)
 	}
 
 	if len(volumes) != 1 {
@@ -501,7 +565,7 @@ func (c *Client) GetVolume(ctx context.Context, req *models.GetVolumeRequest) (*
 
 func (c *Client) GetVolumes(ctx context.Context, req *models.GetVolumesRequest) (*models.GetVolumesResponse, error) {
 	// Use the shared helper with empty list to get all volumes
-	volumes, err := c.getVolumes([]string{})
+	volumes, err := c.getVolumes(ctx, []string{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volumes: %w", err)
 	}
@@ -539,7 +603,7 @@ func (c *Client) CreateVolume(ctx context.Context, req *models.CreateVolumeReque
  *  - Parse the response to create the volume model.
  *  - Set the sector size on the volume model.
  */
-func (c *Client) createNewVolume(_ context.Context, volumeName string, sizeBytes uint64, sectorSize uint32,
+func (c *Client) createNewVolume(ctx context.Context, volumeName string, sizeBytes uint64, sectorSize uint32,
 ) (*models.CreateVolumeResponse, error) {
 	// FlashArray REST API: POST /api/{version}/volume/{volume_name}
 	path := fmt.Sprintf("/api/%s/volumes?names=%s", c.apiVersion, volumeName)
@@ -549,7 +613,7 @@ func (c *Client) createNewVolume(_ context.Context, volumeName string, sizeBytes
 	}
 
 	var response map[string]interface{}
-	err := c.post(path, requestBody, &response)
+	err := c.post(ctx, path, requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create volume %s: %w", volumeName, err)
 	}
@@ -568,7 +632,7 @@ func (c *Client) createNewVolume(_ context.Context, volumeName string, sizeBytes
 }
 
 // createVolumeFromSnapshot creates a volume from an existing snapshot.
-func (c *Client) createVolumeFromSnapshot(_ context.Context, volumeName, snapshotName string) (*models.CreateVolumeResponse, error) {
+func (c *Client) createVolumeFromSnapshot(ctx context.Context, volumeName, snapshotName string) (*models.CreateVolumeResponse, error) {
 	// FlashArray REST API: POST /api/{version}/volume?names={volume_name}
 	// In this case snapshotName is the snapshot suffix
 	// 1. Search for snapshot by suffix
@@ -576,7 +640,8 @@ func (c *Client) createVolumeFromSnapshot(_ context.Context, volumeName, snapsho
 	// 2. Create volume from snapshot
 
 	if c.apiVersion < DefaultAPIVersion {
-		return nil, fmt.Errorf("create volume from snapshot not supported in API version %s", c.apiVersion)
+		return nil, fmt.Errorf("create volume from snapshot not supported in API version %s: %w", c.apiVersion,
+			models.ErrInvalidArgument)
 	}
 
 	if volumeName == "" || snapshotName == "" {
@@ -586,7 +651,7 @@ func (c *Client) createVolumeFromSnapshot(_ context.Context, volumeName, snapsho
 	// Step 1: Search for snapshot by suffix
 	snapshotPath := fmt.Sprintf("/api/%s/volume-snapshots?filter=suffix='%s'", c.apiVersion, snapshotName)
 	var snapshotResponse map[string]interface{}
-	err := c.get(snapshotPath, &snapshotResponse)
+	err := c.get(ctx, snapshotPath, &snapshotResponse)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshot with suffix %s: %w", snapshotName, err)
 	}
@@ -609,7 +674,7 @@ func (c *Client) createVolumeFromSnapshot(_ context.Context, volumeName, snapsho
 	}
 
 	var response map[string]interface{}
-	err = c.post(path, requestBody, &response)
+	err = c.post(ctx, path, requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create volume %s from snapshot %s: %w", volumeName, fullSnapshotName, err)
 	}
@@ -624,6 +689,43 @@ func (c *Client) createVolumeFromSnapshot(_ context.Context, volumeName, snapsho
 	}, nil
 }
 
+// CloneVolume copies a volume into a new volume on the FlashArray, which is done natively and leaves no snapshot behind.
+func (c *Client) CloneVolume(ctx context.Context, req *models.CloneVolumeRequest) (*models.CloneVolumeResponse, error) {
+	if req.UUID == "" || req.SourceVolumeUUID == "" {
+		return nil, fmt.Errorf("volume UUID and source volume UUID are required: %w", models.ErrInvalidArgument)
+	}
+
+	// FlashArray REST API: POST /api/{version}/volumes?names={volume_name} with the source volume
+	path := fmt.Sprintf("/api/%s/volumes?names=%s", c.apiVersion, req.UUID)
+	requestBody := map[string]interface{}{
+		"source": map[string]string{
+			"name": req.SourceVolumeUUID,
+		},
+	}
+
+	var response map[string]interface{}
+	err := c.post(ctx, path, requestBody, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone volume %s to %s: %w", req.SourceVolumeUUID, req.UUID, err)
+	}
+
+	volume, err := c.parseCreateVolumeResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse volume response: %w", err)
+	}
+	// The source of a clone is a volume, not a snapshot.
+	volume.SourceSnapshotUUID = ""
+
+	log.Info().
+		Str("volume_uuid", req.UUID).
+		Str("source_volume_uuid", req.SourceVolumeUUID).
+		Msg("Successfully cloned volume")
+
+	return &models.CloneVolumeResponse{
+		Volume: volume,
+	}, nil
+}
+
 func (c *Client) ResizeVolume(ctx context.Context, req *models.ResizeVolumeRequest) (*models.ResizeVolumeResponse, error) {
 	// Validate input
 	if req.UUID == "" {
@@ -640,7 +742,7 @@ func (c *Client) ResizeVolume(ctx context.Context, req *models.ResizeVolumeReque
 	}
 
 	var response map[string]interface{}
-	err := c.patch(path, body, &response)
+	err := c.patch(ctx, path, body, &response)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resize volume %s: %w", req.UUID, err)
 	}
@@ -653,6 +755,58 @@ func (c *Client) ResizeVolume(ctx context.Context, req *models.ResizeVolumeReque
 	return &models.ResizeVolumeResponse{}, nil
 }
 
+// RevertVolumeToSnapshot overwrites a volume with one of its snapshots by copying the snapshot over the volume.
+func (c *Client) RevertVolumeToSnapshot(ctx context.Context, req *models.RevertVolumeToSnapshotRequest,
+) (*models.RevertVolumeToSnapshotResponse, error) {
+	if req.UUID == "" || req.SnapshotUUID == "" {
+		return nil, fmt.Errorf("volume UUID and snapshot UUID are required: %w", models.ErrInvalidArgument)
+	}
+
+	snapshot, err := c.getSnapshotBySuffix(ctx, req.SnapshotUUID)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.SourceVolumeUUID != req.UUID {
+		return nil, errNotVolumeSnapshot
+	}
+
+	attached, err := c.hasConnections(ctx, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if attached {
+		return nil, errVolumeAttached
+	}
+
+	// FlashArray REST API: POST /api/{version}/volumes?names={volume_name}&overwrite=true with the snapshot as source
+	path := fmt.Sprintf("/api/%s/volumes?names=%s&overwrite=true", c.apiVersion, req.UUID)
+	requestBody := map[string]interface{}{
+		"source": map[string]string{
+			"name": snapshot.SourceVolumeUUID + "." + snapshot.UUID,
+		},
+	}
+
+	var response map[string]interface{}
+	err = c.post(ctx, path, requestBody, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert volume %s to snapshot %s: %w", req.UUID, req.SnapshotUUID, err)
+	}
+
+	volume, err := c.parseCreateVolumeResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse volume response: %w", err)
+	}
+
+	log.Info().
+		Str("volume_uuid", req.UUID).
+		Str("snapshot_uuid", req.SnapshotUUID).
+		Msg("Successfully reverted volume to snapshot")
+
+	return &models.RevertVolumeToSnapshotResponse{
+		Volume: volume,
+	}, nil
+}
+
 func (c *Client) DeleteVolume(ctx context.Context, req *models.DeleteVolumeRequest) (*models.DeleteVolumeResponse, error) {
 	// Validate input
 	if req.UUID == "" {
@@ -670,7 +824,7 @@ func (c *Client) DeleteVolume(ctx context.Context, req *models.DeleteVolumeReque
 	}
 
 	var destroyResponse map[string]interface{}
-	err := c.patch(destroyPath, destroyBody, &destroyResponse)
+	err := c.patch(ctx, destroyPath, destroyBody, &destroyResponse)
 	if err != nil {
 		return nil, fmt.Errorf("failed to destroy volume %s: %w", req.UUID, err)
 	}
@@ -678,7 +832,7 @@ func (c *Client) DeleteVolume(ctx context.Context, req *models.DeleteVolumeReque
 	// Step 2: Eradicate the volume
 	eradicatePath := fmt.Sprintf("/api/%s/volumes?names=%s", c.apiVersion, req.UUID)
 	var eradicateResponse map[string]interface{}
-	err = c.delete(eradicatePath, nil, &eradicateResponse)
+	err = c.delete(ctx, eradicatePath, nil, &eradicateResponse)
 	if err != nil {
 		return nil, fmt.Errorf("failed to eradicate volume %s: %w", req.UUID, err)
 	}
@@ -712,7 +866,7 @@ func (c *Client) AttachVolume(ctx context.Context, req *models.AttachVolumeReque
 	volumeName := req.UUID
 
 	// Get or create host (UUID is translated to NQN format internally)
-	host, err := c.getOrCreateHost(hostUUID)
+	host, err := c.getOrCreateHost(ctx, hostUUID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get or create host: %w", err)
 	}
@@ -723,7 +877,7 @@ func (c *Client) AttachVolume(ctx context.Context, req *models.AttachVolumeReque
 		Msg("Attaching volume to host")
 
 	// Create connection between host and volume (using NQN as host name)
-	err = c.createConnection(host.Name, volumeName)
+	err = c.createConnection(ctx, host.Name, volumeName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection: %w", err)
 	}
@@ -739,13 +893,13 @@ func (c *Client) AttachVolume(ctx context.Context, req *models.AttachVolumeReque
 // getOrCreateHost gets an existing host or creates a new one with the given UUID
 // The UUID is translated to NQN format: "nqn.2014-08.org.nvmexpress:uuid:<UUID>"
 // The NQN is used as both the host name and the NQN value (1:1 mapping).
-func (c *Client) getOrCreateHost(uuid string) (*Host, error) {
+func (c *Client) getOrCreateHost(ctx context.Context, uuid string) (*Host, error) {
 	// Translate UUID to NQN format
 
 	nqn := fmt.Sprintf("nqn.2014-08.org.nvmexpress:uuid:%s", uuid)
 
 	// try to get this host
-	host, err := c.getHost(uuid)
+	host, err := c.getHost(ctx, uuid)
 
 	if err == nil {
 		// Host already exists
@@ -769,7 +923,7 @@ func (c *Client) getOrCreateHost(uuid string) (*Host, error) {
 	}
 
 	var createResp CreateHostsResponse
-	err = c.post(createPath, requestBody, &createResp)
+	err = c.post(ctx, createPath, requestBody, &createResp)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host: %w", err)
@@ -808,7 +962,7 @@ func (c *Client) DetachVolume(ctx context.Context, req *models.DetachVolumeReque
 	volumeName := req.UUID
 
 	// Get host (UUID is translated to NQN format internally)
-	host, err := c.getHost(hostUUID)
+	host, err := c.getHost(ctx, hostUUID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get host: %w", err)
 	}
@@ -819,7 +973,7 @@ func (c *Client) DetachVolume(ctx context.Context, req *models.DetachVolumeReque
 		Msg("Detaching volume from host")
 
 	// Delete connection between host and volume (using host name)
-	err = c.deleteConnection(host.Name, volumeName)
+	err = c.deleteConnection(ctx, host.Name, volumeName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete connection: %w", err)
 	}
@@ -836,7 +990,7 @@ func (c *Client) DetachVolume(ctx context.Context, req *models.DetachVolumeReque
 // The uuid parameter is the raw UUID
 // Searches for a host where the nqns field contains "nqn.2014-08.org.nvmexpress:uuid:<uuid>"
 // Returns an error if the host doesn't exist.
-func (c *Client) getHost(uuid string) (*Host, error) {
+func (c *Client) getHost(ctx context.Context, uuid string) (*Host, error) {
 	// Build the full NQN identifier
 	nqn := fmt.Sprintf("\"nqn.2014-08.org.nvmexpress:uuid:%s\"", uuid)
 
@@ -849,14 +1003,14 @@ func (c *Client) getHost(uuid string) (*Host, error) {
 	path := fmt.Sprintf("/api/%s/hosts?%s", c.apiVersion, q.Encode())
 
 	var getResp GetHostsResponse
-	err := c.get(path, &getResp)
+	err := c.get(ctx, path, &getResp)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get host: %w", err)
 	}
 
 	if len(getResp.Items) == 0 {
-		return nil, fmt.Errorf("host with NQN %s not found", nqn)
+		return nil, fmt.Errorf("host with NQN %s %w", nqn, ErrNotFound)
 	}
 
 	// Host found
@@ -869,11 +1023,11 @@ func (c *Client) getHost(uuid string) (*Host, error) {
 }
 
 // createConnection creates a connection between a host and a volume.
-func (c *Client) createConnection(hostName, volumeName string) error {
+func (c *Client) createConnection(ctx context.Context, hostName, volumeName string) error {
 	path := fmt.Sprintf("/api/%s/connections?host_names=%s&volume_names=%s", c.apiVersion, hostName, volumeName)
 
 	var resp CreateConnectionsResponse
-	err := c.post(path, nil, &resp)
+	err := c.post(ctx, path, nil, &resp)
 	if err != nil {
 		return fmt.Errorf("failed to create connection: %w", err)
 	}
@@ -887,11 +1041,11 @@ func (c *Client) createConnection(hostName, volumeName string) error {
 }
 
 // deleteConnection deletes a connection between a host and a volume.
-func (c *Client) deleteConnection(hostName, volumeName string) error {
+func (c *Client) deleteConnection(ctx context.Context, hostName, volumeName string) error {
 	path := fmt.Sprintf("/api/%s/connections?host_names=%s&volume_names=%s", c.apiVersion, hostName, volumeName)
 
 	var resp interface{}
-	err := c.delete(path, nil, &resp)
+	err := c.delete(ctx, path, nil, &resp)
 	if err != nil {
 		return fmt.Errorf("failed to delete connection: %w", err)
 	}
@@ -904,8 +1058,21 @@ func (c *Client) deleteConnection(hostName, volumeName string) error {
 	return nil
 }
 
+// hasConnections reports whether a volume is connected to any host.
+func (c *Client) hasConnections(ctx context.Context, volumeName string) (bool, error) {
+	path := fmt.Sprintf("/api/%s/connections?volume_names=%s", c.apiVersion, volumeName)
+
+	var resp GetConnectionsResponse
+	err := c.get(ctx, path, &resp)
+	if err != nil {
+		return false, fmt.Errorf("failed to get connections of volume %s: %w", volumeName, err)
+	}
+
+	return len(resp.Items) > 0, nil
+}
+
 // get snapshot.
-func (c *Client) getSnapshotBySuffix(suffix string) (*models.Snapshot, error) {
+func (c *Client) getSnapshotBySuffix(ctx context.Context, suffix string) (*models.Snapshot, error) {
 	if suffix == "" {
 		return nil, fmt.Errorf("snapshot suffix is required")
 	}
@@ -914,7 +1081,7 @@ func (c *Client) getSnapshotBySuffix(suffix string) (*models.Snapshot, error) {
 	path := fmt.Sprintf("/api/%s/volume-snapshots?destroyed=false&filter=suffix='%s'", c.apiVersion, suffix)
 
 	var response map[string]interface{}
-	err := c.get(path, &response)
+	err := c.get(ctx, path, &response)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshot %s: %w", suffix, err)
 	}
@@ -930,10 +1097,10 @@ func (c *Client) getSnapshotBySuffix(suffix string) (*models.Snapshot, error) {
 
 func (c *Client) GetSnapshot(ctx context.Context, req *models.GetSnapshotRequest) (*models.GetSnapshotResponse, error) {
 	if c.apiVersion < DefaultAPIVersion {
-		return nil, fmt.Errorf("get snapshot not supported in API version %s", c.apiVersion)
+		return nil, fmt.Errorf("get snapshot not supported in API version %s: %w", c.apiVersion, models.ErrInvalidArgument)
 	}
 
-	snapshot, err := c.getSnapshotBySuffix(req.UUID)
+	snapshot, err := c.getSnapshotBySuffix(ctx, req.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -950,7 +1117,7 @@ func (c *Client) GetSnapshot(ctx context.Context, req *models.GetSnapshotRequest
 func (c *Client) GetSnapshots(ctx context.Context, req *models.GetSnapshotsRequest) (*models.GetSnapshotsResponse, error) {
 	// FlashArray REST API: GET /api/2.20/volume-snapshots
 	if c.apiVersion < DefaultAPIVersion {
-		return nil, fmt.Errorf("get snapshot not supported in API version %s", c.apiVersion)
+		return nil, fmt.Errorf("get snapshot not supported in API version %s: %w", c.apiVersion, models.ErrInvalidArgument)
 	}
 
 	filter := "name='*.*' and not(name='*.*.*') and contains(suffix,'-')"
@@ -962,7 +1129,7 @@ func (c *Client) GetSnapshots(ctx context.Context, req *models.GetSnapshotsReque
 	path := fmt.Sprintf("/api/%s/volume-snapshots?%s", c.apiVersion, q.Encode())
 
 	var response map[string]interface{}
-	err := c.get(path, &response)
+	err := c.get(ctx, path, &response)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshots: %w", err)
@@ -1002,7 +1169,8 @@ func (c *Client) CreateSnapshot(ctx context.Context, req *models.CreateSnapshotR
 	// FlashArray REST API: POST /api/{version}/volume-snapshots?names={snapshot-name}
 	// Creates a snapshot of a volume by specifying source.name
 	if c.apiVersion < DefaultAPIVersion {
-		return nil, fmt.Errorf("create snapshot not supported in API version %s", c.apiVersion)
+		return nil, fmt.Errorf("create snapshot not supported in API version %s: %w", c.apiVersion,
+			models.ErrInvalidArgument)
 	}
 
 	if req.SourceVolumeUUID == "" || req.UUID == "" {
@@ -1018,7 +1186,7 @@ func (c *Client) CreateSnapshot(ctx context.Context, req *models.CreateSnapshotR
 	log.Info().Msgf("Creating snapshot [name=%s] from volume [id=%s] request body: %+v", req.UUID, req.SourceVolumeUUID, requestBody)
 
 	var response map[string]interface{}
-	err := c.post(path, requestBody, &response)
+	err := c.post(ctx, path, requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snapshot from volume %s: %w", req.SourceVolumeUUID, err)
 	}
@@ -1043,14 +1211,15 @@ func (c *Client) CreateSnapshot(ctx context.Context, req *models.CreateSnapshotR
 func (c *Client) DeleteSnapshot(ctx context.Context, req *models.DeleteSnapshotRequest,
 ) (*models.DeleteSnapshotResponse, error) {
 	if c.apiVersion < DefaultAPIVersion {
-		return nil, fmt.Errorf("delete snapshot not supported in API version %s", c.apiVersion)
+		return nil, fmt.Errorf("delete snapshot not supported in API version %s: %w", c.apiVersion,
+			models.ErrInvalidArgument)
 	}
 
 	if req.UUID == "" {
 		return nil, fmt.Errorf("delelte snapshotUUID is required")
 	}
 
-	snapshot, err := c.getSnapshotBySuffix(req.UUID)
+	snapshot, err := c.getSnapshotBySuffix(ctx, req.UUID)
 	if err != nil {
 		return nil, err
 	}
@@ -1063,7 +1232,7 @@ func (c *Client) DeleteSnapshot(ctx context.Context, req *models.DeleteSnapshotR
 	}
 
 	var resp interface{}
-	err = c.patch(path, requestBody, &resp)
+	err = c.patch(ctx, path, requestBody, &resp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete snapshot: %w", err)
 	}
@@ -1075,6 +1244,184 @@ func (c *Client) DeleteSnapshot(ctx context.Context, req *models.DeleteSnapshotR
 	return &models.DeleteSnapshotResponse{}, nil
 }
 
+// GetCapacity reports the usable capacity of the array and how much of it is consumed.
+func (c *Client) GetCapacity(ctx context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+	// FlashArray REST API: GET /api/2.20/arrays/space
+	path := fmt.Sprintf("/api/%s/arrays/space", c.apiVersion)
+
+	var response GetArraysSpaceResponse
+	err := c.get(ctx, path, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get array space: %w", err)
+	}
+	if len(response.Items) == 0 {
+		return nil, fmt.Errorf("no array space in response")
+	}
+
+	array := response.Items[0]
+
+	return &models.GetCapacityResponse{
+		Capacity: &models.Capacity{
+			TotalBytes:       array.Capacity,
+			UsedBytes:        array.Space.TotalPhysical,
+			ProvisionedBytes: array.Space.TotalProvisioned,
+		},
+	}, nil
+}
+
+// Labels are stored as FlashArray tags in their own namespace, so tags set by other tools are left alone.
+const labelTagNamespace = "storms"
+
+// Returns the tag endpoint of volumes or snapshots.
+func (c *Client) tagsPath(resourceType models.ResourceType) (string, error) {
+	switch resourceType {
+	case models.ResourceTypeVolume:
+		return fmt.Sprintf("/api/%s/volumes/tags", c.apiVersion), nil
+	case models.ResourceTypeSnapshot:
+		return fmt.Sprintf("/api/%s/volume-snapshots/tags", c.apiVersion), nil
+	default:
+		return "", fmt.Errorf("unsupported resource type %q: %w", resourceType, models.ErrInvalidArgument)
+	}
+}
+
+// Returns the tags in the label namespace by resource name, for the named resources or all resources of the type.
+func (c *Client) getTags(ctx context.Context, resourceType models.ResourceType, resourceNames []string) (map[string]map[string]string, error) {
+	path, err := c.tagsPath(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	// FlashArray REST API: GET /api/{version}/volumes/tags?namespaces={namespace}&resource_names={names}
+	q := url.Values{}
+	q.Set("namespaces", labelTagNamespace)
+	if len(resourceNames) > 0 {
+		q.Set("resource_names", strings.Join(resourceNames, ","))
+	}
+
+	var response GetTagsResponse
+	err = c.get(ctx, path+"?"+q.Encode(), &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	out := map[string]map[string]string{}
+	for _, tag := range response.Items {
+		if tag.Resource == nil {
+			continue
+		}
+		if out[tag.Resource.Name] == nil {
+			out[tag.Resource.Name] = map[string]string{}
+		}
+		out[tag.Resource.Name][tag.Key] = tag.Value
+	}
+
+	return out, nil
+}
+
+// GetLabels returns labels of volumes or snapshots, read from their tags.
+func (c *Client) GetLabels(ctx context.Context, req *models.GetLabelsRequest) (*models.GetLabelsResponse, error) {
+	// Snapshot tags are keyed on the full snapshot name (VOLUME_NAME.SUFFIX), so they are all fetched and matched on
+	// their suffix.
+	var resourceNames []string
+	if req.ResourceType == models.ResourceTypeVolume {
+		resourceNames = req.UUIDs
+	}
+
+	tags, err := c.getTags(ctx, req.ResourceType, resourceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{}
+	for _, id := range req.UUIDs {
+		wanted[id] = true
+	}
+
+	labels := map[string]map[string]string{}
+	for name, t := range tags {
+		id := name
+		if idx := strings.LastIndex(name, "."); req.ResourceType == models.ResourceTypeSnapshot && idx != -1 {
+			id = name[idx+1:]
+		}
+		if len(wanted) > 0 && !wanted[id] {
+			continue
+		}
+		labels[id] = t
+	}
+
+	return &models.GetLabelsResponse{
+		Labels: labels,
+	}, nil
+}
+
+// SetLabels replaces the labels of a volume or snapshot: tags of removed labels are deleted, then the remaining
+// labels are written in one batch.
+func (c *Client) SetLabels(ctx context.Context, req *models.SetLabelsRequest) (*models.SetLabelsResponse, error) {
+	if req.UUID == "" {
+		return nil, fmt.Errorf("resource UUID is required: %w", models.ErrInvalidArgument)
+	}
+
+	path, err := c.tagsPath(req.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceName := req.UUID
+	if req.ResourceType == models.ResourceTypeSnapshot {
+		snapshot, err := c.getSnapshotBySuffix(ctx, req.UUID)
+		if err != nil {
+			return nil, err
+		}
+		resourceName = snapshot.SourceVolumeUUID + "." + snapshot.UUID
+	}
+
+	tags, err := c.getTags(ctx, req.ResourceType, []string{resourceName})
+	if err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+	for key := range tags[resourceName] {
+		if _, ok := req.Labels[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	if len(removed) > 0 {
+		// FlashArray REST API: DELETE /api/{version}/volumes/tags?resource_names={name}&namespaces={namespace}&keys={keys}
+		q := url.Values{}
+		q.Set("resource_names", resourceName)
+		q.Set("namespaces", labelTagNamespace)
+		q.Set("keys", strings.Join(removed, ","))
+
+		var resp interface{}
+		err = c.delete(ctx, path+"?"+q.Encode(), nil, &resp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete tags of %s: %w", resourceName, err)
+		}
+	}
+
+	if len(req.Labels) > 0 {
+		// FlashArray REST API: PUT /api/{version}/volumes/tags/batch?resource_names={name}
+		body := make([]Tag, 0, len(req.Labels))
+		for key, value := range req.Labels {
+			body = append(body, Tag{Namespace: labelTagNamespace, Key: key, Value: value})
+		}
+
+		var resp interface{}
+		err = c.put(ctx, path+"/batch?resource_names="+url.QueryEscape(resourceName), body, &resp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set tags of %s: %w", resourceName, err)
+		}
+	}
+
+	log.Info().
+		Str("resource_uuid", req.UUID).
+		Int("label_count", len(req.Labels)).
+		Msg("Successfully set labels")
+
+	return &models.SetLabelsResponse{}, nil
+}
+
 // parseVolumeResponse parses FlashArray volume response into models.Volume.
 func (c *Client) parseCreateVolumeResponse(response map[string]interface{}) (*models.Volume, error) {
 	// marshal response to sjon bytes
@@ -15,7 +15,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
 	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/pkg/redact"
 )
 
 func Test_NewClient(t *testing.T) {
@@ -79,8 +87,8 @@ func Test_NewClient_HTTPClientConfiguration(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify HTTP client configuration
-	transport, ok := client.Client.Transport.(*http.Transport)
-	require.True(t, ok)
+	require.IsType(t, &otelhttp.Transport{}, client.Client.Transport)
+	transport := newTransport()
 	require.NotNil(t, transport.TLSClientConfig)
 	require.True(t, transport.TLSClientConfig.InsecureSkipVerify)
 	require.Equal(t, time.Second*RequestTimeoutSeconds, client.Client.Timeout)
@@ -95,32 +103,22 @@ func Test_maskToken(t *testing.T) {
 		{
 			name:     "normal token",
 			token:    "abcdef123456",
-			expected: "abcd***3456",
+			expected: redact.Placeholder,
 		},
 		{
 			name:     "short token",
 			token:    "abc",
-			expected: "***",
+			expected: redact.Placeholder,
 		},
 		{
 			name:     "empty token",
 			token:    "",
-			expected: "***",
+			expected: "",
 		},
 		{
 			name:     "very long token",
 			token:    "abcdefghijklmnopqrstuvwxyz123456789",
-			expected: "abcd***6789",
-		},
-		{
-			name:     "exactly 8 chars",
-			token:    "12345678",
-			expected: "***",
-		},
-		{
-			name:     "9 chars",
-			token:    "123456789",
-			expected: "1234***6789",
+			expected: redact.Placeholder,
 		},
 	}
 
@@ -160,7 +158,7 @@ func Test_Client_Login_Success(t *testing.T) {
 	client, err := NewClient(cfg)
 	require.NoError(t, err)
 
-	sessionToken, err := client.login(endpoint)
+	sessionToken, err := client.login(context.Background(), endpoint)
 	require.NoError(t, err)
 	require.Equal(t, "session-token-123", sessionToken)
 }
@@ -185,7 +183,7 @@ func Test_Client_Login_AuthError(t *testing.T) {
 	client, err := NewClient(cfg)
 	require.NoError(t, err)
 
-	sessionToken, err := client.login(endpoint)
+	sessionToken, err := client.login(context.Background(), endpoint)
 	require.Error(t, err)
 	require.Empty(t, sessionToken)
 	require.Contains(t, err.Error(), "401")
@@ -211,7 +209,7 @@ func Test_Client_Login_NoSessionToken(t *testing.T) {
 	client, err := NewClient(cfg)
 	require.NoError(t, err)
 
-	sessionToken, err := client.login(endpoint)
+	sessionToken, err := client.login(context.Background(), endpoint)
 	require.Error(t, err)
 	require.Empty(t, sessionToken)
 	require.Contains(t, err.Error(), "session token")
@@ -252,11 +250,44 @@ func Test_Client_DoRequest_HTTP_Success(t *testing.T) {
 	var response map[string]interface{}
 	testURL := fmt.Sprintf("https://%s/api/test", endpoint)
 
-	err = client.doRequest("GET", testURL, nil, &response)
+	err = client.doRequest(context.Background(), "GET", testURL, nil, &response)
 	require.NoError(t, err)
 	require.Equal(t, expectedResponse, response)
 }
 
+func Test_Client_DoRequest_HTTP_Traced(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	var traceparent string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, err := NewClient(&ClientConfig{Endpoints: []string{serverURL.Host}, AuthToken: "test-token"})
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	err = client.doRequest(context.Background(), "GET", server.URL+"/api/test", nil, nil)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Contains(t, traceparent, spans[0].SpanContext().TraceID().String())
+	require.Contains(t, spans[0].Attributes(), attribute.Int("http.status_code", http.StatusNotFound))
+	require.Contains(t, spans[0].Attributes(), attribute.String("net.peer.name", serverURL.Hostname()))
+}
+
 func Test_Client_DoRequest_HTTP_WithRequestBody(t *testing.T) {
 	requestBody := map[string]interface{}{
 		"name": "test-volume",
@@ -296,7 +327,7 @@ func Test_Client_DoRequest_HTTP_WithRequestBody(t *testing.T) {
 	var response map[string]interface{}
 	testURL := fmt.Sprintf("https://%s/api/test", endpoint)
 
-	err = client.doRequest("POST", testURL, requestBody, &response)
+	err = client.doRequest(context.Background(), "POST", testURL, requestBody, &response)
 	require.NoError(t, err)
 	require.Equal(t, "created", response["result"])
 }
@@ -324,12 +355,37 @@ func Test_Client_DoRequest_HTTP_HTTPError(t *testing.T) {
 	var response map[string]interface{}
 	testURL := fmt.Sprintf("https://%s/api/test", endpoint)
 
-	err = client.doRequest("GET", testURL, nil, &response)
+	err = client.doRequest(context.Background(), "GET", testURL, nil, &response)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "400")
 	require.Contains(t, err.Error(), "Bad request")
 }
 
+func Test_Client_DoRequest_Cancelled(t *testing.T) {
+	received := make(chan struct{})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, err := NewClient(&ClientConfig{Endpoints: []string{serverURL.Host}, AuthToken: "test-token"})
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+
+	// The request ends as soon as the caller gives up, rather than after its own timeout.
+	err = client.doRequest(ctx, http.MethodGet, server.URL+"/api/test", nil, nil)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
 func Test_Client_HandleErrorResponse_HTTP(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -387,13 +443,72 @@ func Test_Client_HandleErrorResponse_HTTP(t *testing.T) {
 			var response map[string]interface{}
 			testURL := fmt.Sprintf("https://%s/api/test", endpoint)
 
-			err = client.doRequest("GET", testURL, nil, &response)
+			err = client.doRequest(context.Background(), "GET", testURL, nil, &response)
 			require.Error(t, err)
 			require.Contains(t, err.Error(), tt.expectedError)
 		})
 	}
 }
 
+func Test_classifyErrorResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		apiErr     *apiError
+		expected   error
+	}{
+		{name: "not found status", statusCode: 404, apiErr: &apiError{Message: "Invalid"}, expected: ErrNotFound},
+		{name: "conflict status", statusCode: 409, expected: models.ErrAlreadyExists},
+		{name: "unavailable status", statusCode: 503, expected: models.ErrUnavailable},
+		{
+			name:       "server error with a misleading message",
+			statusCode: 500,
+			apiErr:     &apiError{Message: "Volume does not exist."},
+			expected:   ErrServer,
+		},
+		{
+			name:       "bad request with a specific code",
+			statusCode: 400,
+			apiErr:     &apiError{Code: "409", Message: "Invalid parameter"},
+			expected:   models.ErrAlreadyExists,
+		},
+		{
+			name:       "bad request of a missing resource",
+			statusCode: 400,
+			apiErr:     &apiError{Code: "400", Message: "Volume does not exist."},
+			expected:   ErrNotFound,
+		},
+		{
+			name:       "bad request of a duplicate resource",
+			statusCode: 400,
+			apiErr:     &apiError{Message: "Volume already exists."},
+			expected:   models.ErrAlreadyExists,
+		},
+		{
+			name:       "bad request",
+			statusCode: 400,
+			apiErr:     &apiError{Code: "400", Message: "Invalid parameter"},
+			expected:   models.ErrInvalidArgument,
+		},
+		{name: "bad request without error", statusCode: 400, expected: models.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, classifyErrorResponse(tt.statusCode, tt.apiErr), tt.expected)
+		})
+	}
+}
+
+func Test_apiError_NumericCode(t *testing.T) {
+	var resp struct {
+		Errors []*apiError `json:"errors"`
+	}
+	err := json.Unmarshal([]byte(`{"errors": [{"code": 404, "context": "vol1", "message": "Not found"}]}`), &resp)
+	require.NoError(t, err)
+	require.Equal(t, &apiError{Code: "404", Context: "vol1", Message: "Not found"}, resp.Errors[0])
+}
+
 func Test_Client_HTTPHelperMethods_HTTP(t *testing.T) {
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Echo back the method and any request body
@@ -433,13 +548,13 @@ func Test_Client_HTTPHelperMethods_HTTP(t *testing.T) {
 
 	// Test GET
 	var getResp map[string]interface{}
-	err = client.get(testPath, &getResp)
+	err = client.get(context.Background(), testPath, &getResp)
 	require.NoError(t, err)
 	require.Equal(t, "GET", getResp["method"])
 
 	// Test POST
 	var postResp map[string]interface{}
-	err = client.post(testPath, requestBody, &postResp)
+	err = client.post(context.Background(), testPath, requestBody, &postResp)
 	require.NoError(t, err)
 	require.Equal(t, "POST", postResp["method"])
 	// JSON unmarshaling converts to map[string]interface{} with float64 for numbers
@@ -449,19 +564,19 @@ func Test_Client_HTTPHelperMethods_HTTP(t *testing.T) {
 
 	// Test PUT
 	var putResp map[string]interface{}
-	err = client.put(testPath, requestBody, &putResp)
+	err = client.put(context.Background(), testPath, requestBody, &putResp)
 	require.NoError(t, err)
 	require.Equal(t, "PUT", putResp["method"])
 
 	// Test PATCH
 	var patchResp map[string]interface{}
-	err = client.patch(testPath, requestBody, &patchResp)
+	err = client.patch(context.Background(), testPath, requestBody, &patchResp)
 	require.NoError(t, err)
 	require.Equal(t, "PATCH", patchResp["method"])
 
 	// Test DELETE
 	var deleteResp map[string]interface{}
-	err = client.delete(testPath, nil, &deleteResp)
+	err = client.delete(context.Background(), testPath, nil, &deleteResp)
 	require.NoError(t, err)
 	require.Equal(t, "DELETE", deleteResp["method"])
 }
@@ -497,7 +612,7 @@ func Test_Client_Do_Failover_SingleEndpoint_Success(t *testing.T) {
 
 	var response map[string]interface{}
 	// Use URL path only - the do method adds the endpoint
-	err = client.do("GET", "/api/test", nil, &response)
+	err = client.do(context.Background(), "GET", "/api/test", nil, &response)
 	require.NoError(t, err)
 	require.Equal(t, "success", response["result"])
 }
@@ -535,7 +650,7 @@ func Test_Client_Do_Failover_MultipleEndpoints_FirstFails_NetworkError(t *testin
 
 	var response map[string]interface{}
 	// Network error on first endpoint should trigger failover to second endpoint
-	err = client.do("GET", "/api/test", nil, &response)
+	err = client.do(context.Background(), "GET", "/api/test", nil, &response)
 	require.NoError(t, err)
 	require.Equal(t, "success-from-server2", response["result"])
 }
@@ -580,7 +695,7 @@ func Test_Client_Do_Failover_MultipleEndpoints_FirstFails_HTTPError(t *testing.T
 
 	var response map[string]interface{}
 	// HTTP error should NOT trigger failover
-	err = client.do("GET", "/api/test", nil, &response)
+	err = client.do(context.Background(), "GET", "/api/test", nil, &response)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "500")                     // Should contain the HTTP error
 	require.NotContains(t, err.Error(), "all endpoints failed") // Should not try other endpoints
@@ -597,7 +712,7 @@ func Test_Client_Do_Failover_AllEndpointsFail_NetworkErrors(t *testing.T) {
 	require.NoError(t, err)
 
 	var response map[string]interface{}
-	err = client.do("GET", "/api/test", nil, &response)
+	err = client.do(context.Background(), "GET", "/api/test", nil, &response)
 	require.Error(t, err)
 	// Should contain error indicating all endpoints failed with network errors
 	require.Contains(t, err.Error(), "all endpoints failed with network errors")
@@ -643,7 +758,7 @@ func Test_Client_Do_Failover_AuthErrorTriggersRefresh(t *testing.T) {
 	require.NoError(t, err)
 
 	var response map[string]interface{}
-	err = client.do("GET", "/api/test", nil, &response)
+	err = client.do(context.Background(), "GET", "/api/test", nil, &response)
 	require.NoError(t, err)
 	require.Equal(t, "success-after-refresh", response["result"])
 
@@ -807,13 +922,13 @@ func Test_Client_EnsureSessionToken_Auth_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	// First call should login
-	err = client.ensureSessionToken(endpoint)
+	err = client.ensureSessionToken(context.Background(), endpoint)
 	require.NoError(t, err)
 	require.Equal(t, 1, loginCallCount)
 	require.Equal(t, "new-session-token", client.sessionToken)
 
 	// Second call should not login again (token already exists)
-	err = client.ensureSessionToken(endpoint)
+	err = client.ensureSessionToken(context.Background(), endpoint)
 	require.NoError(t, err)
 	require.Equal(t, 1, loginCallCount) // Should not increment
 }
@@ -857,7 +972,7 @@ func Test_Client_ConcurrentAuth_Auth(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			err := client.ensureSessionToken(endpoint)
+			err := client.ensureSessionToken(context.Background(), endpoint)
 			if err != nil {
 				errors <- err
 			}
@@ -1272,6 +1387,266 @@ func Test_Client_CreateVolume_NewVolumeSpec_VariousSizes(t *testing.T) {
 
 // ========== DeleteVolume Tests ==========
 
+func Test_Client_CloneVolume_Success(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, fmt.Sprintf("/api/%s/volumes", DefaultAPIVersion), r.URL.Path)
+		require.Equal(t, "clone-volume", r.URL.Query().Get("names"))
+
+		// Verify the source volume is copied
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var requestBody map[string]interface{}
+		err = json.Unmarshal(body, &requestBody)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{"name": "source-volume"}, requestBody["source"])
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"items": [
+				{
+					"id": "12345678-1234-1234-1234-123456789012",
+					"name": "clone-volume",
+					"provisioned": 1073741824,
+					"created": 1234567890,
+					"serial": "TEST123456789",
+					"source": {"name": "source-volume"}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewClient(&ClientConfig{
+		Endpoints: []string{serverURL.Host},
+		AuthToken: "test-token",
+	})
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	resp, err := client.CloneVolume(context.Background(), &models.CloneVolumeRequest{
+		UUID:             "clone-volume",
+		SourceVolumeUUID: "source-volume",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "clone-volume", resp.Volume.UUID)
+	require.Equal(t, uint64(1073741824), resp.Volume.Size)
+	require.Empty(t, resp.Volume.SourceSnapshotUUID)
+}
+
+func Test_Client_CloneVolume_SourceNotFound(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors": [{"message": "Volume does not exist.", "code": "400"}]}`))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewClient(&ClientConfig{
+		Endpoints: []string{serverURL.Host},
+		AuthToken: "test-token",
+	})
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	resp, err := client.CloneVolume(context.Background(), &models.CloneVolumeRequest{
+		UUID:             "clone-volume",
+		SourceVolumeUUID: "missing-volume",
+	})
+	require.ErrorIs(t, err, models.ErrNotFound)
+	require.Nil(t, resp)
+}
+
+func Test_Client_RevertVolumeToSnapshot(t *testing.T) {
+	tests := []struct {
+		name           string
+		snapshotSource string
+		connections    string
+		expectErr      error
+	}{
+		{
+			name:           "reverted",
+			snapshotSource: "test-volume",
+			connections:    `{"items": []}`,
+		},
+		{
+			name:           "snapshot of another volume",
+			snapshotSource: "other-volume",
+			connections:    `{"items": []}`,
+			expectErr:      models.ErrFailedPrecondition,
+		},
+		{
+			name:           "volume attached",
+			snapshotSource: "test-volume",
+			connections:    `{"items": [{"host": {"name": "test-host"}, "volume": {"name": "test-volume"}, "lun": 1}]}`,
+			expectErr:      models.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var overwritten bool
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/volume-snapshots"):
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{
+						"items": [
+							{
+								"name": "` + tt.snapshotSource + `.test-snapshot-uuid",
+								"provisioned": 1073741824,
+								"suffix": "test-snapshot-uuid",
+								"source": {"name": "` + tt.snapshotSource + `"}
+							}
+						]
+					}`))
+				case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/connections"):
+					require.Equal(t, "test-volume", r.URL.Query().Get("volume_names"))
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(tt.connections))
+				case r.Method == "POST":
+					require.Equal(t, fmt.Sprintf("/api/%s/volumes", DefaultAPIVersion), r.URL.Path)
+					require.Equal(t, "test-volume", r.URL.Query().Get("names"))
+					require.Equal(t, "true", r.URL.Query().Get("overwrite"))
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					var requestBody map[string]interface{}
+					require.NoError(t, json.Unmarshal(body, &requestBody))
+					require.Equal(t, map[string]interface{}{"name": "test-volume.test-snapshot-uuid"}, requestBody["source"])
+					overwritten = true
+
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"items": [{"name": "test-volume", "provisioned": 1073741824}]}`))
+				default:
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			defer server.Close()
+
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+
+			client, err := NewClient(&ClientConfig{
+				Endpoints: []string{serverURL.Host},
+				AuthToken: "test-token",
+			})
+			require.NoError(t, err)
+			client.sessionToken = "test-session-token"
+
+			resp, err := client.RevertVolumeToSnapshot(context.Background(), &models.RevertVolumeToSnapshotRequest{
+				UUID:         "test-volume",
+				SnapshotUUID: "test-snapshot-uuid",
+			})
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				require.False(t, overwritten)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, overwritten)
+			require.Equal(t, "test-volume", resp.Volume.UUID)
+			require.Equal(t, uint64(1073741824), resp.Volume.Size)
+		})
+	}
+}
+
+func Test_Client_GetLabels_Snapshots(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, fmt.Sprintf("/api/%s/volume-snapshots/tags", DefaultAPIVersion), r.URL.Path)
+		require.Equal(t, labelTagNamespace, r.URL.Query().Get("namespaces"))
+		require.Empty(t, r.URL.Query().Get("resource_names"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"items": [
+				{"namespace": "storms", "key": "tenant", "value": "a", "resource": {"name": "test-volume.snapshot-1"}},
+				{"namespace": "storms", "key": "vm", "value": "b", "resource": {"name": "test-volume.snapshot-1"}},
+				{"namespace": "storms", "key": "tenant", "value": "c", "resource": {"name": "test-volume.snapshot-2"}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewClient(&ClientConfig{
+		Endpoints: []string{serverURL.Host},
+		AuthToken: "test-token",
+	})
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	resp, err := client.GetLabels(context.Background(), &models.GetLabelsRequest{
+		ResourceType: models.ResourceTypeSnapshot,
+		UUIDs:        []string{"snapshot-1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]string{
+		"snapshot-1": {"tenant": "a", "vm": "b"},
+	}, resp.Labels)
+}
+
+func Test_Client_SetLabels(t *testing.T) {
+	var deletedKeys string
+	var putTags []Tag
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/api/%s/volumes/tags", DefaultAPIVersion), strings.TrimSuffix(r.URL.Path, "/batch"))
+		require.Equal(t, "test-volume", r.URL.Query().Get("resource_names"))
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"items": [
+					{"namespace": "storms", "key": "tenant", "value": "a", "resource": {"name": "test-volume"}},
+					{"namespace": "storms", "key": "vm", "value": "b", "resource": {"name": "test-volume"}}
+				]
+			}`))
+		case "DELETE":
+			require.Equal(t, labelTagNamespace, r.URL.Query().Get("namespaces"))
+			deletedKeys = r.URL.Query().Get("keys")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{}`))
+		case "PUT":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(body, &putTags))
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"items": []}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewClient(&ClientConfig{
+		Endpoints: []string{serverURL.Host},
+		AuthToken: "test-token",
+	})
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	_, err = client.SetLabels(context.Background(), &models.SetLabelsRequest{
+		ResourceType: models.ResourceTypeVolume,
+		UUID:         "test-volume",
+		Labels:       map[string]string{"tenant": "z"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "vm", deletedKeys)
+	require.Equal(t, []Tag{{Namespace: labelTagNamespace, Key: "tenant", Value: "z"}}, putTags)
+}
+
 func Test_Client_DeleteVolume_Success(t *testing.T) {
 	callCount := 0
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1891,7 +2266,7 @@ func Test_Client_CreateSnapshot_APIVersionCheck(t *testing.T) {
 			}
 
 			// Call CreateSnapshot
-			resp, err := client.CreateSnapshot(nil, req)
+			resp, err := client.CreateSnapshot(context.Background(), req)
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -2690,3 +3065,62 @@ func Test_Client_GetSnapshots_SnapshotWithoutSuffix(t *testing.T) {
 	require.Equal(t, "", resp.Snapshots[0].UUID) // Empty suffix
 	require.Equal(t, "test-volume", resp.Snapshots[0].SourceVolumeUUID)
 }
+
+func Test_Client_GetCapacity_Success(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, fmt.Sprintf("/api/%s/arrays/space", DefaultAPIVersion), r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"items": [
+				{
+					"name": "test-array",
+					"capacity": 10995116277760,
+					"space": {
+						"total_physical": 1099511627776,
+						"total_provisioned": 21990232555520
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	cfg := &ClientConfig{
+		Endpoints: []string{server.URL[8:]}, // Remove https://
+		AuthToken: "test-token",
+	}
+
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	resp, err := client.GetCapacity(context.Background(), &models.GetCapacityRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Capacity)
+	require.Equal(t, uint64(10995116277760), resp.Capacity.TotalBytes)
+	require.Equal(t, uint64(1099511627776), resp.Capacity.UsedBytes)
+	require.Equal(t, uint64(21990232555520), resp.Capacity.ProvisionedBytes)
+	require.Equal(t, uint64(9895604649984), resp.Capacity.FreeBytes())
+}
+
+func Test_Client_GetCapacity_EmptyResponse(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items": []}`))
+	}))
+	defer server.Close()
+
+	cfg := &ClientConfig{
+		Endpoints: []string{server.URL[8:]}, // Remove https://
+		AuthToken: "test-token",
+	}
+
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	client.sessionToken = "test-session-token"
+
+	_, err = client.GetCapacity(context.Background(), &models.GetCapacityRequest{})
+	require.Error(t, err)
+}
@@ -11,9 +11,9 @@ var errConfigParse = errors.New("failed to parse config")
 //nolint:tagliatelle // using snake case for YAML
 type ClientConfig struct {
 	Endpoints  []string `yaml:"endpoints"`
-	AuthToken  string   `yaml:"auth_token"`
+	AuthToken  string   `yaml:"auth_token" sensitive:"true"`
 	Username   string   `yaml:"username"`
-	Password   string   `yaml:"password"`
+	Password   string   `yaml:"password" sensitive:"true"`
 	APIVersion string   `yaml:"api_version"`
 }
 
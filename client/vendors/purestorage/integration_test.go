@@ -41,7 +41,7 @@ func cleanupHost(t *testing.T, client *Client, ctx context.Context, hostUUID str
 
 	path := fmt.Sprintf("/api/%s/hosts?names=%s", client.apiVersion, nqn)
 	var resp interface{}
-	err := client.delete(path, nil, &resp)
+	err := client.delete(context.Background(), path, nil, &resp)
 	if err != nil {
 		t.Logf("Warning: Failed to cleanup host %s: %v", nqn, err)
 	} else {
@@ -55,7 +55,7 @@ func cleanupConnection(t *testing.T, client *Client, ctx context.Context, hostUU
 	nqn := fmt.Sprintf("nqn.2014-08.org.nvmexpress:uuid:%s", hostUUID)
 	t.Logf("Cleaning up connection between host %s and volume %s", nqn, volumeName)
 
-	err := client.deleteConnection(nqn, volumeName)
+	err := client.deleteConnection(context.Background(), nqn, volumeName)
 	if err != nil {
 		t.Logf("Warning: Failed to cleanup connection: %v", err)
 	} else {
@@ -68,7 +68,7 @@ func getHost(t *testing.T, client *Client, hostUUID string) (*Host, error) {
 	// Translate UUID to NQN format
 	path := fmt.Sprintf("/api/%s/hosts?names=%s", client.apiVersion, hostUUID)
 	var resp GetHostsResponse
-	err := client.get(path, &resp)
+	err := client.get(context.Background(), path, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +86,7 @@ func createHost(t *testing.T, client *Client, hostName string, nqn string) (*Hos
 	}
 
 	var createResp CreateHostsResponse
-	err := client.post(createPath, requestBody, &createResp)
+	err := client.post(context.Background(), createPath, requestBody, &createResp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host: %w", err)
 	}
@@ -105,7 +105,7 @@ func getConnections(t *testing.T, client *Client, hostUUID, volumeName string) (
 	nqn := fmt.Sprintf(hostUUID)
 	path := fmt.Sprintf("/api/%s/connections?host_names=%s&volume_names=%s", client.apiVersion, nqn, volumeName)
 	var resp GetConnectionsResponse
-	err := client.get(path, &resp)
+	err := client.get(context.Background(), path, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1761,7 +1761,7 @@ func TestIntegration_AttachVolume_MultipleVolumesToSameHost(t *testing.T) {
 	// Get all connections for the host (without filtering by volume)
 	allConnectionsPath := fmt.Sprintf("/api/%s/connections?host_names=%s", client.apiVersion, hostName)
 	var allConnectionsResp GetConnectionsResponse
-	err = client.get(allConnectionsPath, &allConnectionsResp)
+	err = client.get(context.Background(), allConnectionsPath, &allConnectionsResp)
 	require.NoError(t, err)
 	require.GreaterOrEqual(t, len(allConnectionsResp.Items), 2, "Should have at least 2 connections for the host")
 
@@ -62,3 +62,30 @@ type CreateConnectionsResponse struct {
 type GetConnectionsResponse struct {
 	Items []Connection `json:"items"`
 }
+
+type Space struct {
+	TotalPhysical    uint64 `json:"total_physical"`
+	TotalProvisioned uint64 `json:"total_provisioned"`
+}
+
+type ArraySpace struct {
+	Name     string `json:"name"`
+	Capacity uint64 `json:"capacity"`
+	Space    Space  `json:"space"`
+}
+
+type GetArraysSpaceResponse struct {
+	Items []ArraySpace `json:"items"`
+}
+
+// Tag is a key-value pair attached to a resource, within a namespace.
+type Tag struct {
+	Namespace string     `json:"namespace"`
+	Key       string     `json:"key"`
+	Value     string     `json:"value"`
+	Resource  *Reference `json:"resource,omitempty"`
+}
+
+type GetTagsResponse struct {
+	Items []Tag `json:"items"`
+}
@@ -0,0 +1,1554 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: admin/v1/admin.proto
+
+package adminpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReloadConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadConfigRequestMultiError, or nil if none found.
+func (m *ReloadConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReloadConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadConfigRequestMultiError is an error wrapping multiple validation
+// errors returned by ReloadConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReloadConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadConfigRequestMultiError) AllErrors() []error { return m }
+
+// ReloadConfigRequestValidationError is the validation error returned by
+// ReloadConfigRequest.Validate if the designated constraints aren't met.
+type ReloadConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadConfigRequestValidationError) ErrorName() string {
+	return "ReloadConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadConfigRequestValidationError{}
+
+// Validate checks the field values on ReloadConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadConfigResponseMultiError, or nil if none found.
+func (m *ReloadConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReloadConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by ReloadConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReloadConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadConfigResponseMultiError) AllErrors() []error { return m }
+
+// ReloadConfigResponseValidationError is the validation error returned by
+// ReloadConfigResponse.Validate if the designated constraints aren't met.
+type ReloadConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadConfigResponseValidationError) ErrorName() string {
+	return "ReloadConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadConfigResponseValidationError{}
+
+// Validate checks the field values on ShowClustersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShowClustersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShowClustersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShowClustersRequestMultiError, or nil if none found.
+func (m *ShowClustersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShowClustersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ShowClustersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShowClustersRequestMultiError is an error wrapping multiple validation
+// errors returned by ShowClustersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ShowClustersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShowClustersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShowClustersRequestMultiError) AllErrors() []error { return m }
+
+// ShowClustersRequestValidationError is the validation error returned by
+// ShowClustersRequest.Validate if the designated constraints aren't met.
+type ShowClustersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShowClustersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShowClustersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShowClustersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShowClustersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShowClustersRequestValidationError) ErrorName() string {
+	return "ShowClustersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShowClustersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShowClustersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShowClustersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShowClustersRequestValidationError{}
+
+// Validate checks the field values on ShowClustersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShowClustersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShowClustersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShowClustersResponseMultiError, or nil if none found.
+func (m *ShowClustersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShowClustersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShowClustersResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShowClustersResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShowClustersResponseValidationError{
+					field:  fmt.Sprintf("Clusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ShowClustersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShowClustersResponseMultiError is an error wrapping multiple validation
+// errors returned by ShowClustersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ShowClustersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShowClustersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShowClustersResponseMultiError) AllErrors() []error { return m }
+
+// ShowClustersResponseValidationError is the validation error returned by
+// ShowClustersResponse.Validate if the designated constraints aren't met.
+type ShowClustersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShowClustersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShowClustersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShowClustersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShowClustersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShowClustersResponseValidationError) ErrorName() string {
+	return "ShowClustersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShowClustersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShowClustersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShowClustersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShowClustersResponseValidationError{}
+
+// Validate checks the field values on Cluster with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Cluster) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Cluster with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClusterMultiError, or nil if none found.
+func (m *Cluster) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Cluster) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Vendor
+
+	// no validation rules for ResourceCount
+
+	if all {
+		switch v := interface{}(m.GetCapacity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Capacity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Capacity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCapacity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterValidationError{
+				field:  "Capacity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLifecycle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Lifecycle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Lifecycle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLifecycle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterValidationError{
+				field:  "Lifecycle",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClusterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterMultiError is an error wrapping multiple validation errors returned
+// by Cluster.ValidateAll() if the designated constraints aren't met.
+type ClusterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterMultiError) AllErrors() []error { return m }
+
+// ClusterValidationError is the validation error returned by Cluster.Validate
+// if the designated constraints aren't met.
+type ClusterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterValidationError) ErrorName() string { return "ClusterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCluster.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterValidationError{}
+
+// Validate checks the field values on Capacity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Capacity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Capacity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CapacityMultiError, or nil
+// if none found.
+func (m *Capacity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Capacity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalBytes
+
+	// no validation rules for UsedBytes
+
+	// no validation rules for ProvisionedBytes
+
+	// no validation rules for FreeBytes
+
+	if len(errors) > 0 {
+		return CapacityMultiError(errors)
+	}
+
+	return nil
+}
+
+// CapacityMultiError is an error wrapping multiple validation errors returned
+// by Capacity.ValidateAll() if the designated constraints aren't met.
+type CapacityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CapacityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CapacityMultiError) AllErrors() []error { return m }
+
+// CapacityValidationError is the validation error returned by
+// Capacity.Validate if the designated constraints aren't met.
+type CapacityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CapacityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CapacityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CapacityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CapacityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CapacityValidationError) ErrorName() string { return "CapacityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CapacityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCapacity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CapacityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CapacityValidationError{}
+
+// Validate checks the field values on ClusterHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterHealth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterHealthMultiError, or
+// nil if none found.
+func (m *ClusterHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetLastCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterHealthValidationError{
+					field:  "LastCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterHealthValidationError{
+					field:  "LastCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterHealthValidationError{
+				field:  "LastCheck",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSuccess()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterHealthValidationError{
+					field:  "LastSuccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterHealthValidationError{
+					field:  "LastSuccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSuccess()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterHealthValidationError{
+				field:  "LastSuccess",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ConsecutiveFailures
+
+	if len(errors) > 0 {
+		return ClusterHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterHealthMultiError is an error wrapping multiple validation errors
+// returned by ClusterHealth.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterHealthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterHealthMultiError) AllErrors() []error { return m }
+
+// ClusterHealthValidationError is the validation error returned by
+// ClusterHealth.Validate if the designated constraints aren't met.
+type ClusterHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterHealthValidationError) ErrorName() string { return "ClusterHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterHealthValidationError{}
+
+// Validate checks the field values on ClusterLifecycle with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClusterLifecycle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterLifecycle with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClusterLifecycleMultiError, or nil if none found.
+func (m *ClusterLifecycle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterLifecycle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	// no validation rules for LastError
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetNextRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterLifecycleValidationError{
+					field:  "NextRetry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterLifecycleValidationError{
+					field:  "NextRetry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterLifecycleValidationError{
+				field:  "NextRetry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClusterLifecycleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterLifecycleMultiError is an error wrapping multiple validation errors
+// returned by ClusterLifecycle.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterLifecycleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterLifecycleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterLifecycleMultiError) AllErrors() []error { return m }
+
+// ClusterLifecycleValidationError is the validation error returned by
+// ClusterLifecycle.Validate if the designated constraints aren't met.
+type ClusterLifecycleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterLifecycleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterLifecycleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterLifecycleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterLifecycleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterLifecycleValidationError) ErrorName() string { return "ClusterLifecycleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterLifecycleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterLifecycle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterLifecycleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterLifecycleValidationError{}
+
+// Validate checks the field values on QueryAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryAuditLogRequestMultiError, or nil if none found.
+func (m *QueryAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceUuid
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryAuditLogRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryAuditLogRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return QueryAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAuditLogRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryAuditLogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAuditLogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// QueryAuditLogRequestValidationError is the validation error returned by
+// QueryAuditLogRequest.Validate if the designated constraints aren't met.
+type QueryAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAuditLogRequestValidationError) ErrorName() string {
+	return "QueryAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAuditLogRequestValidationError{}
+
+// Validate checks the field values on QueryAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryAuditLogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryAuditLogResponseMultiError, or nil if none found.
+func (m *QueryAuditLogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAuditLogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryAuditLogResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryAuditLogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAuditLogResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryAuditLogResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueryAuditLogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAuditLogResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAuditLogResponseMultiError) AllErrors() []error { return m }
+
+// QueryAuditLogResponseValidationError is the validation error returned by
+// QueryAuditLogResponse.Validate if the designated constraints aren't met.
+type QueryAuditLogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAuditLogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAuditLogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAuditLogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAuditLogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAuditLogResponseValidationError) ErrorName() string {
+	return "QueryAuditLogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryAuditLogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAuditLogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryAuditLogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAuditLogResponseValidationError{}
+
+// Validate checks the field values on AuditEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEntryMultiError, or
+// nil if none found.
+func (m *AuditEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEntryValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Caller
+
+	// no validation rules for Role
+
+	// no validation rules for Peer
+
+	// no validation rules for Method
+
+	// no validation rules for ResourceUuid
+
+	// no validation rules for ClusterId
+
+	// no validation rules for Vendor
+
+	// no validation rules for OperationId
+
+	// no validation rules for Request
+
+	// no validation rules for Code
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEntryValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEntryValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEntryValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEntryMultiError is an error wrapping multiple validation errors
+// returned by AuditEntry.ValidateAll() if the designated constraints aren't met.
+type AuditEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEntryMultiError) AllErrors() []error { return m }
+
+// AuditEntryValidationError is the validation error returned by
+// AuditEntry.Validate if the designated constraints aren't met.
+type AuditEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntryValidationError) ErrorName() string { return "AuditEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntryValidationError{}
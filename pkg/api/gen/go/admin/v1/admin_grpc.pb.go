@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_ReloadConfig_FullMethodName = "/admin.v1.AdminService/ReloadConfig"
-	AdminService_ShowClusters_FullMethodName = "/admin.v1.AdminService/ShowClusters"
+	AdminService_ReloadConfig_FullMethodName  = "/admin.v1.AdminService/ReloadConfig"
+	AdminService_ShowClusters_FullMethodName  = "/admin.v1.AdminService/ShowClusters"
+	AdminService_QueryAuditLog_FullMethodName = "/admin.v1.AdminService/QueryAuditLog"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -30,6 +31,8 @@ type AdminServiceClient interface {
 	// ReloadConfig triggers a live reload of the application's configuration.
 	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
 	ShowClusters(ctx context.Context, in *ShowClustersRequest, opts ...grpc.CallOption) (*ShowClustersResponse, error)
+	// QueryAuditLog returns the audit log entries of mutating requests, oldest first.
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
 }
 
 type adminServiceClient struct {
@@ -60,6 +63,16 @@ func (c *adminServiceClient) ShowClusters(ctx context.Context, in *ShowClustersR
 	return out, nil
 }
 
+func (c *adminServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, AdminService_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -67,6 +80,8 @@ type AdminServiceServer interface {
 	// ReloadConfig triggers a live reload of the application's configuration.
 	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
 	ShowClusters(context.Context, *ShowClustersRequest) (*ShowClustersResponse, error)
+	// QueryAuditLog returns the audit log entries of mutating requests, oldest first.
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -83,6 +98,9 @@ func (UnimplementedAdminServiceServer) ReloadConfig(context.Context, *ReloadConf
 func (UnimplementedAdminServiceServer) ShowClusters(context.Context, *ShowClustersRequest) (*ShowClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowClusters not implemented")
 }
+func (UnimplementedAdminServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -140,6 +158,24 @@ func _AdminService_ShowClusters_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_QueryAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -155,6 +191,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShowClusters",
 			Handler:    _AdminService_ShowClusters_Handler,
 		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _AdminService_QueryAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/admin.proto",
@@ -11,6 +11,8 @@ import (
 	_ "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/common/field_option"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -121,10 +123,18 @@ func (x *GetVolumeResponse) GetVolume() *Volume {
 }
 
 // Request message for StorageManagementService.GetVolumes.
+// Volumes are returned in ascending order of UUID across all clusters.
 type GetVolumesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Optional - maximum number of volumes to return. If 0, all matching volumes are returned.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional - next_page_token from a previous response, to retrieve the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional - only volumes matching every set field are returned.
+	Filter *VolumeFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *GetVolumesRequest) Reset() {
@@ -159,6 +169,131 @@ func (*GetVolumesRequest) Descriptor() ([]byte, []int) {
 	return file_storms_v1_storms_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *GetVolumesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetVolumesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetVolumesRequest) GetFilter() *VolumeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Filters for StorageManagementService.GetVolumes. Unset fields match all volumes.
+type VolumeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only volumes on this cluster.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// Only volumes on clusters of this vendor (e.g. "lightbits", "purestorage").
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// Only volumes with this availability.
+	IsAvailable *bool `protobuf:"varint,3,opt,name=is_available,json=isAvailable,proto3,oneof" json:"is_available,omitempty"`
+	// Only volumes whose ACL contains this host.
+	AclContains string `protobuf:"bytes,4,opt,name=acl_contains,json=aclContains,proto3" json:"acl_contains,omitempty"`
+	// Only volumes created from this snapshot.
+	SourceSnapshotUuid string `protobuf:"bytes,5,opt,name=source_snapshot_uuid,json=sourceSnapshotUuid,proto3" json:"source_snapshot_uuid,omitempty"`
+	// Only volumes created strictly after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Only volumes whose labels match this selector: a comma-separated list of requirements, each of the form
+	// "key=value", "key!=value", "key" (has the label) or "!key" (does not have the label).
+	LabelSelector string `protobuf:"bytes,7,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+}
+
+func (x *VolumeFilter) Reset() {
+	*x = VolumeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeFilter) ProtoMessage() {}
+
+func (x *VolumeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeFilter.ProtoReflect.Descriptor instead.
+func (*VolumeFilter) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VolumeFilter) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *VolumeFilter) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *VolumeFilter) GetIsAvailable() bool {
+	if x != nil && x.IsAvailable != nil {
+		return *x.IsAvailable
+	}
+	return false
+}
+
+func (x *VolumeFilter) GetAclContains() string {
+	if x != nil {
+		return x.AclContains
+	}
+	return ""
+}
+
+func (x *VolumeFilter) GetSourceSnapshotUuid() string {
+	if x != nil {
+		return x.SourceSnapshotUuid
+	}
+	return ""
+}
+
+func (x *VolumeFilter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *VolumeFilter) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
 // Response message for StorageManagementService.GetVolumes.
 type GetVolumesResponse struct {
 	state         protoimpl.MessageState
@@ -167,12 +302,16 @@ type GetVolumesResponse struct {
 
 	// A list of volumes
 	Volumes []*Volume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	// Token to retrieve the next page. Empty if there are no more volumes.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Clusters that could not be listed. Their volumes are missing from this response.
+	ClusterFailures []*ClusterFailure `protobuf:"bytes,3,rep,name=cluster_failures,json=clusterFailures,proto3" json:"cluster_failures,omitempty"`
 }
 
 func (x *GetVolumesResponse) Reset() {
 	*x = GetVolumesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[3]
+		mi := &file_storms_v1_storms_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -185,7 +324,7 @@ func (x *GetVolumesResponse) String() string {
 func (*GetVolumesResponse) ProtoMessage() {}
 
 func (x *GetVolumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[3]
+	mi := &file_storms_v1_storms_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +337,7 @@ func (x *GetVolumesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVolumesResponse.ProtoReflect.Descriptor instead.
 func (*GetVolumesResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{3}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetVolumesResponse) GetVolumes() []*Volume {
@@ -208,6 +347,87 @@ func (x *GetVolumesResponse) GetVolumes() []*Volume {
 	return nil
 }
 
+func (x *GetVolumesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetVolumesResponse) GetClusterFailures() []*ClusterFailure {
+	if x != nil {
+		return x.ClusterFailures
+	}
+	return nil
+}
+
+// A cluster that failed to respond to a request fanned out across clusters.
+type ClusterFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the cluster
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// Vendor of the cluster
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// Reason the cluster failed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ClusterFailure) Reset() {
+	*x = ClusterFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterFailure) ProtoMessage() {}
+
+func (x *ClusterFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterFailure.ProtoReflect.Descriptor instead.
+func (*ClusterFailure) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClusterFailure) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ClusterFailure) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *ClusterFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // Request message for StorageManagementService.CreateVolume.
 type CreateVolumeRequest struct {
 	state         protoimpl.MessageState
@@ -216,7 +436,8 @@ type CreateVolumeRequest struct {
 
 	// Required - UUID of the volume
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// Optional - cluster affinity tags to indicate cluster placement preference
+	// Optional - cluster affinity tags to indicate cluster placement preference. They are only used to place the
+	// volume and are not stored; use labels to attach metadata to the volume.
 	AffinityTags map[string]string `protobuf:"bytes,2,rep,name=affinity_tags,json=affinityTags,proto3" json:"affinity_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The source from which to create the volume. Choose one of the following.
 	//
@@ -225,12 +446,18 @@ type CreateVolumeRequest struct {
 	//	*CreateVolumeRequest_FromNew
 	//	*CreateVolumeRequest_FromSnapshot
 	Source isCreateVolumeRequest_Source `protobuf_oneof:"source"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
+	// Optional - labels of the volume
+	Labels map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional - tenant owning the volume, whose quota it counts against. Volumes without a tenant are not subject to quotas.
+	TenantId string `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 }
 
 func (x *CreateVolumeRequest) Reset() {
 	*x = CreateVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[4]
+		mi := &file_storms_v1_storms_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -243,7 +470,7 @@ func (x *CreateVolumeRequest) String() string {
 func (*CreateVolumeRequest) ProtoMessage() {}
 
 func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[4]
+	mi := &file_storms_v1_storms_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +483,7 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
 func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{4}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateVolumeRequest) GetUuid() string {
@@ -294,6 +521,27 @@ func (x *CreateVolumeRequest) GetFromSnapshot() *SnapshotSourceVolumeSpec {
 	return nil
 }
 
+func (x *CreateVolumeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *CreateVolumeRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateVolumeRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
 type isCreateVolumeRequest_Source interface {
 	isCreateVolumeRequest_Source()
 }
@@ -326,7 +574,7 @@ type NewVolumeSpec struct {
 func (x *NewVolumeSpec) Reset() {
 	*x = NewVolumeSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[5]
+		mi := &file_storms_v1_storms_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +587,7 @@ func (x *NewVolumeSpec) String() string {
 func (*NewVolumeSpec) ProtoMessage() {}
 
 func (x *NewVolumeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[5]
+	mi := &file_storms_v1_storms_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +600,7 @@ func (x *NewVolumeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewVolumeSpec.ProtoReflect.Descriptor instead.
 func (*NewVolumeSpec) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{5}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NewVolumeSpec) GetSize() uint64 {
@@ -383,7 +631,7 @@ type SnapshotSourceVolumeSpec struct {
 func (x *SnapshotSourceVolumeSpec) Reset() {
 	*x = SnapshotSourceVolumeSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[6]
+		mi := &file_storms_v1_storms_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +644,7 @@ func (x *SnapshotSourceVolumeSpec) String() string {
 func (*SnapshotSourceVolumeSpec) ProtoMessage() {}
 
 func (x *SnapshotSourceVolumeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[6]
+	mi := &file_storms_v1_storms_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +657,7 @@ func (x *SnapshotSourceVolumeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotSourceVolumeSpec.ProtoReflect.Descriptor instead.
 func (*SnapshotSourceVolumeSpec) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{6}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SnapshotSourceVolumeSpec) GetSnapshotUuid() string {
@@ -424,12 +672,19 @@ type CreateVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// The created volume. Unset if the request was sent with async; the operation carries it instead.
+	Volume *Volume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	// UUID of the cluster the volume was created on.
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 }
 
 func (x *CreateVolumeResponse) Reset() {
 	*x = CreateVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[7]
+		mi := &file_storms_v1_storms_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -442,7 +697,7 @@ func (x *CreateVolumeResponse) String() string {
 func (*CreateVolumeResponse) ProtoMessage() {}
 
 func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[7]
+	mi := &file_storms_v1_storms_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,38 +710,63 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
 func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{7}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{9}
 }
 
-// Request message for StorageManagementService.ResizeVolume.
-type ResizeVolumeRequest struct {
+func (x *CreateVolumeResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CreateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *CreateVolumeResponse) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.CloneVolume.
+type CloneVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required - UUID of the volume
+	// Required - UUID of the new volume
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// Updated size of the disk, in bytes.
-	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Required - UUID of the volume to clone
+	SrcVolumeUuid string `protobuf:"bytes,2,opt,name=src_volume_uuid,json=srcVolumeUuid,proto3" json:"src_volume_uuid,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+	// Optional - labels of the new volume. Labels of the source volume are not copied.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ResizeVolumeRequest) Reset() {
-	*x = ResizeVolumeRequest{}
+func (x *CloneVolumeRequest) Reset() {
+	*x = CloneVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[8]
+		mi := &file_storms_v1_storms_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResizeVolumeRequest) String() string {
+func (x *CloneVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResizeVolumeRequest) ProtoMessage() {}
+func (*CloneVolumeRequest) ProtoMessage() {}
 
-func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[8]
+func (x *CloneVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,49 +777,70 @@ func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
-func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CloneVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CloneVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ResizeVolumeRequest) GetUuid() string {
+func (x *CloneVolumeRequest) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *ResizeVolumeRequest) GetSize() uint64 {
+func (x *CloneVolumeRequest) GetSrcVolumeUuid() string {
 	if x != nil {
-		return x.Size
+		return x.SrcVolumeUuid
 	}
-	return 0
+	return ""
 }
 
-// Response message for StorageManagementService.ResizeVolume
-type ResizeVolumeResponse struct {
+func (x *CloneVolumeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *CloneVolumeRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// Response message for StorageManagementService.CloneVolume.
+type CloneVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// The new volume. Unset if the request was sent with async; the operation carries it instead.
+	Volume *Volume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	// UUID of the cluster the volume was cloned on, which is the cluster of the source volume.
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 }
 
-func (x *ResizeVolumeResponse) Reset() {
-	*x = ResizeVolumeResponse{}
+func (x *CloneVolumeResponse) Reset() {
+	*x = CloneVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[9]
+		mi := &file_storms_v1_storms_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResizeVolumeResponse) String() string {
+func (x *CloneVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResizeVolumeResponse) ProtoMessage() {}
+func (*CloneVolumeResponse) ProtoMessage() {}
 
-func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[9]
+func (x *CloneVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,25 +851,164 @@ func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResizeVolumeResponse.ProtoReflect.Descriptor instead.
-func (*ResizeVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CloneVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CloneVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{11}
 }
 
-// Request message for StorageManagementService.DeleteVolume.
-type DeleteVolumeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Required - UUID of the volume
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+func (x *CloneVolumeResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CloneVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *CloneVolumeResponse) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.ResizeVolume.
+type ResizeVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - UUID of the volume
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Updated size of the disk, in bytes.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+}
+
+func (x *ResizeVolumeRequest) Reset() {
+	*x = ResizeVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeRequest) ProtoMessage() {}
+
+func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResizeVolumeRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ResizeVolumeRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ResizeVolumeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// Response message for StorageManagementService.ResizeVolume
+type ResizeVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *ResizeVolumeResponse) Reset() {
+	*x = ResizeVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeResponse) ProtoMessage() {}
+
+func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResizeVolumeResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.DeleteVolume.
+type DeleteVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - UUID of the volume
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
 }
 
 func (x *DeleteVolumeRequest) Reset() {
 	*x = DeleteVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[10]
+		mi := &file_storms_v1_storms_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -581,7 +1021,7 @@ func (x *DeleteVolumeRequest) String() string {
 func (*DeleteVolumeRequest) ProtoMessage() {}
 
 func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[10]
+	mi := &file_storms_v1_storms_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +1034,7 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{10}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteVolumeRequest) GetUuid() string {
@@ -604,17 +1044,27 @@ func (x *DeleteVolumeRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteVolumeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 // Response message for StorageManagementService.DeleteVolume.
 type DeleteVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
 func (x *DeleteVolumeResponse) Reset() {
 	*x = DeleteVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[11]
+		mi := &file_storms_v1_storms_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -627,7 +1077,7 @@ func (x *DeleteVolumeResponse) String() string {
 func (*DeleteVolumeResponse) ProtoMessage() {}
 
 func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[11]
+	mi := &file_storms_v1_storms_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +1090,14 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{11}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteVolumeResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
 }
 
 // Request message for StorageManagementService.AttachVolume.
@@ -653,12 +1110,14 @@ type AttachVolumeRequest struct {
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	// Required - List of ACL (hosts UUID) to add to the volume
 	Acl []string `protobuf:"bytes,2,rep,name=acl,proto3" json:"acl,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
 }
 
 func (x *AttachVolumeRequest) Reset() {
 	*x = AttachVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[12]
+		mi := &file_storms_v1_storms_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -671,7 +1130,7 @@ func (x *AttachVolumeRequest) String() string {
 func (*AttachVolumeRequest) ProtoMessage() {}
 
 func (x *AttachVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[12]
+	mi := &file_storms_v1_storms_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +1143,7 @@ func (x *AttachVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachVolumeRequest.ProtoReflect.Descriptor instead.
 func (*AttachVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{12}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AttachVolumeRequest) GetUuid() string {
@@ -701,17 +1160,27 @@ func (x *AttachVolumeRequest) GetAcl() []string {
 	return nil
 }
 
+func (x *AttachVolumeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 // Response message for StorageManagementService.AttachVolume.
 type AttachVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
 func (x *AttachVolumeResponse) Reset() {
 	*x = AttachVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[13]
+		mi := &file_storms_v1_storms_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -724,7 +1193,7 @@ func (x *AttachVolumeResponse) String() string {
 func (*AttachVolumeResponse) ProtoMessage() {}
 
 func (x *AttachVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[13]
+	mi := &file_storms_v1_storms_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +1206,14 @@ func (x *AttachVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachVolumeResponse.ProtoReflect.Descriptor instead.
 func (*AttachVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{13}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AttachVolumeResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
 }
 
 // Request message for StorageManagementService.DetachVolume.
@@ -750,12 +1226,14 @@ type DetachVolumeRequest struct {
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	// Required - List of ACL (hosts UUID) to remove from the volume
 	Acl []string `protobuf:"bytes,2,rep,name=acl,proto3" json:"acl,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
 }
 
 func (x *DetachVolumeRequest) Reset() {
 	*x = DetachVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[14]
+		mi := &file_storms_v1_storms_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -768,7 +1246,7 @@ func (x *DetachVolumeRequest) String() string {
 func (*DetachVolumeRequest) ProtoMessage() {}
 
 func (x *DetachVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[14]
+	mi := &file_storms_v1_storms_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +1259,7 @@ func (x *DetachVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachVolumeRequest.ProtoReflect.Descriptor instead.
 func (*DetachVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{14}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DetachVolumeRequest) GetUuid() string {
@@ -798,17 +1276,27 @@ func (x *DetachVolumeRequest) GetAcl() []string {
 	return nil
 }
 
+func (x *DetachVolumeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 // Response message for StorageManagementService.DetachVolume.
 type DetachVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
 func (x *DetachVolumeResponse) Reset() {
 	*x = DetachVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[15]
+		mi := &file_storms_v1_storms_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -821,7 +1309,7 @@ func (x *DetachVolumeResponse) String() string {
 func (*DetachVolumeResponse) ProtoMessage() {}
 
 func (x *DetachVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[15]
+	mi := &file_storms_v1_storms_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,36 +1322,47 @@ func (x *DetachVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachVolumeResponse.ProtoReflect.Descriptor instead.
 func (*DetachVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{15}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{19}
 }
 
-// Request message for StorageManagementService.GetSnapshot
-type GetSnapshotRequest struct {
+func (x *DetachVolumeResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.RevertVolumeToSnapshot.
+type RevertVolumeToSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required - UUID of the snapshot
+	// Required - UUID of the volume, which must be detached
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Required - UUID of a snapshot of the volume
+	SnapshotUuid string `protobuf:"bytes,2,opt,name=snapshot_uuid,json=snapshotUuid,proto3" json:"snapshot_uuid,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
 }
 
-func (x *GetSnapshotRequest) Reset() {
-	*x = GetSnapshotRequest{}
+func (x *RevertVolumeToSnapshotRequest) Reset() {
+	*x = RevertVolumeToSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[16]
+		mi := &file_storms_v1_storms_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSnapshotRequest) String() string {
+func (x *RevertVolumeToSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSnapshotRequest) ProtoMessage() {}
+func (*RevertVolumeToSnapshotRequest) ProtoMessage() {}
 
-func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[16]
+func (x *RevertVolumeToSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,45 +1373,61 @@ func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*GetSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RevertVolumeToSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RevertVolumeToSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetSnapshotRequest) GetUuid() string {
+func (x *RevertVolumeToSnapshotRequest) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-// Response message for StorageManagementService.GetSnapshot
-type GetSnapshotResponse struct {
+func (x *RevertVolumeToSnapshotRequest) GetSnapshotUuid() string {
+	if x != nil {
+		return x.SnapshotUuid
+	}
+	return ""
+}
+
+func (x *RevertVolumeToSnapshotRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// Response message for StorageManagementService.RevertVolumeToSnapshot.
+type RevertVolumeToSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// snapshot
-	Snapshot *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// The reverted volume. Unset if the request was sent with async; the operation carries it instead.
+	Volume *Volume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (x *GetSnapshotResponse) Reset() {
-	*x = GetSnapshotResponse{}
+func (x *RevertVolumeToSnapshotResponse) Reset() {
+	*x = RevertVolumeToSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[17]
+		mi := &file_storms_v1_storms_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSnapshotResponse) String() string {
+func (x *RevertVolumeToSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSnapshotResponse) ProtoMessage() {}
+func (*RevertVolumeToSnapshotResponse) ProtoMessage() {}
 
-func (x *GetSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[17]
+func (x *RevertVolumeToSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,42 +1438,58 @@ func (x *GetSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*GetSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RevertVolumeToSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RevertVolumeToSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetSnapshotResponse) GetSnapshot() *Snapshot {
+func (x *RevertVolumeToSnapshotResponse) GetOperationId() string {
 	if x != nil {
-		return x.Snapshot
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *RevertVolumeToSnapshotResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
 	}
 	return nil
 }
 
-// Request message for StorageManagementService.GetSnapshots
-type GetSnapshotsRequest struct {
+// Request message for StorageManagementService.CopyVolume.
+type CopyVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Required - UUID of the new volume
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Required - UUID of the volume to copy
+	SrcVolumeUuid string `protobuf:"bytes,2,opt,name=src_volume_uuid,json=srcVolumeUuid,proto3" json:"src_volume_uuid,omitempty"`
+	// Required - UUID of the cluster to copy the volume to
+	DstClusterId string `protobuf:"bytes,3,opt,name=dst_cluster_id,json=dstClusterId,proto3" json:"dst_cluster_id,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
 }
 
-func (x *GetSnapshotsRequest) Reset() {
-	*x = GetSnapshotsRequest{}
+func (x *CopyVolumeRequest) Reset() {
+	*x = CopyVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[18]
+		mi := &file_storms_v1_storms_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSnapshotsRequest) String() string {
+func (x *CopyVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSnapshotsRequest) ProtoMessage() {}
+func (*CopyVolumeRequest) ProtoMessage() {}
 
-func (x *GetSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[18]
+func (x *CopyVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,37 +1500,70 @@ func (x *GetSnapshotsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSnapshotsRequest.ProtoReflect.Descriptor instead.
-func (*GetSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CopyVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CopyVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{22}
 }
 
-// Response message for StorageManagementService.GetSnapshots
-type GetSnapshotsResponse struct {
+func (x *CopyVolumeRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CopyVolumeRequest) GetSrcVolumeUuid() string {
+	if x != nil {
+		return x.SrcVolumeUuid
+	}
+	return ""
+}
+
+func (x *CopyVolumeRequest) GetDstClusterId() string {
+	if x != nil {
+		return x.DstClusterId
+	}
+	return ""
+}
+
+func (x *CopyVolumeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// Response message for StorageManagementService.CopyVolume.
+type CopyVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Snapshots []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// The new volume. Unset if the request was sent with async; the operation carries it instead.
+	Volume *Volume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	// UUID of the cluster the volume was copied to.
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 }
 
-func (x *GetSnapshotsResponse) Reset() {
-	*x = GetSnapshotsResponse{}
+func (x *CopyVolumeResponse) Reset() {
+	*x = CopyVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[19]
+		mi := &file_storms_v1_storms_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSnapshotsResponse) String() string {
+func (x *CopyVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSnapshotsResponse) ProtoMessage() {}
+func (*CopyVolumeResponse) ProtoMessage() {}
 
-func (x *GetSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[19]
+func (x *CopyVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,47 +1574,63 @@ func (x *GetSnapshotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSnapshotsResponse.ProtoReflect.Descriptor instead.
-func (*GetSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CopyVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CopyVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetSnapshotsResponse) GetSnapshots() []*Snapshot {
+func (x *CopyVolumeResponse) GetOperationId() string {
 	if x != nil {
-		return x.Snapshots
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CopyVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
 	}
 	return nil
 }
 
-// Request message for StorageManagementService.CreateSnapshot.
-type CreateSnapshotRequest struct {
+func (x *CopyVolumeResponse) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.UpdateVolumeLabels.
+type UpdateVolumeLabelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required - UUID of the snapshot
+	// Required - UUID of the volume
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// Required - UUID of the source volume
-	SrcVolumeUuid string `protobuf:"bytes,2,opt,name=src_volume_uuid,json=srcVolumeUuid,proto3" json:"src_volume_uuid,omitempty"`
+	// Optional - labels to set, replacing the values of labels already set
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional - keys of labels to remove. Keys that are not set are ignored.
+	RemoveLabels []string `protobuf:"bytes,3,rep,name=remove_labels,json=removeLabels,proto3" json:"remove_labels,omitempty"`
 }
 
-func (x *CreateSnapshotRequest) Reset() {
-	*x = CreateSnapshotRequest{}
+func (x *UpdateVolumeLabelsRequest) Reset() {
+	*x = UpdateVolumeLabelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[20]
+		mi := &file_storms_v1_storms_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSnapshotRequest) String() string {
+func (x *UpdateVolumeLabelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotRequest) ProtoMessage() {}
+func (*UpdateVolumeLabelsRequest) ProtoMessage() {}
 
-func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[20]
+func (x *UpdateVolumeLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,49 +1641,59 @@ func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateVolumeLabelsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVolumeLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateSnapshotRequest) GetUuid() string {
+func (x *UpdateVolumeLabelsRequest) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *CreateSnapshotRequest) GetSrcVolumeUuid() string {
+func (x *UpdateVolumeLabelsRequest) GetLabels() map[string]string {
 	if x != nil {
-		return x.SrcVolumeUuid
+		return x.Labels
 	}
-	return ""
+	return nil
 }
 
-// Response message for StorageManagementService.CreateSnapshot.
-type CreateSnapshotResponse struct {
+func (x *UpdateVolumeLabelsRequest) GetRemoveLabels() []string {
+	if x != nil {
+		return x.RemoveLabels
+	}
+	return nil
+}
+
+// Response message for StorageManagementService.UpdateVolumeLabels.
+type UpdateVolumeLabelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The volume, with its updated labels.
+	Volume *Volume `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (x *CreateSnapshotResponse) Reset() {
-	*x = CreateSnapshotResponse{}
+func (x *UpdateVolumeLabelsResponse) Reset() {
+	*x = UpdateVolumeLabelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[21]
+		mi := &file_storms_v1_storms_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSnapshotResponse) String() string {
+func (x *UpdateVolumeLabelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotResponse) ProtoMessage() {}
+func (*UpdateVolumeLabelsResponse) ProtoMessage() {}
 
-func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[21]
+func (x *UpdateVolumeLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,13 +1704,20 @@ func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UpdateVolumeLabelsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVolumeLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{25}
 }
 
-// Request message for StorageManagementService.DeleteSnapshot.
-type DeleteSnapshotRequest struct {
+func (x *UpdateVolumeLabelsResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+// Request message for StorageManagementService.GetSnapshot
+type GetSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1129,23 +1726,23 @@ type DeleteSnapshotRequest struct {
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *DeleteSnapshotRequest) Reset() {
-	*x = DeleteSnapshotRequest{}
+func (x *GetSnapshotRequest) Reset() {
+	*x = GetSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[22]
+		mi := &file_storms_v1_storms_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSnapshotRequest) String() string {
+func (x *GetSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSnapshotRequest) ProtoMessage() {}
+func (*GetSnapshotRequest) ProtoMessage() {}
 
-func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[22]
+func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1156,42 +1753,45 @@ func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeleteSnapshotRequest) GetUuid() string {
+func (x *GetSnapshotRequest) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-// Response message for StorageManagementService.DeleteSnapshot.
-type DeleteSnapshotResponse struct {
+// Response message for StorageManagementService.GetSnapshot
+type GetSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// snapshot
+	Snapshot *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (x *DeleteSnapshotResponse) Reset() {
-	*x = DeleteSnapshotResponse{}
+func (x *GetSnapshotResponse) Reset() {
+	*x = GetSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[23]
+		mi := &file_storms_v1_storms_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSnapshotResponse) String() string {
+func (x *GetSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSnapshotResponse) ProtoMessage() {}
+func (*GetSnapshotResponse) ProtoMessage() {}
 
-func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[23]
+func (x *GetSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,42 +1802,50 @@ func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{27}
 }
 
-// Request mesage for StorageManagementService.SyncResource
-type SyncResourceRequest struct {
+func (x *GetSnapshotResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// Request message for StorageManagementService.GetSnapshots
+// Snapshots are returned in ascending order of UUID across all clusters.
+type GetSnapshotsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required - the resource type
-	ResourceType ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=storms.v1.ResourceType" json:"resource_type,omitempty"`
-	// Required - UUID of the resource
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// Optional - the UUID of the cluster this resource belongs on
-	ClusterUuid string `protobuf:"bytes,3,opt,name=cluster_uuid,json=clusterUuid,proto3" json:"cluster_uuid,omitempty"`
+	// Optional - maximum number of snapshots to return. If 0, all matching snapshots are returned.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional - next_page_token from a previous response, to retrieve the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional - only snapshots matching every set field are returned.
+	Filter *SnapshotFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *SyncResourceRequest) Reset() {
-	*x = SyncResourceRequest{}
+func (x *GetSnapshotsRequest) Reset() {
+	*x = GetSnapshotsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[24]
+		mi := &file_storms_v1_storms_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncResourceRequest) String() string {
+func (x *GetSnapshotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncResourceRequest) ProtoMessage() {}
+func (*GetSnapshotsRequest) ProtoMessage() {}
 
-func (x *SyncResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[24]
+func (x *GetSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,56 +1856,69 @@ func (x *SyncResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncResourceRequest.ProtoReflect.Descriptor instead.
-func (*SyncResourceRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SyncResourceRequest) GetResourceType() ResourceType {
+func (x *GetSnapshotsRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.ResourceType
+		return x.PageSize
 	}
-	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *SyncResourceRequest) GetUuid() string {
+func (x *GetSnapshotsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Uuid
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *SyncResourceRequest) GetClusterUuid() string {
+func (x *GetSnapshotsRequest) GetFilter() *SnapshotFilter {
 	if x != nil {
-		return x.ClusterUuid
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-// Response message for StorageManagementService.SyncResource
-type SyncResourceResponse struct {
+// Filters for StorageManagementService.GetSnapshots. Unset fields match all snapshots.
+type SnapshotFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *SyncResourceResponse) Reset() {
-	*x = SyncResourceResponse{}
+	// Only snapshots on this cluster.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// Only snapshots on clusters of this vendor (e.g. "lightbits", "purestorage").
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// Only snapshots with this availability.
+	IsAvailable *bool `protobuf:"varint,3,opt,name=is_available,json=isAvailable,proto3,oneof" json:"is_available,omitempty"`
+	// Only snapshots of this volume.
+	SourceVolumeUuid string `protobuf:"bytes,4,opt,name=source_volume_uuid,json=sourceVolumeUuid,proto3" json:"source_volume_uuid,omitempty"`
+	// Only snapshots created strictly after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Only snapshots whose labels match this selector, in the syntax of VolumeFilter.label_selector.
+	LabelSelector string `protobuf:"bytes,6,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+}
+
+func (x *SnapshotFilter) Reset() {
+	*x = SnapshotFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[25]
+		mi := &file_storms_v1_storms_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncResourceResponse) String() string {
+func (x *SnapshotFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncResourceResponse) ProtoMessage() {}
+func (*SnapshotFilter) ProtoMessage() {}
 
-func (x *SyncResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[25]
+func (x *SnapshotFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,74 +1929,1188 @@ func (x *SyncResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncResourceResponse.ProtoReflect.Descriptor instead.
-func (*SyncResourceResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SnapshotFilter.ProtoReflect.Descriptor instead.
+func (*SnapshotFilter) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{29}
 }
 
-// Request message for StorageManagementService.SyncResource
-type SyncAllResourcesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SnapshotFilter) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
 }
 
-func (x *SyncAllResourcesRequest) Reset() {
-	*x = SyncAllResourcesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SnapshotFilter) GetVendor() string {
+	if x != nil {
+		return x.Vendor
 	}
+	return ""
 }
 
-func (x *SyncAllResourcesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SnapshotFilter) GetIsAvailable() bool {
+	if x != nil && x.IsAvailable != nil {
+		return *x.IsAvailable
+	}
+	return false
 }
 
-func (*SyncAllResourcesRequest) ProtoMessage() {}
+func (x *SnapshotFilter) GetSourceVolumeUuid() string {
+	if x != nil {
+		return x.SourceVolumeUuid
+	}
+	return ""
+}
 
-func (x *SyncAllResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SnapshotFilter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SyncAllResourcesRequest.ProtoReflect.Descriptor instead.
+func (x *SnapshotFilter) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+// Response message for StorageManagementService.GetSnapshots
+type GetSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// Token to retrieve the next page. Empty if there are no more snapshots.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Clusters that could not be listed. Their snapshots are missing from this response.
+	ClusterFailures []*ClusterFailure `protobuf:"bytes,3,rep,name=cluster_failures,json=clusterFailures,proto3" json:"cluster_failures,omitempty"`
+}
+
+func (x *GetSnapshotsResponse) Reset() {
+	*x = GetSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotsResponse) ProtoMessage() {}
+
+func (x *GetSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*GetSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSnapshotsResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *GetSnapshotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetSnapshotsResponse) GetClusterFailures() []*ClusterFailure {
+	if x != nil {
+		return x.ClusterFailures
+	}
+	return nil
+}
+
+// Request message for StorageManagementService.CreateSnapshot.
+type CreateSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - UUID of the snapshot
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Required - UUID of the source volume
+	SrcVolumeUuid string `protobuf:"bytes,2,opt,name=src_volume_uuid,json=srcVolumeUuid,proto3" json:"src_volume_uuid,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+	// Optional - labels of the snapshot
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional - tenant owning the snapshot, whose quota it counts against. Defaults to the tenant of the source volume.
+	TenantId string `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *CreateSnapshotRequest) Reset() {
+	*x = CreateSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateSnapshotRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetSrcVolumeUuid() string {
+	if x != nil {
+		return x.SrcVolumeUuid
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *CreateSnapshotRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateSnapshotRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// Response message for StorageManagementService.CreateSnapshot.
+type CreateSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// The created snapshot. Unset if the request was sent with async; the operation carries it instead.
+	Snapshot *Snapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// UUID of the cluster the snapshot was created on.
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+}
+
+func (x *CreateSnapshotResponse) Reset() {
+	*x = CreateSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateSnapshotResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CreateSnapshotResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *CreateSnapshotResponse) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.DeleteSnapshot.
+type DeleteSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - UUID of the snapshot
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
+}
+
+func (x *DeleteSnapshotRequest) Reset() {
+	*x = DeleteSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteSnapshotRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DeleteSnapshotRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// Response message for StorageManagementService.DeleteSnapshot.
+type DeleteSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *DeleteSnapshotResponse) Reset() {
+	*x = DeleteSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteSnapshotResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.CopySnapshot.
+type CopySnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - UUID of the new snapshot
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Required - UUID of the snapshot to copy
+	SrcSnapshotUuid string `protobuf:"bytes,2,opt,name=src_snapshot_uuid,json=srcSnapshotUuid,proto3" json:"src_snapshot_uuid,omitempty"`
+	// Required - UUID of the cluster to copy the snapshot to
+	DstClusterId string `protobuf:"bytes,3,opt,name=dst_cluster_id,json=dstClusterId,proto3" json:"dst_cluster_id,omitempty"`
+	// Optional - return an operation ID immediately instead of waiting for the operation to complete.
+	Async bool `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
+}
+
+func (x *CopySnapshotRequest) Reset() {
+	*x = CopySnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopySnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopySnapshotRequest) ProtoMessage() {}
+
+func (x *CopySnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopySnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CopySnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CopySnapshotRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetSrcSnapshotUuid() string {
+	if x != nil {
+		return x.SrcSnapshotUuid
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetDstClusterId() string {
+	if x != nil {
+		return x.DstClusterId
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// Response message for StorageManagementService.CopySnapshot.
+type CopySnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation, set if the request was sent with async.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// The new snapshot. Unset if the request was sent with async; the operation carries it instead.
+	Snapshot *Snapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// UUID of the cluster the snapshot was copied to.
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+}
+
+func (x *CopySnapshotResponse) Reset() {
+	*x = CopySnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopySnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopySnapshotResponse) ProtoMessage() {}
+
+func (x *CopySnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopySnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CopySnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CopySnapshotResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CopySnapshotResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *CopySnapshotResponse) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.UpdateSnapshotLabels.
+type UpdateSnapshotLabelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - UUID of the snapshot
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Optional - labels to set, replacing the values of labels already set
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional - keys of labels to remove. Keys that are not set are ignored.
+	RemoveLabels []string `protobuf:"bytes,3,rep,name=remove_labels,json=removeLabels,proto3" json:"remove_labels,omitempty"`
+}
+
+func (x *UpdateSnapshotLabelsRequest) Reset() {
+	*x = UpdateSnapshotLabelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSnapshotLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSnapshotLabelsRequest) ProtoMessage() {}
+
+func (x *UpdateSnapshotLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSnapshotLabelsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSnapshotLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateSnapshotLabelsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateSnapshotLabelsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateSnapshotLabelsRequest) GetRemoveLabels() []string {
+	if x != nil {
+		return x.RemoveLabels
+	}
+	return nil
+}
+
+// Response message for StorageManagementService.UpdateSnapshotLabels.
+type UpdateSnapshotLabelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The snapshot, with its updated labels.
+	Snapshot *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *UpdateSnapshotLabelsResponse) Reset() {
+	*x = UpdateSnapshotLabelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSnapshotLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSnapshotLabelsResponse) ProtoMessage() {}
+
+func (x *UpdateSnapshotLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSnapshotLabelsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSnapshotLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateSnapshotLabelsResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// Request mesage for StorageManagementService.SyncResource
+type SyncResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - the resource type
+	ResourceType ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=storms.v1.ResourceType" json:"resource_type,omitempty"`
+	// Required - UUID of the resource
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Optional - the UUID of the cluster this resource belongs on
+	ClusterUuid string `protobuf:"bytes,3,opt,name=cluster_uuid,json=clusterUuid,proto3" json:"cluster_uuid,omitempty"`
+}
+
+func (x *SyncResourceRequest) Reset() {
+	*x = SyncResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResourceRequest) ProtoMessage() {}
+
+func (x *SyncResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResourceRequest.ProtoReflect.Descriptor instead.
+func (*SyncResourceRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SyncResourceRequest) GetResourceType() ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *SyncResourceRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SyncResourceRequest) GetClusterUuid() string {
+	if x != nil {
+		return x.ClusterUuid
+	}
+	return ""
+}
+
+// Response message for StorageManagementService.SyncResource
+type SyncResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncResourceResponse) Reset() {
+	*x = SyncResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResourceResponse) ProtoMessage() {}
+
+func (x *SyncResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResourceResponse.ProtoReflect.Descriptor instead.
+func (*SyncResourceResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{40}
+}
+
+// Request message for StorageManagementService.SyncResource
+type SyncAllResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncAllResourcesRequest) Reset() {
+	*x = SyncAllResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncAllResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAllResourcesRequest) ProtoMessage() {}
+
+func (x *SyncAllResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAllResourcesRequest.ProtoReflect.Descriptor instead.
 func (*SyncAllResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{26}
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{41}
+}
+
+// Response mesage for StorageManagementService.SyncResource
+type SyncAllResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncAllResourcesResponse) Reset() {
+	*x = SyncAllResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncAllResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAllResourcesResponse) ProtoMessage() {}
+
+func (x *SyncAllResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAllResourcesResponse.ProtoReflect.Descriptor instead.
+func (*SyncAllResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{42}
+}
+
+// Request message for StorageManagementService.GetTenantUsage.
+type GetTenantUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - ID of the tenant
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *GetTenantUsageRequest) Reset() {
+	*x = GetTenantUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageRequest) ProtoMessage() {}
+
+func (x *GetTenantUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTenantUsageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// Response message for StorageManagementService.GetTenantUsage.
+type GetTenantUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resources owned by the tenant.
+	Usage *TenantUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Limits on the resources the tenant may own.
+	Quota *TenantQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *GetTenantUsageResponse) Reset() {
+	*x = GetTenantUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageResponse) ProtoMessage() {}
+
+func (x *GetTenantUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTenantUsageResponse) GetUsage() *TenantUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetTenantUsageResponse) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// Request message for StorageManagementService.GetOperation.
+type GetOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - ID of the operation
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for StorageManagementService.GetOperation.
+type GetOperationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (x *GetOperationResponse) Reset() {
+	*x = GetOperationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationResponse) ProtoMessage() {}
+
+func (x *GetOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationResponse.ProtoReflect.Descriptor instead.
+func (*GetOperationResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetOperationResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// Request message for StorageManagementService.ListOperations.
+// Operations are returned in ascending order of ID.
+type ListOperationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional - maximum number of operations to return. If 0, all matching operations are returned.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional - next_page_token from a previous response, to retrieve the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional - only operations on this resource.
+	ResourceUuid string `protobuf:"bytes,3,opt,name=resource_uuid,json=resourceUuid,proto3" json:"resource_uuid,omitempty"`
+	// Optional - only operations in this state.
+	State OperationState `protobuf:"varint,4,opt,name=state,proto3,enum=storms.v1.OperationState" json:"state,omitempty"`
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListOperationsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOperationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetResourceUuid() string {
+	if x != nil {
+		return x.ResourceUuid
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetState() OperationState {
+	if x != nil {
+		return x.State
+	}
+	return OperationState_OPERATION_STATE_UNSPECIFIED
+}
+
+// Response message for StorageManagementService.ListOperations.
+type ListOperationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Token to retrieve the next page. Empty if there are no more operations.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Response mesage for StorageManagementService.SyncResource
-type SyncAllResourcesResponse struct {
+func (x *ListOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse) ProtoMessage() {}
+
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListOperationsResponse) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *ListOperationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request message for StorageManagementService.WaitOperation.
+type WaitOperationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Required - ID of the operation
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional - maximum time to wait. If unset, waits until the operation completes or the call's deadline.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *SyncAllResourcesResponse) Reset() {
-	*x = SyncAllResourcesResponse{}
+func (x *WaitOperationRequest) Reset() {
+	*x = WaitOperationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storms_v1_storms_proto_msgTypes[27]
+		mi := &file_storms_v1_storms_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncAllResourcesResponse) String() string {
+func (x *WaitOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAllResourcesResponse) ProtoMessage() {}
+func (*WaitOperationRequest) ProtoMessage() {}
 
-func (x *SyncAllResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storms_v1_storms_proto_msgTypes[27]
+func (x *WaitOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,9 +3121,169 @@ func (x *SyncAllResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAllResourcesResponse.ProtoReflect.Descriptor instead.
-func (*SyncAllResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_storms_v1_storms_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use WaitOperationRequest.ProtoReflect.Descriptor instead.
+func (*WaitOperationRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WaitOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitOperationRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// Response message for StorageManagementService.WaitOperation.
+type WaitOperationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The operation as of the end of the wait. It is still in progress if the timeout elapsed first.
+	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (x *WaitOperationResponse) Reset() {
+	*x = WaitOperationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitOperationResponse) ProtoMessage() {}
+
+func (x *WaitOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitOperationResponse.ProtoReflect.Descriptor instead.
+func (*WaitOperationResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WaitOperationResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// Request message for StorageManagementService.CancelOperation.
+type CancelOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required - ID of the operation
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelOperationRequest) Reset() {
+	*x = CancelOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationRequest) ProtoMessage() {}
+
+func (x *CancelOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CancelOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for StorageManagementService.CancelOperation.
+type CancelOperationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (x *CancelOperationResponse) Reset() {
+	*x = CancelOperationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storms_v1_storms_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationResponse) ProtoMessage() {}
+
+func (x *CancelOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storms_v1_storms_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationResponse.ProtoReflect.Descriptor instead.
+func (*CancelOperationResponse) Descriptor() ([]byte, []int) {
+	return file_storms_v1_storms_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CancelOperationResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
 }
 
 var File_storms_v1_storms_proto protoreflect.FileDescriptor
@@ -1401,197 +3296,641 @@ var file_storms_v1_storms_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x66, 0x69, 0x65, 0x6c,
 	0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x3e, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x13, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x3e,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xa1,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c,
+	0x73, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42,
+	0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x8c, 0x03, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01,
+	0x01, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x12, 0x31, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0c, 0x61, 0x63, 0x6c, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x14, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x52, 0x12,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73,
+	0x65, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0xd0, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75,
 	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72,
 	0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x09, 0x8a, 0xb5,
 	0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
-	0x22, 0xd8, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x55, 0x0a, 0x0d, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x66,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0c, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x35, 0x0a,
-	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x07, 0x66, 0x72, 0x6f,
-	0x6d, 0x4e, 0x65, 0x77, 0x12, 0x4a, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x1a, 0x3f, 0x0a, 0x11, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x61, 0x67, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x72, 0x0a, 0x0d, 0x4e,
-	0x65, 0x77, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32,
-	0x02, 0x20, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x53, 0x69, 0x7a, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0x49, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x0d, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0c, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x52, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01,
-	0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
-	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x13, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61,
-	0x63, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x13, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61, 0x63,
-	0x6c, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x09, 0x8a, 0xb5, 0x18,
-	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3c, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c,
-	0x73, 0x65, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x67, 0x0a,
-	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x12, 0x31, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x4f, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x93, 0x05, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x55, 0x0a, 0x0d, 0x61, 0x66, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x61, 0x67, 0x73,
+	0x12, 0x35, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x65, 0x77, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x07,
+	0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x77, 0x12, 0x4a, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x12, 0x87, 0x01, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x43, 0xfa, 0x42, 0x40, 0x9a, 0x01, 0x3d, 0x10, 0x40, 0x22, 0x33, 0x72, 0x31, 0x10,
+	0x01, 0x18, 0x3f, 0x32, 0x2b, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2f, 0x2d,
+	0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x24,
+	0x2a, 0x04, 0x72, 0x02, 0x18, 0x3f, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x53,
+	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xfa, 0x42, 0x33, 0x72, 0x31, 0x18, 0x3f, 0x32, 0x2d, 0x5e, 0x28, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x1a, 0x3f, 0x0a, 0x11, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x72, 0x0a, 0x0d, 0x4e, 0x65, 0x77,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20,
+	0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x69, 0x7a, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x49, 0x0a,
+	0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x0d, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c,
+	0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x34, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xc9, 0x02, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04,
 	0x75, 0x75, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x0f, 0x73, 0x72, 0x63, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
 	0x6d, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
 	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0d, 0x73, 0x72, 0x63, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x35, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6d, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x86, 0x01, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x43, 0xfa, 0x42, 0x40, 0x9a, 0x01, 0x3d, 0x10, 0x40, 0x22, 0x33, 0x72,
+	0x31, 0x10, 0x01, 0x18, 0x3f, 0x32, 0x2b, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x5f,
+	0x2f, 0x2d, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x29,
+	0x3f, 0x24, 0x2a, 0x04, 0x72, 0x02, 0x18, 0x3f, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x13,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x73, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x22, 0x44, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x12,
+	0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09,
+	0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63,
+	0x22, 0x44, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x13, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x63, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x12, 0x1f, 0x0a,
+	0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5,
+	0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x44,
+	0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5,
+	0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x22, 0x84, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a,
+	0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x11,
+	0x43, 0x6f, 0x70, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x0f, 0x73, 0x72, 0x63, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x0d, 0x73, 0x72, 0x63, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x2e, 0x0a, 0x0e, 0x64, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x0c, 0x64, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x22, 0xa2, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x70, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb4, 0x02, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x8d, 0x01, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x43, 0xfa, 0x42, 0x40, 0x9a, 0x01, 0x3d, 0x10, 0x40,
+	0x22, 0x33, 0x72, 0x31, 0x10, 0x01, 0x18, 0x3f, 0x32, 0x2b, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x2e, 0x5f, 0x2f, 0x2d, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5d, 0x29, 0x3f, 0x24, 0x2a, 0x04, 0x72, 0x02, 0x18, 0x3f, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52,
+	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42,
+	0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x22, 0x32, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a,
+	0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x09,
+	0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0xdc, 0x02, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0,
+	0x01, 0x01, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x52,
+	0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0xd8, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4f, 0x0a, 0x10, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42,
+	0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0xa4, 0x03, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x0f, 0x73, 0x72, 0x63, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0d, 0x73, 0x72, 0x63, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x89, 0x01, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x43, 0xfa, 0x42, 0x40, 0x9a, 0x01, 0x3d, 0x10, 0x40, 0x22,
+	0x33, 0x72, 0x31, 0x10, 0x01, 0x18, 0x3f, 0x32, 0x2b, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x2e, 0x5f, 0x2f, 0x2d, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x29, 0x3f, 0x24, 0x2a, 0x04, 0x72, 0x02, 0x18, 0x3f, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x53, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xfa, 0x42, 0x33, 0x72, 0x31, 0x18, 0x3f, 0x32, 0x2d,
+	0x5e, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d, 0x5d, 0x2a, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x56, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c,
+	0x73, 0x65, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x46, 0x0a, 0x16, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
 	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01,
-	0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
-	0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x11, 0x73, 0x72, 0x63, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0f, 0x73, 0x72,
+	0x63, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x2e, 0x0a,
+	0x0e, 0x64, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
-	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14,
-	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x1a, 0x0a, 0x18, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbd, 0x08, 0x0a, 0x18,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1c,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x0c, 0x64, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x8a, 0xb5,
+	0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0xaa,
+	0x01, 0x0a, 0x14, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a,
+	0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x09, 0x8a, 0xb5,
+	0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x28, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb8, 0x02, 0x0a, 0x1b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x8f, 0x01, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x43,
+	0xfa, 0x42, 0x40, 0x9a, 0x01, 0x3d, 0x10, 0x40, 0x22, 0x33, 0x72, 0x31, 0x10, 0x01, 0x18, 0x3f,
+	0x32, 0x2b, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2f, 0x2d, 0x5d, 0x2a, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x24, 0x2a, 0x04, 0x72,
+	0x02, 0x18, 0x3f, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x0d, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x09, 0x8a,
+	0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x16, 0x0a,
+	0x14, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x1a, 0x0a, 0x18, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x3f, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x8a, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x37, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x8a, 0xb5,
+	0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xd6, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0xb0,
+	0x01, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x70, 0x0a, 0x14, 0x57, 0x61,
+	0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x56, 0x0a, 0x15,
+	0x57, 0x61, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0x8a, 0xb5, 0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x8a, 0xb5,
+	0x18, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x32, 0x8f, 0x10, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x73,
 	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c,
-	0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
-	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
-	0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4f, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
-	0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61,
-	0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61,
-	0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
-	0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1e,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x55, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x28, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x54, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x6f, 0x70, 0x79, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x70,
+	0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x61, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f,
 	0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
-	0x0c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
-	0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x0c, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x70, 0x79,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x70, 0x79,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x53, 0x79,
+	0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x22,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
 	0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x58, 0x5a, 0x56, 0x67,
-	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x75, 0x73, 0x6f, 0x65,
-	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x2f, 0x69, 0x73, 0x6c, 0x61, 0x6e, 0x64, 0x2f, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2f, 0x73, 0x74, 0x6f,
-	0x72, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x6f,
-	0x72, 0x6d, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x58, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x75, 0x73, 0x6f, 0x65, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x2f,
+	0x69, 0x73, 0x6c, 0x61, 0x6e, 0x64, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x73, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1606,83 +3945,168 @@ func file_storms_v1_storms_proto_rawDescGZIP() []byte {
 	return file_storms_v1_storms_proto_rawDescData
 }
 
-var file_storms_v1_storms_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_storms_v1_storms_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_storms_v1_storms_proto_goTypes = []any{
-	(*GetVolumeRequest)(nil),         // 0: storms.v1.GetVolumeRequest
-	(*GetVolumeResponse)(nil),        // 1: storms.v1.GetVolumeResponse
-	(*GetVolumesRequest)(nil),        // 2: storms.v1.GetVolumesRequest
-	(*GetVolumesResponse)(nil),       // 3: storms.v1.GetVolumesResponse
-	(*CreateVolumeRequest)(nil),      // 4: storms.v1.CreateVolumeRequest
-	(*NewVolumeSpec)(nil),            // 5: storms.v1.NewVolumeSpec
-	(*SnapshotSourceVolumeSpec)(nil), // 6: storms.v1.SnapshotSourceVolumeSpec
-	(*CreateVolumeResponse)(nil),     // 7: storms.v1.CreateVolumeResponse
-	(*ResizeVolumeRequest)(nil),      // 8: storms.v1.ResizeVolumeRequest
-	(*ResizeVolumeResponse)(nil),     // 9: storms.v1.ResizeVolumeResponse
-	(*DeleteVolumeRequest)(nil),      // 10: storms.v1.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),     // 11: storms.v1.DeleteVolumeResponse
-	(*AttachVolumeRequest)(nil),      // 12: storms.v1.AttachVolumeRequest
-	(*AttachVolumeResponse)(nil),     // 13: storms.v1.AttachVolumeResponse
-	(*DetachVolumeRequest)(nil),      // 14: storms.v1.DetachVolumeRequest
-	(*DetachVolumeResponse)(nil),     // 15: storms.v1.DetachVolumeResponse
-	(*GetSnapshotRequest)(nil),       // 16: storms.v1.GetSnapshotRequest
-	(*GetSnapshotResponse)(nil),      // 17: storms.v1.GetSnapshotResponse
-	(*GetSnapshotsRequest)(nil),      // 18: storms.v1.GetSnapshotsRequest
-	(*GetSnapshotsResponse)(nil),     // 19: storms.v1.GetSnapshotsResponse
-	(*CreateSnapshotRequest)(nil),    // 20: storms.v1.CreateSnapshotRequest
-	(*CreateSnapshotResponse)(nil),   // 21: storms.v1.CreateSnapshotResponse
-	(*DeleteSnapshotRequest)(nil),    // 22: storms.v1.DeleteSnapshotRequest
-	(*DeleteSnapshotResponse)(nil),   // 23: storms.v1.DeleteSnapshotResponse
-	(*SyncResourceRequest)(nil),      // 24: storms.v1.SyncResourceRequest
-	(*SyncResourceResponse)(nil),     // 25: storms.v1.SyncResourceResponse
-	(*SyncAllResourcesRequest)(nil),  // 26: storms.v1.SyncAllResourcesRequest
-	(*SyncAllResourcesResponse)(nil), // 27: storms.v1.SyncAllResourcesResponse
-	nil,                              // 28: storms.v1.CreateVolumeRequest.AffinityTagsEntry
-	(*Volume)(nil),                   // 29: storms.v1.Volume
-	(SectorSizeEnum)(0),              // 30: storms.v1.SectorSizeEnum
-	(*Snapshot)(nil),                 // 31: storms.v1.Snapshot
-	(ResourceType)(0),                // 32: storms.v1.ResourceType
+	(*GetVolumeRequest)(nil),               // 0: storms.v1.GetVolumeRequest
+	(*GetVolumeResponse)(nil),              // 1: storms.v1.GetVolumeResponse
+	(*GetVolumesRequest)(nil),              // 2: storms.v1.GetVolumesRequest
+	(*VolumeFilter)(nil),                   // 3: storms.v1.VolumeFilter
+	(*GetVolumesResponse)(nil),             // 4: storms.v1.GetVolumesResponse
+	(*ClusterFailure)(nil),                 // 5: storms.v1.ClusterFailure
+	(*CreateVolumeRequest)(nil),            // 6: storms.v1.CreateVolumeRequest
+	(*NewVolumeSpec)(nil),                  // 7: storms.v1.NewVolumeSpec
+	(*SnapshotSourceVolumeSpec)(nil),       // 8: storms.v1.SnapshotSourceVolumeSpec
+	(*CreateVolumeResponse)(nil),           // 9: storms.v1.CreateVolumeResponse
+	(*CloneVolumeRequest)(nil),             // 10: storms.v1.CloneVolumeRequest
+	(*CloneVolumeResponse)(nil),            // 11: storms.v1.CloneVolumeResponse
+	(*ResizeVolumeRequest)(nil),            // 12: storms.v1.ResizeVolumeRequest
+	(*ResizeVolumeResponse)(nil),           // 13: storms.v1.ResizeVolumeResponse
+	(*DeleteVolumeRequest)(nil),            // 14: storms.v1.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),           // 15: storms.v1.DeleteVolumeResponse
+	(*AttachVolumeRequest)(nil),            // 16: storms.v1.AttachVolumeRequest
+	(*AttachVolumeResponse)(nil),           // 17: storms.v1.AttachVolumeResponse
+	(*DetachVolumeRequest)(nil),            // 18: storms.v1.DetachVolumeRequest
+	(*DetachVolumeResponse)(nil),           // 19: storms.v1.DetachVolumeResponse
+	(*RevertVolumeToSnapshotRequest)(nil),  // 20: storms.v1.RevertVolumeToSnapshotRequest
+	(*RevertVolumeToSnapshotResponse)(nil), // 21: storms.v1.RevertVolumeToSnapshotResponse
+	(*CopyVolumeRequest)(nil),              // 22: storms.v1.CopyVolumeRequest
+	(*CopyVolumeResponse)(nil),             // 23: storms.v1.CopyVolumeResponse
+	(*UpdateVolumeLabelsRequest)(nil),      // 24: storms.v1.UpdateVolumeLabelsRequest
+	(*UpdateVolumeLabelsResponse)(nil),     // 25: storms.v1.UpdateVolumeLabelsResponse
+	(*GetSnapshotRequest)(nil),             // 26: storms.v1.GetSnapshotRequest
+	(*GetSnapshotResponse)(nil),            // 27: storms.v1.GetSnapshotResponse
+	(*GetSnapshotsRequest)(nil),            // 28: storms.v1.GetSnapshotsRequest
+	(*SnapshotFilter)(nil),                 // 29: storms.v1.SnapshotFilter
+	(*GetSnapshotsResponse)(nil),           // 30: storms.v1.GetSnapshotsResponse
+	(*CreateSnapshotRequest)(nil),          // 31: storms.v1.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),         // 32: storms.v1.CreateSnapshotResponse
+	(*DeleteSnapshotRequest)(nil),          // 33: storms.v1.DeleteSnapshotRequest
+	(*DeleteSnapshotResponse)(nil),         // 34: storms.v1.DeleteSnapshotResponse
+	(*CopySnapshotRequest)(nil),            // 35: storms.v1.CopySnapshotRequest
+	(*CopySnapshotResponse)(nil),           // 36: storms.v1.CopySnapshotResponse
+	(*UpdateSnapshotLabelsRequest)(nil),    // 37: storms.v1.UpdateSnapshotLabelsRequest
+	(*UpdateSnapshotLabelsResponse)(nil),   // 38: storms.v1.UpdateSnapshotLabelsResponse
+	(*SyncResourceRequest)(nil),            // 39: storms.v1.SyncResourceRequest
+	(*SyncResourceResponse)(nil),           // 40: storms.v1.SyncResourceResponse
+	(*SyncAllResourcesRequest)(nil),        // 41: storms.v1.SyncAllResourcesRequest
+	(*SyncAllResourcesResponse)(nil),       // 42: storms.v1.SyncAllResourcesResponse
+	(*GetTenantUsageRequest)(nil),          // 43: storms.v1.GetTenantUsageRequest
+	(*GetTenantUsageResponse)(nil),         // 44: storms.v1.GetTenantUsageResponse
+	(*GetOperationRequest)(nil),            // 45: storms.v1.GetOperationRequest
+	(*GetOperationResponse)(nil),           // 46: storms.v1.GetOperationResponse
+	(*ListOperationsRequest)(nil),          // 47: storms.v1.ListOperationsRequest
+	(*ListOperationsResponse)(nil),         // 48: storms.v1.ListOperationsResponse
+	(*WaitOperationRequest)(nil),           // 49: storms.v1.WaitOperationRequest
+	(*WaitOperationResponse)(nil),          // 50: storms.v1.WaitOperationResponse
+	(*CancelOperationRequest)(nil),         // 51: storms.v1.CancelOperationRequest
+	(*CancelOperationResponse)(nil),        // 52: storms.v1.CancelOperationResponse
+	nil,                                    // 53: storms.v1.CreateVolumeRequest.AffinityTagsEntry
+	nil,                                    // 54: storms.v1.CreateVolumeRequest.LabelsEntry
+	nil,                                    // 55: storms.v1.CloneVolumeRequest.LabelsEntry
+	nil,                                    // 56: storms.v1.UpdateVolumeLabelsRequest.LabelsEntry
+	nil,                                    // 57: storms.v1.CreateSnapshotRequest.LabelsEntry
+	nil,                                    // 58: storms.v1.UpdateSnapshotLabelsRequest.LabelsEntry
+	(*Volume)(nil),                         // 59: storms.v1.Volume
+	(*timestamppb.Timestamp)(nil),          // 60: google.protobuf.Timestamp
+	(SectorSizeEnum)(0),                    // 61: storms.v1.SectorSizeEnum
+	(*Snapshot)(nil),                       // 62: storms.v1.Snapshot
+	(ResourceType)(0),                      // 63: storms.v1.ResourceType
+	(*TenantUsage)(nil),                    // 64: storms.v1.TenantUsage
+	(*TenantQuota)(nil),                    // 65: storms.v1.TenantQuota
+	(*Operation)(nil),                      // 66: storms.v1.Operation
+	(OperationState)(0),                    // 67: storms.v1.OperationState
+	(*durationpb.Duration)(nil),            // 68: google.protobuf.Duration
 }
 var file_storms_v1_storms_proto_depIdxs = []int32{
-	29, // 0: storms.v1.GetVolumeResponse.volume:type_name -> storms.v1.Volume
-	29, // 1: storms.v1.GetVolumesResponse.volumes:type_name -> storms.v1.Volume
-	28, // 2: storms.v1.CreateVolumeRequest.affinity_tags:type_name -> storms.v1.CreateVolumeRequest.AffinityTagsEntry
-	5,  // 3: storms.v1.CreateVolumeRequest.from_new:type_name -> storms.v1.NewVolumeSpec
-	6,  // 4: storms.v1.CreateVolumeRequest.from_snapshot:type_name -> storms.v1.SnapshotSourceVolumeSpec
-	30, // 5: storms.v1.NewVolumeSpec.sector_size:type_name -> storms.v1.SectorSizeEnum
-	31, // 6: storms.v1.GetSnapshotResponse.snapshot:type_name -> storms.v1.Snapshot
-	31, // 7: storms.v1.GetSnapshotsResponse.snapshots:type_name -> storms.v1.Snapshot
-	32, // 8: storms.v1.SyncResourceRequest.resource_type:type_name -> storms.v1.ResourceType
-	0,  // 9: storms.v1.StorageManagementService.GetVolume:input_type -> storms.v1.GetVolumeRequest
-	2,  // 10: storms.v1.StorageManagementService.GetVolumes:input_type -> storms.v1.GetVolumesRequest
-	4,  // 11: storms.v1.StorageManagementService.CreateVolume:input_type -> storms.v1.CreateVolumeRequest
-	8,  // 12: storms.v1.StorageManagementService.ResizeVolume:input_type -> storms.v1.ResizeVolumeRequest
-	10, // 13: storms.v1.StorageManagementService.DeleteVolume:input_type -> storms.v1.DeleteVolumeRequest
-	12, // 14: storms.v1.StorageManagementService.AttachVolume:input_type -> storms.v1.AttachVolumeRequest
-	14, // 15: storms.v1.StorageManagementService.DetachVolume:input_type -> storms.v1.DetachVolumeRequest
-	16, // 16: storms.v1.StorageManagementService.GetSnapshot:input_type -> storms.v1.GetSnapshotRequest
-	18, // 17: storms.v1.StorageManagementService.GetSnapshots:input_type -> storms.v1.GetSnapshotsRequest
-	20, // 18: storms.v1.StorageManagementService.CreateSnapshot:input_type -> storms.v1.CreateSnapshotRequest
-	22, // 19: storms.v1.StorageManagementService.DeleteSnapshot:input_type -> storms.v1.DeleteSnapshotRequest
-	24, // 20: storms.v1.StorageManagementService.SyncResource:input_type -> storms.v1.SyncResourceRequest
-	26, // 21: storms.v1.StorageManagementService.SyncAllResources:input_type -> storms.v1.SyncAllResourcesRequest
-	1,  // 22: storms.v1.StorageManagementService.GetVolume:output_type -> storms.v1.GetVolumeResponse
-	3,  // 23: storms.v1.StorageManagementService.GetVolumes:output_type -> storms.v1.GetVolumesResponse
-	7,  // 24: storms.v1.StorageManagementService.CreateVolume:output_type -> storms.v1.CreateVolumeResponse
-	9,  // 25: storms.v1.StorageManagementService.ResizeVolume:output_type -> storms.v1.ResizeVolumeResponse
-	11, // 26: storms.v1.StorageManagementService.DeleteVolume:output_type -> storms.v1.DeleteVolumeResponse
-	13, // 27: storms.v1.StorageManagementService.AttachVolume:output_type -> storms.v1.AttachVolumeResponse
-	15, // 28: storms.v1.StorageManagementService.DetachVolume:output_type -> storms.v1.DetachVolumeResponse
-	17, // 29: storms.v1.StorageManagementService.GetSnapshot:output_type -> storms.v1.GetSnapshotResponse
-	19, // 30: storms.v1.StorageManagementService.GetSnapshots:output_type -> storms.v1.GetSnapshotsResponse
-	21, // 31: storms.v1.StorageManagementService.CreateSnapshot:output_type -> storms.v1.CreateSnapshotResponse
-	23, // 32: storms.v1.StorageManagementService.DeleteSnapshot:output_type -> storms.v1.DeleteSnapshotResponse
-	25, // 33: storms.v1.StorageManagementService.SyncResource:output_type -> storms.v1.SyncResourceResponse
-	27, // 34: storms.v1.StorageManagementService.SyncAllResources:output_type -> storms.v1.SyncAllResourcesResponse
-	22, // [22:35] is the sub-list for method output_type
-	9,  // [9:22] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	59, // 0: storms.v1.GetVolumeResponse.volume:type_name -> storms.v1.Volume
+	3,  // 1: storms.v1.GetVolumesRequest.filter:type_name -> storms.v1.VolumeFilter
+	60, // 2: storms.v1.VolumeFilter.created_after:type_name -> google.protobuf.Timestamp
+	59, // 3: storms.v1.GetVolumesResponse.volumes:type_name -> storms.v1.Volume
+	5,  // 4: storms.v1.GetVolumesResponse.cluster_failures:type_name -> storms.v1.ClusterFailure
+	53, // 5: storms.v1.CreateVolumeRequest.affinity_tags:type_name -> storms.v1.CreateVolumeRequest.AffinityTagsEntry
+	7,  // 6: storms.v1.CreateVolumeRequest.from_new:type_name -> storms.v1.NewVolumeSpec
+	8,  // 7: storms.v1.CreateVolumeRequest.from_snapshot:type_name -> storms.v1.SnapshotSourceVolumeSpec
+	54, // 8: storms.v1.CreateVolumeRequest.labels:type_name -> storms.v1.CreateVolumeRequest.LabelsEntry
+	61, // 9: storms.v1.NewVolumeSpec.sector_size:type_name -> storms.v1.SectorSizeEnum
+	59, // 10: storms.v1.CreateVolumeResponse.volume:type_name -> storms.v1.Volume
+	55, // 11: storms.v1.CloneVolumeRequest.labels:type_name -> storms.v1.CloneVolumeRequest.LabelsEntry
+	59, // 12: storms.v1.CloneVolumeResponse.volume:type_name -> storms.v1.Volume
+	59, // 13: storms.v1.RevertVolumeToSnapshotResponse.volume:type_name -> storms.v1.Volume
+	59, // 14: storms.v1.CopyVolumeResponse.volume:type_name -> storms.v1.Volume
+	56, // 15: storms.v1.UpdateVolumeLabelsRequest.labels:type_name -> storms.v1.UpdateVolumeLabelsRequest.LabelsEntry
+	59, // 16: storms.v1.UpdateVolumeLabelsResponse.volume:type_name -> storms.v1.Volume
+	62, // 17: storms.v1.GetSnapshotResponse.snapshot:type_name -> storms.v1.Snapshot
+	29, // 18: storms.v1.GetSnapshotsRequest.filter:type_name -> storms.v1.SnapshotFilter
+	60, // 19: storms.v1.SnapshotFilter.created_after:type_name -> google.protobuf.Timestamp
+	62, // 20: storms.v1.GetSnapshotsResponse.snapshots:type_name -> storms.v1.Snapshot
+	5,  // 21: storms.v1.GetSnapshotsResponse.cluster_failures:type_name -> storms.v1.ClusterFailure
+	57, // 22: storms.v1.CreateSnapshotRequest.labels:type_name -> storms.v1.CreateSnapshotRequest.LabelsEntry
+	62, // 23: storms.v1.CreateSnapshotResponse.snapshot:type_name -> storms.v1.Snapshot
+	62, // 24: storms.v1.CopySnapshotResponse.snapshot:type_name -> storms.v1.Snapshot
+	58, // 25: storms.v1.UpdateSnapshotLabelsRequest.labels:type_name -> storms.v1.UpdateSnapshotLabelsRequest.LabelsEntry
+	62, // 26: storms.v1.UpdateSnapshotLabelsResponse.snapshot:type_name -> storms.v1.Snapshot
+	63, // 27: storms.v1.SyncResourceRequest.resource_type:type_name -> storms.v1.ResourceType
+	64, // 28: storms.v1.GetTenantUsageResponse.usage:type_name -> storms.v1.TenantUsage
+	65, // 29: storms.v1.GetTenantUsageResponse.quota:type_name -> storms.v1.TenantQuota
+	66, // 30: storms.v1.GetOperationResponse.operation:type_name -> storms.v1.Operation
+	67, // 31: storms.v1.ListOperationsRequest.state:type_name -> storms.v1.OperationState
+	66, // 32: storms.v1.ListOperationsResponse.operations:type_name -> storms.v1.Operation
+	68, // 33: storms.v1.WaitOperationRequest.timeout:type_name -> google.protobuf.Duration
+	66, // 34: storms.v1.WaitOperationResponse.operation:type_name -> storms.v1.Operation
+	66, // 35: storms.v1.CancelOperationResponse.operation:type_name -> storms.v1.Operation
+	0,  // 36: storms.v1.StorageManagementService.GetVolume:input_type -> storms.v1.GetVolumeRequest
+	2,  // 37: storms.v1.StorageManagementService.GetVolumes:input_type -> storms.v1.GetVolumesRequest
+	6,  // 38: storms.v1.StorageManagementService.CreateVolume:input_type -> storms.v1.CreateVolumeRequest
+	10, // 39: storms.v1.StorageManagementService.CloneVolume:input_type -> storms.v1.CloneVolumeRequest
+	12, // 40: storms.v1.StorageManagementService.ResizeVolume:input_type -> storms.v1.ResizeVolumeRequest
+	14, // 41: storms.v1.StorageManagementService.DeleteVolume:input_type -> storms.v1.DeleteVolumeRequest
+	16, // 42: storms.v1.StorageManagementService.AttachVolume:input_type -> storms.v1.AttachVolumeRequest
+	18, // 43: storms.v1.StorageManagementService.DetachVolume:input_type -> storms.v1.DetachVolumeRequest
+	20, // 44: storms.v1.StorageManagementService.RevertVolumeToSnapshot:input_type -> storms.v1.RevertVolumeToSnapshotRequest
+	22, // 45: storms.v1.StorageManagementService.CopyVolume:input_type -> storms.v1.CopyVolumeRequest
+	24, // 46: storms.v1.StorageManagementService.UpdateVolumeLabels:input_type -> storms.v1.UpdateVolumeLabelsRequest
+	26, // 47: storms.v1.StorageManagementService.GetSnapshot:input_type -> storms.v1.GetSnapshotRequest
+	28, // 48: storms.v1.StorageManagementService.GetSnapshots:input_type -> storms.v1.GetSnapshotsRequest
+	31, // 49: storms.v1.StorageManagementService.CreateSnapshot:input_type -> storms.v1.CreateSnapshotRequest
+	33, // 50: storms.v1.StorageManagementService.DeleteSnapshot:input_type -> storms.v1.DeleteSnapshotRequest
+	35, // 51: storms.v1.StorageManagementService.CopySnapshot:input_type -> storms.v1.CopySnapshotRequest
+	37, // 52: storms.v1.StorageManagementService.UpdateSnapshotLabels:input_type -> storms.v1.UpdateSnapshotLabelsRequest
+	39, // 53: storms.v1.StorageManagementService.SyncResource:input_type -> storms.v1.SyncResourceRequest
+	41, // 54: storms.v1.StorageManagementService.SyncAllResources:input_type -> storms.v1.SyncAllResourcesRequest
+	43, // 55: storms.v1.StorageManagementService.GetTenantUsage:input_type -> storms.v1.GetTenantUsageRequest
+	45, // 56: storms.v1.StorageManagementService.GetOperation:input_type -> storms.v1.GetOperationRequest
+	47, // 57: storms.v1.StorageManagementService.ListOperations:input_type -> storms.v1.ListOperationsRequest
+	49, // 58: storms.v1.StorageManagementService.WaitOperation:input_type -> storms.v1.WaitOperationRequest
+	51, // 59: storms.v1.StorageManagementService.CancelOperation:input_type -> storms.v1.CancelOperationRequest
+	1,  // 60: storms.v1.StorageManagementService.GetVolume:output_type -> storms.v1.GetVolumeResponse
+	4,  // 61: storms.v1.StorageManagementService.GetVolumes:output_type -> storms.v1.GetVolumesResponse
+	9,  // 62: storms.v1.StorageManagementService.CreateVolume:output_type -> storms.v1.CreateVolumeResponse
+	11, // 63: storms.v1.StorageManagementService.CloneVolume:output_type -> storms.v1.CloneVolumeResponse
+	13, // 64: storms.v1.StorageManagementService.ResizeVolume:output_type -> storms.v1.ResizeVolumeResponse
+	15, // 65: storms.v1.StorageManagementService.DeleteVolume:output_type -> storms.v1.DeleteVolumeResponse
+	17, // 66: storms.v1.StorageManagementService.AttachVolume:output_type -> storms.v1.AttachVolumeResponse
+	19, // 67: storms.v1.StorageManagementService.DetachVolume:output_type -> storms.v1.DetachVolumeResponse
+	21, // 68: storms.v1.StorageManagementService.RevertVolumeToSnapshot:output_type -> storms.v1.RevertVolumeToSnapshotResponse
+	23, // 69: storms.v1.StorageManagementService.CopyVolume:output_type -> storms.v1.CopyVolumeResponse
+	25, // 70: storms.v1.StorageManagementService.UpdateVolumeLabels:output_type -> storms.v1.UpdateVolumeLabelsResponse
+	27, // 71: storms.v1.StorageManagementService.GetSnapshot:output_type -> storms.v1.GetSnapshotResponse
+	30, // 72: storms.v1.StorageManagementService.GetSnapshots:output_type -> storms.v1.GetSnapshotsResponse
+	32, // 73: storms.v1.StorageManagementService.CreateSnapshot:output_type -> storms.v1.CreateSnapshotResponse
+	34, // 74: storms.v1.StorageManagementService.DeleteSnapshot:output_type -> storms.v1.DeleteSnapshotResponse
+	36, // 75: storms.v1.StorageManagementService.CopySnapshot:output_type -> storms.v1.CopySnapshotResponse
+	38, // 76: storms.v1.StorageManagementService.UpdateSnapshotLabels:output_type -> storms.v1.UpdateSnapshotLabelsResponse
+	40, // 77: storms.v1.StorageManagementService.SyncResource:output_type -> storms.v1.SyncResourceResponse
+	42, // 78: storms.v1.StorageManagementService.SyncAllResources:output_type -> storms.v1.SyncAllResourcesResponse
+	44, // 79: storms.v1.StorageManagementService.GetTenantUsage:output_type -> storms.v1.GetTenantUsageResponse
+	46, // 80: storms.v1.StorageManagementService.GetOperation:output_type -> storms.v1.GetOperationResponse
+	48, // 81: storms.v1.StorageManagementService.ListOperations:output_type -> storms.v1.ListOperationsResponse
+	50, // 82: storms.v1.StorageManagementService.WaitOperation:output_type -> storms.v1.WaitOperationResponse
+	52, // 83: storms.v1.StorageManagementService.CancelOperation:output_type -> storms.v1.CancelOperationResponse
+	60, // [60:84] is the sub-list for method output_type
+	36, // [36:60] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_storms_v1_storms_proto_init() }
@@ -1704,8 +4128,92 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetVolumeResponse); i {
+		file_storms_v1_storms_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVolumesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*VolumeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVolumesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*NewVolumeSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SnapshotSourceVolumeSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1716,8 +4224,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetVolumesRequest); i {
+		file_storms_v1_storms_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1728,8 +4236,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetVolumesResponse); i {
+		file_storms_v1_storms_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CloneVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1740,8 +4248,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateVolumeRequest); i {
+		file_storms_v1_storms_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CloneVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1752,8 +4260,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*NewVolumeSpec); i {
+		file_storms_v1_storms_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ResizeVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1764,8 +4272,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*SnapshotSourceVolumeSpec); i {
+		file_storms_v1_storms_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ResizeVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1776,8 +4284,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateVolumeResponse); i {
+		file_storms_v1_storms_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1788,8 +4296,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*ResizeVolumeRequest); i {
+		file_storms_v1_storms_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1800,8 +4308,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ResizeVolumeResponse); i {
+		file_storms_v1_storms_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1812,8 +4320,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteVolumeRequest); i {
+		file_storms_v1_storms_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1824,8 +4332,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteVolumeResponse); i {
+		file_storms_v1_storms_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1836,8 +4344,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*AttachVolumeRequest); i {
+		file_storms_v1_storms_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1848,8 +4356,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*AttachVolumeResponse); i {
+		file_storms_v1_storms_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*RevertVolumeToSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1860,8 +4368,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*DetachVolumeRequest); i {
+		file_storms_v1_storms_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RevertVolumeToSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1872,8 +4380,8 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*DetachVolumeResponse); i {
+		file_storms_v1_storms_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*CopyVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1884,7 +4392,43 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*CopyVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateVolumeLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateVolumeLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[26].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSnapshotRequest); i {
 			case 0:
 				return &v.state
@@ -1896,7 +4440,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[27].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSnapshotResponse); i {
 			case 0:
 				return &v.state
@@ -1908,7 +4452,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSnapshotsRequest); i {
 			case 0:
 				return &v.state
@@ -1920,7 +4464,19 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*SnapshotFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[30].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSnapshotsResponse); i {
 			case 0:
 				return &v.state
@@ -1932,7 +4488,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSnapshotRequest); i {
 			case 0:
 				return &v.state
@@ -1944,7 +4500,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[21].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[32].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSnapshotResponse); i {
 			case 0:
 				return &v.state
@@ -1956,7 +4512,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[22].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSnapshotRequest); i {
 			case 0:
 				return &v.state
@@ -1968,7 +4524,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[23].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSnapshotResponse); i {
 			case 0:
 				return &v.state
@@ -1980,7 +4536,55 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[24].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*CopySnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*CopySnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateSnapshotLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateSnapshotLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*SyncResourceRequest); i {
 			case 0:
 				return &v.state
@@ -1992,7 +4596,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[25].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*SyncResourceResponse); i {
 			case 0:
 				return &v.state
@@ -2004,7 +4608,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*SyncAllResourcesRequest); i {
 			case 0:
 				return &v.state
@@ -2016,7 +4620,7 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
-		file_storms_v1_storms_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_storms_v1_storms_proto_msgTypes[42].Exporter = func(v any, i int) any {
 			switch v := v.(*SyncAllResourcesResponse); i {
 			case 0:
 				return &v.state
@@ -2028,18 +4632,140 @@ func file_storms_v1_storms_proto_init() {
 				return nil
 			}
 		}
+		file_storms_v1_storms_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTenantUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTenantUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOperationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOperationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOperationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*WaitOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*WaitOperationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storms_v1_storms_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelOperationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_storms_v1_storms_proto_msgTypes[4].OneofWrappers = []any{
+	file_storms_v1_storms_proto_msgTypes[3].OneofWrappers = []any{}
+	file_storms_v1_storms_proto_msgTypes[6].OneofWrappers = []any{
 		(*CreateVolumeRequest_FromNew)(nil),
 		(*CreateVolumeRequest_FromSnapshot)(nil),
 	}
+	file_storms_v1_storms_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_storms_v1_storms_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
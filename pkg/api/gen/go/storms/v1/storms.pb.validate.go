@@ -0,0 +1,7383 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: storms/v1/storms.proto
+
+package stormspb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _storms_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on GetVolumeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVolumeRequestMultiError, or nil if none found.
+func (m *GetVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = GetVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetVolumeRequestMultiError is an error wrapping multiple validation errors
+// returned by GetVolumeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVolumeRequestMultiError) AllErrors() []error { return m }
+
+// GetVolumeRequestValidationError is the validation error returned by
+// GetVolumeRequest.Validate if the designated constraints aren't met.
+type GetVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVolumeRequestValidationError) ErrorName() string { return "GetVolumeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVolumeRequestValidationError{}
+
+// Validate checks the field values on GetVolumeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVolumeResponseMultiError, or nil if none found.
+func (m *GetVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVolume()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVolume()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetVolumeResponseValidationError{
+				field:  "Volume",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVolumeResponseMultiError is an error wrapping multiple validation errors
+// returned by GetVolumeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVolumeResponseMultiError) AllErrors() []error { return m }
+
+// GetVolumeResponseValidationError is the validation error returned by
+// GetVolumeResponse.Validate if the designated constraints aren't met.
+type GetVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVolumeResponseValidationError) ErrorName() string {
+	return "GetVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVolumeResponseValidationError{}
+
+// Validate checks the field values on GetVolumesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetVolumesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVolumesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVolumesRequestMultiError, or nil if none found.
+func (m *GetVolumesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVolumesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetVolumesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetVolumesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetVolumesRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetVolumesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVolumesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetVolumesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetVolumesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVolumesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVolumesRequestMultiError) AllErrors() []error { return m }
+
+// GetVolumesRequestValidationError is the validation error returned by
+// GetVolumesRequest.Validate if the designated constraints aren't met.
+type GetVolumesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVolumesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVolumesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVolumesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVolumesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVolumesRequestValidationError) ErrorName() string {
+	return "GetVolumesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVolumesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVolumesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVolumesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVolumesRequestValidationError{}
+
+// Validate checks the field values on VolumeFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VolumeFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VolumeFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VolumeFilterMultiError, or
+// nil if none found.
+func (m *VolumeFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VolumeFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClusterId() != "" {
+
+		if err := m._validateUuid(m.GetClusterId()); err != nil {
+			err = VolumeFilterValidationError{
+				field:  "ClusterId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Vendor
+
+	// no validation rules for AclContains
+
+	if m.GetSourceSnapshotUuid() != "" {
+
+		if err := m._validateUuid(m.GetSourceSnapshotUuid()); err != nil {
+			err = VolumeFilterValidationError{
+				field:  "SourceSnapshotUuid",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VolumeFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VolumeFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VolumeFilterValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LabelSelector
+
+	if m.IsAvailable != nil {
+		// no validation rules for IsAvailable
+	}
+
+	if len(errors) > 0 {
+		return VolumeFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VolumeFilter) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VolumeFilterMultiError is an error wrapping multiple validation errors
+// returned by VolumeFilter.ValidateAll() if the designated constraints aren't met.
+type VolumeFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VolumeFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VolumeFilterMultiError) AllErrors() []error { return m }
+
+// VolumeFilterValidationError is the validation error returned by
+// VolumeFilter.Validate if the designated constraints aren't met.
+type VolumeFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VolumeFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VolumeFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VolumeFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VolumeFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VolumeFilterValidationError) ErrorName() string { return "VolumeFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VolumeFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVolumeFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VolumeFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VolumeFilterValidationError{}
+
+// Validate checks the field values on GetVolumesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVolumesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVolumesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVolumesResponseMultiError, or nil if none found.
+func (m *GetVolumesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVolumesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVolumes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVolumesResponseValidationError{
+						field:  fmt.Sprintf("Volumes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVolumesResponseValidationError{
+						field:  fmt.Sprintf("Volumes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVolumesResponseValidationError{
+					field:  fmt.Sprintf("Volumes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	for idx, item := range m.GetClusterFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVolumesResponseValidationError{
+						field:  fmt.Sprintf("ClusterFailures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVolumesResponseValidationError{
+						field:  fmt.Sprintf("ClusterFailures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVolumesResponseValidationError{
+					field:  fmt.Sprintf("ClusterFailures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetVolumesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVolumesResponseMultiError is an error wrapping multiple validation errors
+// returned by GetVolumesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetVolumesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVolumesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVolumesResponseMultiError) AllErrors() []error { return m }
+
+// GetVolumesResponseValidationError is the validation error returned by
+// GetVolumesResponse.Validate if the designated constraints aren't met.
+type GetVolumesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVolumesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVolumesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVolumesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVolumesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVolumesResponseValidationError) ErrorName() string {
+	return "GetVolumesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVolumesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVolumesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVolumesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVolumesResponseValidationError{}
+
+// Validate checks the field values on ClusterFailure with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterFailureMultiError,
+// or nil if none found.
+func (m *ClusterFailure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterFailure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterId
+
+	// no validation rules for Vendor
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ClusterFailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterFailureMultiError is an error wrapping multiple validation errors
+// returned by ClusterFailure.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterFailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterFailureMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterFailureMultiError) AllErrors() []error { return m }
+
+// ClusterFailureValidationError is the validation error returned by
+// ClusterFailure.Validate if the designated constraints aren't met.
+type ClusterFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterFailureValidationError) ErrorName() string { return "ClusterFailureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterFailureValidationError{}
+
+// Validate checks the field values on CreateVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateVolumeRequestMultiError, or nil if none found.
+func (m *CreateVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = CreateVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AffinityTags
+
+	// no validation rules for Async
+
+	if len(m.GetLabels()) > 64 {
+		err := CreateVolumeRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := CreateVolumeRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_CreateVolumeRequest_Labels_Pattern.MatchString(key) {
+				err := CreateVolumeRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := CreateVolumeRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTenantId()) > 63 {
+		err := CreateVolumeRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateVolumeRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := CreateVolumeRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch v := m.Source.(type) {
+	case *CreateVolumeRequest_FromNew:
+		if v == nil {
+			err := CreateVolumeRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFromNew()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateVolumeRequestValidationError{
+						field:  "FromNew",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateVolumeRequestValidationError{
+						field:  "FromNew",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFromNew()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateVolumeRequestValidationError{
+					field:  "FromNew",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateVolumeRequest_FromSnapshot:
+		if v == nil {
+			err := CreateVolumeRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFromSnapshot()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateVolumeRequestValidationError{
+						field:  "FromSnapshot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateVolumeRequestValidationError{
+						field:  "FromSnapshot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFromSnapshot()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateVolumeRequestValidationError{
+					field:  "FromSnapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CreateVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateVolumeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateVolumeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateVolumeRequestMultiError) AllErrors() []error { return m }
+
+// CreateVolumeRequestValidationError is the validation error returned by
+// CreateVolumeRequest.Validate if the designated constraints aren't met.
+type CreateVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateVolumeRequestValidationError) ErrorName() string {
+	return "CreateVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateVolumeRequestValidationError{}
+
+var _CreateVolumeRequest_Labels_Pattern = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$")
+
+var _CreateVolumeRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?)?$")
+
+// Validate checks the field values on NewVolumeSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NewVolumeSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NewVolumeSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NewVolumeSpecMultiError, or
+// nil if none found.
+func (m *NewVolumeSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NewVolumeSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSize() <= 0 {
+		err := NewVolumeSpecValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SectorSizeEnum_name[int32(m.GetSectorSize())]; !ok {
+		err := NewVolumeSpecValidationError{
+			field:  "SectorSize",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NewVolumeSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// NewVolumeSpecMultiError is an error wrapping multiple validation errors
+// returned by NewVolumeSpec.ValidateAll() if the designated constraints
+// aren't met.
+type NewVolumeSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewVolumeSpecMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewVolumeSpecMultiError) AllErrors() []error { return m }
+
+// NewVolumeSpecValidationError is the validation error returned by
+// NewVolumeSpec.Validate if the designated constraints aren't met.
+type NewVolumeSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewVolumeSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewVolumeSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewVolumeSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewVolumeSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewVolumeSpecValidationError) ErrorName() string { return "NewVolumeSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewVolumeSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewVolumeSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewVolumeSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewVolumeSpecValidationError{}
+
+// Validate checks the field values on SnapshotSourceVolumeSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SnapshotSourceVolumeSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SnapshotSourceVolumeSpec with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SnapshotSourceVolumeSpecMultiError, or nil if none found.
+func (m *SnapshotSourceVolumeSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SnapshotSourceVolumeSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSnapshotUuid()); err != nil {
+		err = SnapshotSourceVolumeSpecValidationError{
+			field:  "SnapshotUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SnapshotSourceVolumeSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SnapshotSourceVolumeSpec) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SnapshotSourceVolumeSpecMultiError is an error wrapping multiple validation
+// errors returned by SnapshotSourceVolumeSpec.ValidateAll() if the designated
+// constraints aren't met.
+type SnapshotSourceVolumeSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnapshotSourceVolumeSpecMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnapshotSourceVolumeSpecMultiError) AllErrors() []error { return m }
+
+// SnapshotSourceVolumeSpecValidationError is the validation error returned by
+// SnapshotSourceVolumeSpec.Validate if the designated constraints aren't met.
+type SnapshotSourceVolumeSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnapshotSourceVolumeSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnapshotSourceVolumeSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnapshotSourceVolumeSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnapshotSourceVolumeSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnapshotSourceVolumeSpecValidationError) ErrorName() string {
+	return "SnapshotSourceVolumeSpecValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SnapshotSourceVolumeSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnapshotSourceVolumeSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnapshotSourceVolumeSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnapshotSourceVolumeSpecValidationError{}
+
+// Validate checks the field values on CreateVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateVolumeResponseMultiError, or nil if none found.
+func (m *CreateVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if all {
+		switch v := interface{}(m.GetVolume()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVolume()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateVolumeResponseValidationError{
+				field:  "Volume",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClusterId
+
+	if len(errors) > 0 {
+		return CreateVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateVolumeResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateVolumeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateVolumeResponseMultiError) AllErrors() []error { return m }
+
+// CreateVolumeResponseValidationError is the validation error returned by
+// CreateVolumeResponse.Validate if the designated constraints aren't met.
+type CreateVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateVolumeResponseValidationError) ErrorName() string {
+	return "CreateVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateVolumeResponseValidationError{}
+
+// Validate checks the field values on CloneVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneVolumeRequestMultiError, or nil if none found.
+func (m *CloneVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = CloneVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSrcVolumeUuid()); err != nil {
+		err = CloneVolumeRequestValidationError{
+			field:  "SrcVolumeUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(m.GetLabels()) > 64 {
+		err := CloneVolumeRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := CloneVolumeRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_CloneVolumeRequest_Labels_Pattern.MatchString(key) {
+				err := CloneVolumeRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := CloneVolumeRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloneVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CloneVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CloneVolumeRequestMultiError is an error wrapping multiple validation errors
+// returned by CloneVolumeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CloneVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneVolumeRequestMultiError) AllErrors() []error { return m }
+
+// CloneVolumeRequestValidationError is the validation error returned by
+// CloneVolumeRequest.Validate if the designated constraints aren't met.
+type CloneVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneVolumeRequestValidationError) ErrorName() string {
+	return "CloneVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneVolumeRequestValidationError{}
+
+var _CloneVolumeRequest_Labels_Pattern = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$")
+
+// Validate checks the field values on CloneVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneVolumeResponseMultiError, or nil if none found.
+func (m *CloneVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if all {
+		switch v := interface{}(m.GetVolume()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloneVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloneVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVolume()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloneVolumeResponseValidationError{
+				field:  "Volume",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClusterId
+
+	if len(errors) > 0 {
+		return CloneVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneVolumeResponseMultiError is an error wrapping multiple validation
+// errors returned by CloneVolumeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CloneVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneVolumeResponseMultiError) AllErrors() []error { return m }
+
+// CloneVolumeResponseValidationError is the validation error returned by
+// CloneVolumeResponse.Validate if the designated constraints aren't met.
+type CloneVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneVolumeResponseValidationError) ErrorName() string {
+	return "CloneVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneVolumeResponseValidationError{}
+
+// Validate checks the field values on ResizeVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResizeVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResizeVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResizeVolumeRequestMultiError, or nil if none found.
+func (m *ResizeVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResizeVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = ResizeVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Size
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return ResizeVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ResizeVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ResizeVolumeRequestMultiError is an error wrapping multiple validation
+// errors returned by ResizeVolumeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResizeVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResizeVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResizeVolumeRequestMultiError) AllErrors() []error { return m }
+
+// ResizeVolumeRequestValidationError is the validation error returned by
+// ResizeVolumeRequest.Validate if the designated constraints aren't met.
+type ResizeVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResizeVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResizeVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResizeVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResizeVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResizeVolumeRequestValidationError) ErrorName() string {
+	return "ResizeVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResizeVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResizeVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResizeVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResizeVolumeRequestValidationError{}
+
+// Validate checks the field values on ResizeVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResizeVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResizeVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResizeVolumeResponseMultiError, or nil if none found.
+func (m *ResizeVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResizeVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if len(errors) > 0 {
+		return ResizeVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResizeVolumeResponseMultiError is an error wrapping multiple validation
+// errors returned by ResizeVolumeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResizeVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResizeVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResizeVolumeResponseMultiError) AllErrors() []error { return m }
+
+// ResizeVolumeResponseValidationError is the validation error returned by
+// ResizeVolumeResponse.Validate if the designated constraints aren't met.
+type ResizeVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResizeVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResizeVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResizeVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResizeVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResizeVolumeResponseValidationError) ErrorName() string {
+	return "ResizeVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResizeVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResizeVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResizeVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResizeVolumeResponseValidationError{}
+
+// Validate checks the field values on DeleteVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVolumeRequestMultiError, or nil if none found.
+func (m *DeleteVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DeleteVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return DeleteVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteVolumeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteVolumeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVolumeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteVolumeRequestValidationError is the validation error returned by
+// DeleteVolumeRequest.Validate if the designated constraints aren't met.
+type DeleteVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVolumeRequestValidationError) ErrorName() string {
+	return "DeleteVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVolumeRequestValidationError{}
+
+// Validate checks the field values on DeleteVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVolumeResponseMultiError, or nil if none found.
+func (m *DeleteVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if len(errors) > 0 {
+		return DeleteVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteVolumeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteVolumeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVolumeResponseMultiError) AllErrors() []error { return m }
+
+// DeleteVolumeResponseValidationError is the validation error returned by
+// DeleteVolumeResponse.Validate if the designated constraints aren't met.
+type DeleteVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVolumeResponseValidationError) ErrorName() string {
+	return "DeleteVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVolumeResponseValidationError{}
+
+// Validate checks the field values on AttachVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttachVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttachVolumeRequestMultiError, or nil if none found.
+func (m *AttachVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = AttachVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return AttachVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AttachVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AttachVolumeRequestMultiError is an error wrapping multiple validation
+// errors returned by AttachVolumeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttachVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachVolumeRequestMultiError) AllErrors() []error { return m }
+
+// AttachVolumeRequestValidationError is the validation error returned by
+// AttachVolumeRequest.Validate if the designated constraints aren't met.
+type AttachVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachVolumeRequestValidationError) ErrorName() string {
+	return "AttachVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttachVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachVolumeRequestValidationError{}
+
+// Validate checks the field values on AttachVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttachVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttachVolumeResponseMultiError, or nil if none found.
+func (m *AttachVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if len(errors) > 0 {
+		return AttachVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachVolumeResponseMultiError is an error wrapping multiple validation
+// errors returned by AttachVolumeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttachVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachVolumeResponseMultiError) AllErrors() []error { return m }
+
+// AttachVolumeResponseValidationError is the validation error returned by
+// AttachVolumeResponse.Validate if the designated constraints aren't met.
+type AttachVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachVolumeResponseValidationError) ErrorName() string {
+	return "AttachVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttachVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachVolumeResponseValidationError{}
+
+// Validate checks the field values on DetachVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DetachVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DetachVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DetachVolumeRequestMultiError, or nil if none found.
+func (m *DetachVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DetachVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DetachVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return DetachVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DetachVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DetachVolumeRequestMultiError is an error wrapping multiple validation
+// errors returned by DetachVolumeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DetachVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DetachVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DetachVolumeRequestMultiError) AllErrors() []error { return m }
+
+// DetachVolumeRequestValidationError is the validation error returned by
+// DetachVolumeRequest.Validate if the designated constraints aren't met.
+type DetachVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DetachVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DetachVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DetachVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DetachVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DetachVolumeRequestValidationError) ErrorName() string {
+	return "DetachVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DetachVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDetachVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DetachVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DetachVolumeRequestValidationError{}
+
+// Validate checks the field values on DetachVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DetachVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DetachVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DetachVolumeResponseMultiError, or nil if none found.
+func (m *DetachVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DetachVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if len(errors) > 0 {
+		return DetachVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DetachVolumeResponseMultiError is an error wrapping multiple validation
+// errors returned by DetachVolumeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DetachVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DetachVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DetachVolumeResponseMultiError) AllErrors() []error { return m }
+
+// DetachVolumeResponseValidationError is the validation error returned by
+// DetachVolumeResponse.Validate if the designated constraints aren't met.
+type DetachVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DetachVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DetachVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DetachVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DetachVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DetachVolumeResponseValidationError) ErrorName() string {
+	return "DetachVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DetachVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDetachVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DetachVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DetachVolumeResponseValidationError{}
+
+// Validate checks the field values on RevertVolumeToSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertVolumeToSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertVolumeToSnapshotRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevertVolumeToSnapshotRequestMultiError, or nil if none found.
+func (m *RevertVolumeToSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertVolumeToSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = RevertVolumeToSnapshotRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSnapshotUuid()); err != nil {
+		err = RevertVolumeToSnapshotRequestValidationError{
+			field:  "SnapshotUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return RevertVolumeToSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevertVolumeToSnapshotRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevertVolumeToSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by RevertVolumeToSnapshotRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevertVolumeToSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertVolumeToSnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertVolumeToSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// RevertVolumeToSnapshotRequestValidationError is the validation error
+// returned by RevertVolumeToSnapshotRequest.Validate if the designated
+// constraints aren't met.
+type RevertVolumeToSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertVolumeToSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertVolumeToSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertVolumeToSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertVolumeToSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertVolumeToSnapshotRequestValidationError) ErrorName() string {
+	return "RevertVolumeToSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertVolumeToSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertVolumeToSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertVolumeToSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertVolumeToSnapshotRequestValidationError{}
+
+// Validate checks the field values on RevertVolumeToSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertVolumeToSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertVolumeToSnapshotResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevertVolumeToSnapshotResponseMultiError, or nil if none found.
+func (m *RevertVolumeToSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertVolumeToSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if all {
+		switch v := interface{}(m.GetVolume()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevertVolumeToSnapshotResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevertVolumeToSnapshotResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVolume()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevertVolumeToSnapshotResponseValidationError{
+				field:  "Volume",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevertVolumeToSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevertVolumeToSnapshotResponseMultiError is an error wrapping multiple
+// validation errors returned by RevertVolumeToSnapshotResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevertVolumeToSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertVolumeToSnapshotResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertVolumeToSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// RevertVolumeToSnapshotResponseValidationError is the validation error
+// returned by RevertVolumeToSnapshotResponse.Validate if the designated
+// constraints aren't met.
+type RevertVolumeToSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertVolumeToSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertVolumeToSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertVolumeToSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertVolumeToSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertVolumeToSnapshotResponseValidationError) ErrorName() string {
+	return "RevertVolumeToSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertVolumeToSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertVolumeToSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertVolumeToSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertVolumeToSnapshotResponseValidationError{}
+
+// Validate checks the field values on CopyVolumeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CopyVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyVolumeRequestMultiError, or nil if none found.
+func (m *CopyVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = CopyVolumeRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSrcVolumeUuid()); err != nil {
+		err = CopyVolumeRequestValidationError{
+			field:  "SrcVolumeUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetDstClusterId()); err != nil {
+		err = CopyVolumeRequestValidationError{
+			field:  "DstClusterId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return CopyVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CopyVolumeRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CopyVolumeRequestMultiError is an error wrapping multiple validation errors
+// returned by CopyVolumeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CopyVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyVolumeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyVolumeRequestMultiError) AllErrors() []error { return m }
+
+// CopyVolumeRequestValidationError is the validation error returned by
+// CopyVolumeRequest.Validate if the designated constraints aren't met.
+type CopyVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyVolumeRequestValidationError) ErrorName() string {
+	return "CopyVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyVolumeRequestValidationError{}
+
+// Validate checks the field values on CopyVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyVolumeResponseMultiError, or nil if none found.
+func (m *CopyVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if all {
+		switch v := interface{}(m.GetVolume()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyVolumeResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVolume()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyVolumeResponseValidationError{
+				field:  "Volume",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClusterId
+
+	if len(errors) > 0 {
+		return CopyVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyVolumeResponseMultiError is an error wrapping multiple validation errors
+// returned by CopyVolumeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CopyVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyVolumeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyVolumeResponseMultiError) AllErrors() []error { return m }
+
+// CopyVolumeResponseValidationError is the validation error returned by
+// CopyVolumeResponse.Validate if the designated constraints aren't met.
+type CopyVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyVolumeResponseValidationError) ErrorName() string {
+	return "CopyVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyVolumeResponseValidationError{}
+
+// Validate checks the field values on UpdateVolumeLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateVolumeLabelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateVolumeLabelsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateVolumeLabelsRequestMultiError, or nil if none found.
+func (m *UpdateVolumeLabelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateVolumeLabelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = UpdateVolumeLabelsRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLabels()) > 64 {
+		err := UpdateVolumeLabelsRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := UpdateVolumeLabelsRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_UpdateVolumeLabelsRequest_Labels_Pattern.MatchString(key) {
+				err := UpdateVolumeLabelsRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := UpdateVolumeLabelsRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateVolumeLabelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateVolumeLabelsRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateVolumeLabelsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateVolumeLabelsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateVolumeLabelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateVolumeLabelsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateVolumeLabelsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateVolumeLabelsRequestValidationError is the validation error returned by
+// UpdateVolumeLabelsRequest.Validate if the designated constraints aren't met.
+type UpdateVolumeLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateVolumeLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateVolumeLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateVolumeLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateVolumeLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateVolumeLabelsRequestValidationError) ErrorName() string {
+	return "UpdateVolumeLabelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateVolumeLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateVolumeLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateVolumeLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateVolumeLabelsRequestValidationError{}
+
+var _UpdateVolumeLabelsRequest_Labels_Pattern = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$")
+
+// Validate checks the field values on UpdateVolumeLabelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateVolumeLabelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateVolumeLabelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateVolumeLabelsResponseMultiError, or nil if none found.
+func (m *UpdateVolumeLabelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateVolumeLabelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVolume()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateVolumeLabelsResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateVolumeLabelsResponseValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVolume()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateVolumeLabelsResponseValidationError{
+				field:  "Volume",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateVolumeLabelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateVolumeLabelsResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateVolumeLabelsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateVolumeLabelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateVolumeLabelsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateVolumeLabelsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateVolumeLabelsResponseValidationError is the validation error returned
+// by UpdateVolumeLabelsResponse.Validate if the designated constraints aren't met.
+type UpdateVolumeLabelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateVolumeLabelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateVolumeLabelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateVolumeLabelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateVolumeLabelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateVolumeLabelsResponseValidationError) ErrorName() string {
+	return "UpdateVolumeLabelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateVolumeLabelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateVolumeLabelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateVolumeLabelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateVolumeLabelsResponseValidationError{}
+
+// Validate checks the field values on GetSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSnapshotRequestMultiError, or nil if none found.
+func (m *GetSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = GetSnapshotRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetSnapshotRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetSnapshotRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSnapshotRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// GetSnapshotRequestValidationError is the validation error returned by
+// GetSnapshotRequest.Validate if the designated constraints aren't met.
+type GetSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSnapshotRequestValidationError) ErrorName() string {
+	return "GetSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSnapshotRequestValidationError{}
+
+// Validate checks the field values on GetSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSnapshotResponseMultiError, or nil if none found.
+func (m *GetSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSnapshotResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSnapshotResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// GetSnapshotResponseValidationError is the validation error returned by
+// GetSnapshotResponse.Validate if the designated constraints aren't met.
+type GetSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSnapshotResponseValidationError) ErrorName() string {
+	return "GetSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSnapshotResponseValidationError{}
+
+// Validate checks the field values on GetSnapshotsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSnapshotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSnapshotsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSnapshotsRequestMultiError, or nil if none found.
+func (m *GetSnapshotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSnapshotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSnapshotsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSnapshotsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSnapshotsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSnapshotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSnapshotsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSnapshotsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSnapshotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSnapshotsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSnapshotsRequestMultiError) AllErrors() []error { return m }
+
+// GetSnapshotsRequestValidationError is the validation error returned by
+// GetSnapshotsRequest.Validate if the designated constraints aren't met.
+type GetSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSnapshotsRequestValidationError) ErrorName() string {
+	return "GetSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSnapshotsRequestValidationError{}
+
+// Validate checks the field values on SnapshotFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SnapshotFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SnapshotFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SnapshotFilterMultiError,
+// or nil if none found.
+func (m *SnapshotFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SnapshotFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClusterId() != "" {
+
+		if err := m._validateUuid(m.GetClusterId()); err != nil {
+			err = SnapshotFilterValidationError{
+				field:  "ClusterId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Vendor
+
+	if m.GetSourceVolumeUuid() != "" {
+
+		if err := m._validateUuid(m.GetSourceVolumeUuid()); err != nil {
+			err = SnapshotFilterValidationError{
+				field:  "SourceVolumeUuid",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SnapshotFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SnapshotFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SnapshotFilterValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LabelSelector
+
+	if m.IsAvailable != nil {
+		// no validation rules for IsAvailable
+	}
+
+	if len(errors) > 0 {
+		return SnapshotFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SnapshotFilter) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SnapshotFilterMultiError is an error wrapping multiple validation errors
+// returned by SnapshotFilter.ValidateAll() if the designated constraints
+// aren't met.
+type SnapshotFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnapshotFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnapshotFilterMultiError) AllErrors() []error { return m }
+
+// SnapshotFilterValidationError is the validation error returned by
+// SnapshotFilter.Validate if the designated constraints aren't met.
+type SnapshotFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnapshotFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnapshotFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnapshotFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnapshotFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnapshotFilterValidationError) ErrorName() string { return "SnapshotFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SnapshotFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnapshotFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnapshotFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnapshotFilterValidationError{}
+
+// Validate checks the field values on GetSnapshotsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSnapshotsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSnapshotsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSnapshotsResponseMultiError, or nil if none found.
+func (m *GetSnapshotsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSnapshotsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSnapshotsResponseValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	for idx, item := range m.GetClusterFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("ClusterFailures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("ClusterFailures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSnapshotsResponseValidationError{
+					field:  fmt.Sprintf("ClusterFailures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSnapshotsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSnapshotsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSnapshotsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSnapshotsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSnapshotsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSnapshotsResponseMultiError) AllErrors() []error { return m }
+
+// GetSnapshotsResponseValidationError is the validation error returned by
+// GetSnapshotsResponse.Validate if the designated constraints aren't met.
+type GetSnapshotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSnapshotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSnapshotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSnapshotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSnapshotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSnapshotsResponseValidationError) ErrorName() string {
+	return "GetSnapshotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSnapshotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSnapshotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSnapshotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSnapshotsResponseValidationError{}
+
+// Validate checks the field values on CreateSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSnapshotRequestMultiError, or nil if none found.
+func (m *CreateSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = CreateSnapshotRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSrcVolumeUuid()); err != nil {
+		err = CreateSnapshotRequestValidationError{
+			field:  "SrcVolumeUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(m.GetLabels()) > 64 {
+		err := CreateSnapshotRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := CreateSnapshotRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_CreateSnapshotRequest_Labels_Pattern.MatchString(key) {
+				err := CreateSnapshotRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := CreateSnapshotRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTenantId()) > 63 {
+		err := CreateSnapshotRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateSnapshotRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := CreateSnapshotRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateSnapshotRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// CreateSnapshotRequestValidationError is the validation error returned by
+// CreateSnapshotRequest.Validate if the designated constraints aren't met.
+type CreateSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSnapshotRequestValidationError) ErrorName() string {
+	return "CreateSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSnapshotRequestValidationError{}
+
+var _CreateSnapshotRequest_Labels_Pattern = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$")
+
+var _CreateSnapshotRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?)?$")
+
+// Validate checks the field values on CreateSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSnapshotResponseMultiError, or nil if none found.
+func (m *CreateSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSnapshotResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClusterId
+
+	if len(errors) > 0 {
+		return CreateSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSnapshotResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// CreateSnapshotResponseValidationError is the validation error returned by
+// CreateSnapshotResponse.Validate if the designated constraints aren't met.
+type CreateSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSnapshotResponseValidationError) ErrorName() string {
+	return "CreateSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSnapshotResponseValidationError{}
+
+// Validate checks the field values on DeleteSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSnapshotRequestMultiError, or nil if none found.
+func (m *DeleteSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DeleteSnapshotRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return DeleteSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSnapshotRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSnapshotRequestValidationError is the validation error returned by
+// DeleteSnapshotRequest.Validate if the designated constraints aren't met.
+type DeleteSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSnapshotRequestValidationError) ErrorName() string {
+	return "DeleteSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSnapshotRequestValidationError{}
+
+// Validate checks the field values on DeleteSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSnapshotResponseMultiError, or nil if none found.
+func (m *DeleteSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if len(errors) > 0 {
+		return DeleteSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSnapshotResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSnapshotResponseValidationError is the validation error returned by
+// DeleteSnapshotResponse.Validate if the designated constraints aren't met.
+type DeleteSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSnapshotResponseValidationError) ErrorName() string {
+	return "DeleteSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSnapshotResponseValidationError{}
+
+// Validate checks the field values on CopySnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopySnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopySnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopySnapshotRequestMultiError, or nil if none found.
+func (m *CopySnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopySnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = CopySnapshotRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSrcSnapshotUuid()); err != nil {
+		err = CopySnapshotRequestValidationError{
+			field:  "SrcSnapshotUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetDstClusterId()); err != nil {
+		err = CopySnapshotRequestValidationError{
+			field:  "DstClusterId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Async
+
+	if len(errors) > 0 {
+		return CopySnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CopySnapshotRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CopySnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by CopySnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CopySnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopySnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopySnapshotRequestMultiError) AllErrors() []error { return m }
+
+// CopySnapshotRequestValidationError is the validation error returned by
+// CopySnapshotRequest.Validate if the designated constraints aren't met.
+type CopySnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopySnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopySnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopySnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopySnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopySnapshotRequestValidationError) ErrorName() string {
+	return "CopySnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopySnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopySnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopySnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopySnapshotRequestValidationError{}
+
+// Validate checks the field values on CopySnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopySnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopySnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopySnapshotResponseMultiError, or nil if none found.
+func (m *CopySnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopySnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopySnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopySnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopySnapshotResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClusterId
+
+	if len(errors) > 0 {
+		return CopySnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopySnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by CopySnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CopySnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopySnapshotResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopySnapshotResponseMultiError) AllErrors() []error { return m }
+
+// CopySnapshotResponseValidationError is the validation error returned by
+// CopySnapshotResponse.Validate if the designated constraints aren't met.
+type CopySnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopySnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopySnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopySnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopySnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopySnapshotResponseValidationError) ErrorName() string {
+	return "CopySnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopySnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopySnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopySnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopySnapshotResponseValidationError{}
+
+// Validate checks the field values on UpdateSnapshotLabelsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSnapshotLabelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSnapshotLabelsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSnapshotLabelsRequestMultiError, or nil if none found.
+func (m *UpdateSnapshotLabelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSnapshotLabelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = UpdateSnapshotLabelsRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLabels()) > 64 {
+		err := UpdateSnapshotLabelsRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := UpdateSnapshotLabelsRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_UpdateSnapshotLabelsRequest_Labels_Pattern.MatchString(key) {
+				err := UpdateSnapshotLabelsRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := UpdateSnapshotLabelsRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSnapshotLabelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateSnapshotLabelsRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateSnapshotLabelsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateSnapshotLabelsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSnapshotLabelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSnapshotLabelsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSnapshotLabelsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSnapshotLabelsRequestValidationError is the validation error returned
+// by UpdateSnapshotLabelsRequest.Validate if the designated constraints
+// aren't met.
+type UpdateSnapshotLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSnapshotLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSnapshotLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSnapshotLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSnapshotLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSnapshotLabelsRequestValidationError) ErrorName() string {
+	return "UpdateSnapshotLabelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSnapshotLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSnapshotLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSnapshotLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSnapshotLabelsRequestValidationError{}
+
+var _UpdateSnapshotLabelsRequest_Labels_Pattern = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$")
+
+// Validate checks the field values on UpdateSnapshotLabelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSnapshotLabelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSnapshotLabelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSnapshotLabelsResponseMultiError, or nil if none found.
+func (m *UpdateSnapshotLabelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSnapshotLabelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSnapshotLabelsResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSnapshotLabelsResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSnapshotLabelsResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSnapshotLabelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSnapshotLabelsResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateSnapshotLabelsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSnapshotLabelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSnapshotLabelsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSnapshotLabelsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSnapshotLabelsResponseValidationError is the validation error returned
+// by UpdateSnapshotLabelsResponse.Validate if the designated constraints
+// aren't met.
+type UpdateSnapshotLabelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSnapshotLabelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSnapshotLabelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSnapshotLabelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSnapshotLabelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSnapshotLabelsResponseValidationError) ErrorName() string {
+	return "UpdateSnapshotLabelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSnapshotLabelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSnapshotLabelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSnapshotLabelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSnapshotLabelsResponseValidationError{}
+
+// Validate checks the field values on SyncResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncResourceRequestMultiError, or nil if none found.
+func (m *SyncResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ResourceType_name[int32(m.GetResourceType())]; !ok {
+		err := SyncResourceRequestValidationError{
+			field:  "ResourceType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = SyncResourceRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetClusterUuid()); err != nil {
+		err = SyncResourceRequestValidationError{
+			field:  "ClusterUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SyncResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SyncResourceRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SyncResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by SyncResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncResourceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncResourceRequestMultiError) AllErrors() []error { return m }
+
+// SyncResourceRequestValidationError is the validation error returned by
+// SyncResourceRequest.Validate if the designated constraints aren't met.
+type SyncResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncResourceRequestValidationError) ErrorName() string {
+	return "SyncResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncResourceRequestValidationError{}
+
+// Validate checks the field values on SyncResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncResourceResponseMultiError, or nil if none found.
+func (m *SyncResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SyncResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by SyncResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SyncResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncResourceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncResourceResponseMultiError) AllErrors() []error { return m }
+
+// SyncResourceResponseValidationError is the validation error returned by
+// SyncResourceResponse.Validate if the designated constraints aren't met.
+type SyncResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncResourceResponseValidationError) ErrorName() string {
+	return "SyncResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncResourceResponseValidationError{}
+
+// Validate checks the field values on SyncAllResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncAllResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncAllResourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncAllResourcesRequestMultiError, or nil if none found.
+func (m *SyncAllResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncAllResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SyncAllResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncAllResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by SyncAllResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncAllResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncAllResourcesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncAllResourcesRequestMultiError) AllErrors() []error { return m }
+
+// SyncAllResourcesRequestValidationError is the validation error returned by
+// SyncAllResourcesRequest.Validate if the designated constraints aren't met.
+type SyncAllResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncAllResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncAllResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncAllResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncAllResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncAllResourcesRequestValidationError) ErrorName() string {
+	return "SyncAllResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncAllResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncAllResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncAllResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncAllResourcesRequestValidationError{}
+
+// Validate checks the field values on SyncAllResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncAllResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncAllResourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncAllResourcesResponseMultiError, or nil if none found.
+func (m *SyncAllResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncAllResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SyncAllResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncAllResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by SyncAllResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SyncAllResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncAllResourcesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncAllResourcesResponseMultiError) AllErrors() []error { return m }
+
+// SyncAllResourcesResponseValidationError is the validation error returned by
+// SyncAllResourcesResponse.Validate if the designated constraints aren't met.
+type SyncAllResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncAllResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncAllResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncAllResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncAllResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncAllResourcesResponseValidationError) ErrorName() string {
+	return "SyncAllResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncAllResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncAllResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncAllResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncAllResourcesResponseValidationError{}
+
+// Validate checks the field values on GetTenantUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantUsageRequestMultiError, or nil if none found.
+func (m *GetTenantUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTenantId()); l < 1 || l > 63 {
+		err := GetTenantUsageRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be between 1 and 63 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTenantUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTenantUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTenantUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetTenantUsageRequestValidationError is the validation error returned by
+// GetTenantUsageRequest.Validate if the designated constraints aren't met.
+type GetTenantUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantUsageRequestValidationError) ErrorName() string {
+	return "GetTenantUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantUsageRequestValidationError{}
+
+// Validate checks the field values on GetTenantUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantUsageResponseMultiError, or nil if none found.
+func (m *GetTenantUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantUsageResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantUsageResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantUsageResponseValidationError{
+				field:  "Usage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantUsageResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantUsageResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantUsageResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTenantUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTenantUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTenantUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantUsageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetTenantUsageResponseValidationError is the validation error returned by
+// GetTenantUsageResponse.Validate if the designated constraints aren't met.
+type GetTenantUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantUsageResponseValidationError) ErrorName() string {
+	return "GetTenantUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantUsageResponseValidationError{}
+
+// Validate checks the field values on GetOperationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOperationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOperationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOperationRequestMultiError, or nil if none found.
+func (m *GetOperationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOperationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetOperationRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOperationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetOperationRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetOperationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOperationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOperationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOperationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOperationRequestMultiError) AllErrors() []error { return m }
+
+// GetOperationRequestValidationError is the validation error returned by
+// GetOperationRequest.Validate if the designated constraints aren't met.
+type GetOperationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOperationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOperationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOperationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOperationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOperationRequestValidationError) ErrorName() string {
+	return "GetOperationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOperationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOperationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOperationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOperationRequestValidationError{}
+
+// Validate checks the field values on GetOperationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOperationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOperationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOperationResponseMultiError, or nil if none found.
+func (m *GetOperationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOperationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOperation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetOperationResponseValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetOperationResponseValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOperation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOperationResponseValidationError{
+				field:  "Operation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetOperationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOperationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOperationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOperationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOperationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOperationResponseMultiError) AllErrors() []error { return m }
+
+// GetOperationResponseValidationError is the validation error returned by
+// GetOperationResponse.Validate if the designated constraints aren't met.
+type GetOperationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOperationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOperationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOperationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOperationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOperationResponseValidationError) ErrorName() string {
+	return "GetOperationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOperationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOperationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOperationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOperationResponseValidationError{}
+
+// Validate checks the field values on ListOperationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOperationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOperationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOperationsRequestMultiError, or nil if none found.
+func (m *ListOperationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOperationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if m.GetResourceUuid() != "" {
+
+		if err := m._validateUuid(m.GetResourceUuid()); err != nil {
+			err = ListOperationsRequestValidationError{
+				field:  "ResourceUuid",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := OperationState_name[int32(m.GetState())]; !ok {
+		err := ListOperationsRequestValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListOperationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListOperationsRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListOperationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListOperationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOperationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOperationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOperationsRequestMultiError) AllErrors() []error { return m }
+
+// ListOperationsRequestValidationError is the validation error returned by
+// ListOperationsRequest.Validate if the designated constraints aren't met.
+type ListOperationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOperationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOperationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOperationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOperationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOperationsRequestValidationError) ErrorName() string {
+	return "ListOperationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOperationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOperationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOperationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOperationsRequestValidationError{}
+
+// Validate checks the field values on ListOperationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOperationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOperationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOperationsResponseMultiError, or nil if none found.
+func (m *ListOperationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOperationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOperationsResponseValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOperationsResponseValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOperationsResponseValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListOperationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOperationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListOperationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListOperationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOperationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOperationsResponseMultiError) AllErrors() []error { return m }
+
+// ListOperationsResponseValidationError is the validation error returned by
+// ListOperationsResponse.Validate if the designated constraints aren't met.
+type ListOperationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOperationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOperationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOperationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOperationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOperationsResponseValidationError) ErrorName() string {
+	return "ListOperationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOperationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOperationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOperationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOperationsResponseValidationError{}
+
+// Validate checks the field values on WaitOperationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WaitOperationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WaitOperationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WaitOperationRequestMultiError, or nil if none found.
+func (m *WaitOperationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WaitOperationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = WaitOperationRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WaitOperationRequestValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WaitOperationRequestValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WaitOperationRequestValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WaitOperationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WaitOperationRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WaitOperationRequestMultiError is an error wrapping multiple validation
+// errors returned by WaitOperationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WaitOperationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WaitOperationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WaitOperationRequestMultiError) AllErrors() []error { return m }
+
+// WaitOperationRequestValidationError is the validation error returned by
+// WaitOperationRequest.Validate if the designated constraints aren't met.
+type WaitOperationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WaitOperationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WaitOperationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WaitOperationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WaitOperationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WaitOperationRequestValidationError) ErrorName() string {
+	return "WaitOperationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WaitOperationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWaitOperationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WaitOperationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WaitOperationRequestValidationError{}
+
+// Validate checks the field values on WaitOperationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WaitOperationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WaitOperationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WaitOperationResponseMultiError, or nil if none found.
+func (m *WaitOperationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WaitOperationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOperation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WaitOperationResponseValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WaitOperationResponseValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOperation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WaitOperationResponseValidationError{
+				field:  "Operation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WaitOperationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WaitOperationResponseMultiError is an error wrapping multiple validation
+// errors returned by WaitOperationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WaitOperationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WaitOperationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WaitOperationResponseMultiError) AllErrors() []error { return m }
+
+// WaitOperationResponseValidationError is the validation error returned by
+// WaitOperationResponse.Validate if the designated constraints aren't met.
+type WaitOperationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WaitOperationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WaitOperationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WaitOperationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WaitOperationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WaitOperationResponseValidationError) ErrorName() string {
+	return "WaitOperationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WaitOperationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWaitOperationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WaitOperationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WaitOperationResponseValidationError{}
+
+// Validate checks the field values on CancelOperationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOperationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOperationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOperationRequestMultiError, or nil if none found.
+func (m *CancelOperationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOperationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = CancelOperationRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelOperationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CancelOperationRequest) _validateUuid(uuid string) error {
+	if matched := _storms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CancelOperationRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelOperationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelOperationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOperationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOperationRequestMultiError) AllErrors() []error { return m }
+
+// CancelOperationRequestValidationError is the validation error returned by
+// CancelOperationRequest.Validate if the designated constraints aren't met.
+type CancelOperationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOperationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOperationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOperationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOperationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOperationRequestValidationError) ErrorName() string {
+	return "CancelOperationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOperationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOperationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOperationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOperationRequestValidationError{}
+
+// Validate checks the field values on CancelOperationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOperationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOperationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOperationResponseMultiError, or nil if none found.
+func (m *CancelOperationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOperationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOperation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelOperationResponseValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelOperationResponseValidationError{
+					field:  "Operation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOperation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelOperationResponseValidationError{
+				field:  "Operation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelOperationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOperationResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelOperationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelOperationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOperationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOperationResponseMultiError) AllErrors() []error { return m }
+
+// CancelOperationResponseValidationError is the validation error returned by
+// CancelOperationResponse.Validate if the designated constraints aren't met.
+type CancelOperationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOperationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOperationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOperationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOperationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOperationResponseValidationError) ErrorName() string {
+	return "CancelOperationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOperationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOperationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOperationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOperationResponseValidationError{}
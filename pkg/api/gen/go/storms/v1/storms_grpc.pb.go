@@ -19,19 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StorageManagementService_GetVolume_FullMethodName        = "/storms.v1.StorageManagementService/GetVolume"
-	StorageManagementService_GetVolumes_FullMethodName       = "/storms.v1.StorageManagementService/GetVolumes"
-	StorageManagementService_CreateVolume_FullMethodName     = "/storms.v1.StorageManagementService/CreateVolume"
-	StorageManagementService_ResizeVolume_FullMethodName     = "/storms.v1.StorageManagementService/ResizeVolume"
-	StorageManagementService_DeleteVolume_FullMethodName     = "/storms.v1.StorageManagementService/DeleteVolume"
-	StorageManagementService_AttachVolume_FullMethodName     = "/storms.v1.StorageManagementService/AttachVolume"
-	StorageManagementService_DetachVolume_FullMethodName     = "/storms.v1.StorageManagementService/DetachVolume"
-	StorageManagementService_GetSnapshot_FullMethodName      = "/storms.v1.StorageManagementService/GetSnapshot"
-	StorageManagementService_GetSnapshots_FullMethodName     = "/storms.v1.StorageManagementService/GetSnapshots"
-	StorageManagementService_CreateSnapshot_FullMethodName   = "/storms.v1.StorageManagementService/CreateSnapshot"
-	StorageManagementService_DeleteSnapshot_FullMethodName   = "/storms.v1.StorageManagementService/DeleteSnapshot"
-	StorageManagementService_SyncResource_FullMethodName     = "/storms.v1.StorageManagementService/SyncResource"
-	StorageManagementService_SyncAllResources_FullMethodName = "/storms.v1.StorageManagementService/SyncAllResources"
+	StorageManagementService_GetVolume_FullMethodName              = "/storms.v1.StorageManagementService/GetVolume"
+	StorageManagementService_GetVolumes_FullMethodName             = "/storms.v1.StorageManagementService/GetVolumes"
+	StorageManagementService_CreateVolume_FullMethodName           = "/storms.v1.StorageManagementService/CreateVolume"
+	StorageManagementService_CloneVolume_FullMethodName            = "/storms.v1.StorageManagementService/CloneVolume"
+	StorageManagementService_ResizeVolume_FullMethodName           = "/storms.v1.StorageManagementService/ResizeVolume"
+	StorageManagementService_DeleteVolume_FullMethodName           = "/storms.v1.StorageManagementService/DeleteVolume"
+	StorageManagementService_AttachVolume_FullMethodName           = "/storms.v1.StorageManagementService/AttachVolume"
+	StorageManagementService_DetachVolume_FullMethodName           = "/storms.v1.StorageManagementService/DetachVolume"
+	StorageManagementService_RevertVolumeToSnapshot_FullMethodName = "/storms.v1.StorageManagementService/RevertVolumeToSnapshot"
+	StorageManagementService_CopyVolume_FullMethodName             = "/storms.v1.StorageManagementService/CopyVolume"
+	StorageManagementService_UpdateVolumeLabels_FullMethodName     = "/storms.v1.StorageManagementService/UpdateVolumeLabels"
+	StorageManagementService_GetSnapshot_FullMethodName            = "/storms.v1.StorageManagementService/GetSnapshot"
+	StorageManagementService_GetSnapshots_FullMethodName           = "/storms.v1.StorageManagementService/GetSnapshots"
+	StorageManagementService_CreateSnapshot_FullMethodName         = "/storms.v1.StorageManagementService/CreateSnapshot"
+	StorageManagementService_DeleteSnapshot_FullMethodName         = "/storms.v1.StorageManagementService/DeleteSnapshot"
+	StorageManagementService_CopySnapshot_FullMethodName           = "/storms.v1.StorageManagementService/CopySnapshot"
+	StorageManagementService_UpdateSnapshotLabels_FullMethodName   = "/storms.v1.StorageManagementService/UpdateSnapshotLabels"
+	StorageManagementService_SyncResource_FullMethodName           = "/storms.v1.StorageManagementService/SyncResource"
+	StorageManagementService_SyncAllResources_FullMethodName       = "/storms.v1.StorageManagementService/SyncAllResources"
+	StorageManagementService_GetTenantUsage_FullMethodName         = "/storms.v1.StorageManagementService/GetTenantUsage"
+	StorageManagementService_GetOperation_FullMethodName           = "/storms.v1.StorageManagementService/GetOperation"
+	StorageManagementService_ListOperations_FullMethodName         = "/storms.v1.StorageManagementService/ListOperations"
+	StorageManagementService_WaitOperation_FullMethodName          = "/storms.v1.StorageManagementService/WaitOperation"
+	StorageManagementService_CancelOperation_FullMethodName        = "/storms.v1.StorageManagementService/CancelOperation"
 )
 
 // StorageManagementServiceClient is the client API for StorageManagementService service.
@@ -51,6 +62,8 @@ type StorageManagementServiceClient interface {
 	GetVolumes(ctx context.Context, in *GetVolumesRequest, opts ...grpc.CallOption) (*GetVolumesResponse, error)
 	// Create a new volume.
 	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
+	// Clone a volume on the cluster it lives on.
+	CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...grpc.CallOption) (*CloneVolumeResponse, error)
 	// Resize a volume.
 	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error)
 	// Delete a volume
@@ -59,6 +72,12 @@ type StorageManagementServiceClient interface {
 	AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error)
 	// Detach a Volume
 	DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error)
+	// Revert a volume in place to one of its snapshots.
+	RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotRequest, opts ...grpc.CallOption) (*RevertVolumeToSnapshotResponse, error)
+	// Copy a volume to another cluster of the same vendor.
+	CopyVolume(ctx context.Context, in *CopyVolumeRequest, opts ...grpc.CallOption) (*CopyVolumeResponse, error)
+	// Set or remove labels of a volume.
+	UpdateVolumeLabels(ctx context.Context, in *UpdateVolumeLabelsRequest, opts ...grpc.CallOption) (*UpdateVolumeLabelsResponse, error)
 	// /////////////////////// SNAPSHOT /////////////////////////////
 	// Block storage Snapshot opertion
 	// Retrive a snapshot.
@@ -69,10 +88,27 @@ type StorageManagementServiceClient interface {
 	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
 	// Delete a snapshot.
 	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+	// Copy a snapshot to another cluster of the same vendor.
+	CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error)
+	// Set or remove labels of a snapshot.
+	UpdateSnapshotLabels(ctx context.Context, in *UpdateSnapshotLabelsRequest, opts ...grpc.CallOption) (*UpdateSnapshotLabelsResponse, error)
 	// Sync a resource
 	SyncResource(ctx context.Context, in *SyncResourceRequest, opts ...grpc.CallOption) (*SyncResourceResponse, error)
 	// Sync all resource from all clusters
 	SyncAllResources(ctx context.Context, in *SyncAllResourcesRequest, opts ...grpc.CallOption) (*SyncAllResourcesResponse, error)
+	// /////////////////////// TENANT /////////////////////////////
+	// Retrieve the resources a tenant owns and its quota.
+	GetTenantUsage(ctx context.Context, in *GetTenantUsageRequest, opts ...grpc.CallOption) (*GetTenantUsageResponse, error)
+	// /////////////////////// OPERATION /////////////////////////////
+	// Mutating requests sent with async set return an operation ID instead of waiting for the vendor.
+	// Retrieve an operation.
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	// Retrieve all operations.
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// Wait for an operation to complete, or for the timeout to elapse.
+	WaitOperation(ctx context.Context, in *WaitOperationRequest, opts ...grpc.CallOption) (*WaitOperationResponse, error)
+	// Cancel an operation that has not yet completed.
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error)
 }
 
 type storageManagementServiceClient struct {
@@ -113,6 +149,16 @@ func (c *storageManagementServiceClient) CreateVolume(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *storageManagementServiceClient) CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...grpc.CallOption) (*CloneVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneVolumeResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_CloneVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageManagementServiceClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResizeVolumeResponse)
@@ -153,6 +199,36 @@ func (c *storageManagementServiceClient) DetachVolume(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *storageManagementServiceClient) RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotRequest, opts ...grpc.CallOption) (*RevertVolumeToSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertVolumeToSnapshotResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_RevertVolumeToSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageManagementServiceClient) CopyVolume(ctx context.Context, in *CopyVolumeRequest, opts ...grpc.CallOption) (*CopyVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyVolumeResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_CopyVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageManagementServiceClient) UpdateVolumeLabels(ctx context.Context, in *UpdateVolumeLabelsRequest, opts ...grpc.CallOption) (*UpdateVolumeLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVolumeLabelsResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_UpdateVolumeLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageManagementServiceClient) GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*GetSnapshotResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSnapshotResponse)
@@ -193,6 +269,26 @@ func (c *storageManagementServiceClient) DeleteSnapshot(ctx context.Context, in
 	return out, nil
 }
 
+func (c *storageManagementServiceClient) CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopySnapshotResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_CopySnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageManagementServiceClient) UpdateSnapshotLabels(ctx context.Context, in *UpdateSnapshotLabelsRequest, opts ...grpc.CallOption) (*UpdateSnapshotLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSnapshotLabelsResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_UpdateSnapshotLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageManagementServiceClient) SyncResource(ctx context.Context, in *SyncResourceRequest, opts ...grpc.CallOption) (*SyncResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SyncResourceResponse)
@@ -213,6 +309,56 @@ func (c *storageManagementServiceClient) SyncAllResources(ctx context.Context, i
 	return out, nil
 }
 
+func (c *storageManagementServiceClient) GetTenantUsage(ctx context.Context, in *GetTenantUsageRequest, opts ...grpc.CallOption) (*GetTenantUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantUsageResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_GetTenantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageManagementServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageManagementServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_ListOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageManagementServiceClient) WaitOperation(ctx context.Context, in *WaitOperationRequest, opts ...grpc.CallOption) (*WaitOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitOperationResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_WaitOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageManagementServiceClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOperationResponse)
+	err := c.cc.Invoke(ctx, StorageManagementService_CancelOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageManagementServiceServer is the server API for StorageManagementService service.
 // All implementations must embed UnimplementedStorageManagementServiceServer
 // for forward compatibility.
@@ -230,6 +376,8 @@ type StorageManagementServiceServer interface {
 	GetVolumes(context.Context, *GetVolumesRequest) (*GetVolumesResponse, error)
 	// Create a new volume.
 	CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
+	// Clone a volume on the cluster it lives on.
+	CloneVolume(context.Context, *CloneVolumeRequest) (*CloneVolumeResponse, error)
 	// Resize a volume.
 	ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error)
 	// Delete a volume
@@ -238,6 +386,12 @@ type StorageManagementServiceServer interface {
 	AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error)
 	// Detach a Volume
 	DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error)
+	// Revert a volume in place to one of its snapshots.
+	RevertVolumeToSnapshot(context.Context, *RevertVolumeToSnapshotRequest) (*RevertVolumeToSnapshotResponse, error)
+	// Copy a volume to another cluster of the same vendor.
+	CopyVolume(context.Context, *CopyVolumeRequest) (*CopyVolumeResponse, error)
+	// Set or remove labels of a volume.
+	UpdateVolumeLabels(context.Context, *UpdateVolumeLabelsRequest) (*UpdateVolumeLabelsResponse, error)
 	// /////////////////////// SNAPSHOT /////////////////////////////
 	// Block storage Snapshot opertion
 	// Retrive a snapshot.
@@ -248,10 +402,27 @@ type StorageManagementServiceServer interface {
 	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
 	// Delete a snapshot.
 	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	// Copy a snapshot to another cluster of the same vendor.
+	CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error)
+	// Set or remove labels of a snapshot.
+	UpdateSnapshotLabels(context.Context, *UpdateSnapshotLabelsRequest) (*UpdateSnapshotLabelsResponse, error)
 	// Sync a resource
 	SyncResource(context.Context, *SyncResourceRequest) (*SyncResourceResponse, error)
 	// Sync all resource from all clusters
 	SyncAllResources(context.Context, *SyncAllResourcesRequest) (*SyncAllResourcesResponse, error)
+	// /////////////////////// TENANT /////////////////////////////
+	// Retrieve the resources a tenant owns and its quota.
+	GetTenantUsage(context.Context, *GetTenantUsageRequest) (*GetTenantUsageResponse, error)
+	// /////////////////////// OPERATION /////////////////////////////
+	// Mutating requests sent with async set return an operation ID instead of waiting for the vendor.
+	// Retrieve an operation.
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	// Retrieve all operations.
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// Wait for an operation to complete, or for the timeout to elapse.
+	WaitOperation(context.Context, *WaitOperationRequest) (*WaitOperationResponse, error)
+	// Cancel an operation that has not yet completed.
+	CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error)
 	mustEmbedUnimplementedStorageManagementServiceServer()
 }
 
@@ -271,6 +442,9 @@ func (UnimplementedStorageManagementServiceServer) GetVolumes(context.Context, *
 func (UnimplementedStorageManagementServiceServer) CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
 }
+func (UnimplementedStorageManagementServiceServer) CloneVolume(context.Context, *CloneVolumeRequest) (*CloneVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneVolume not implemented")
+}
 func (UnimplementedStorageManagementServiceServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResizeVolume not implemented")
 }
@@ -283,6 +457,15 @@ func (UnimplementedStorageManagementServiceServer) AttachVolume(context.Context,
 func (UnimplementedStorageManagementServiceServer) DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DetachVolume not implemented")
 }
+func (UnimplementedStorageManagementServiceServer) RevertVolumeToSnapshot(context.Context, *RevertVolumeToSnapshotRequest) (*RevertVolumeToSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertVolumeToSnapshot not implemented")
+}
+func (UnimplementedStorageManagementServiceServer) CopyVolume(context.Context, *CopyVolumeRequest) (*CopyVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyVolume not implemented")
+}
+func (UnimplementedStorageManagementServiceServer) UpdateVolumeLabels(context.Context, *UpdateVolumeLabelsRequest) (*UpdateVolumeLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVolumeLabels not implemented")
+}
 func (UnimplementedStorageManagementServiceServer) GetSnapshot(context.Context, *GetSnapshotRequest) (*GetSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
 }
@@ -295,12 +478,33 @@ func (UnimplementedStorageManagementServiceServer) CreateSnapshot(context.Contex
 func (UnimplementedStorageManagementServiceServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
 }
+func (UnimplementedStorageManagementServiceServer) CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopySnapshot not implemented")
+}
+func (UnimplementedStorageManagementServiceServer) UpdateSnapshotLabels(context.Context, *UpdateSnapshotLabelsRequest) (*UpdateSnapshotLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSnapshotLabels not implemented")
+}
 func (UnimplementedStorageManagementServiceServer) SyncResource(context.Context, *SyncResourceRequest) (*SyncResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncResource not implemented")
 }
 func (UnimplementedStorageManagementServiceServer) SyncAllResources(context.Context, *SyncAllResourcesRequest) (*SyncAllResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncAllResources not implemented")
 }
+func (UnimplementedStorageManagementServiceServer) GetTenantUsage(context.Context, *GetTenantUsageRequest) (*GetTenantUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantUsage not implemented")
+}
+func (UnimplementedStorageManagementServiceServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedStorageManagementServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedStorageManagementServiceServer) WaitOperation(context.Context, *WaitOperationRequest) (*WaitOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitOperation not implemented")
+}
+func (UnimplementedStorageManagementServiceServer) CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
 func (UnimplementedStorageManagementServiceServer) mustEmbedUnimplementedStorageManagementServiceServer() {
 }
 func (UnimplementedStorageManagementServiceServer) testEmbeddedByValue() {}
@@ -377,6 +581,24 @@ func _StorageManagementService_CreateVolume_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageManagementService_CloneVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).CloneVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_CloneVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).CloneVolume(ctx, req.(*CloneVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageManagementService_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResizeVolumeRequest)
 	if err := dec(in); err != nil {
@@ -449,6 +671,60 @@ func _StorageManagementService_DetachVolume_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageManagementService_RevertVolumeToSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertVolumeToSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).RevertVolumeToSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_RevertVolumeToSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).RevertVolumeToSnapshot(ctx, req.(*RevertVolumeToSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageManagementService_CopyVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).CopyVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_CopyVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).CopyVolume(ctx, req.(*CopyVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageManagementService_UpdateVolumeLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolumeLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).UpdateVolumeLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_UpdateVolumeLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).UpdateVolumeLabels(ctx, req.(*UpdateVolumeLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageManagementService_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -521,6 +797,42 @@ func _StorageManagementService_DeleteSnapshot_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageManagementService_CopySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).CopySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_CopySnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).CopySnapshot(ctx, req.(*CopySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageManagementService_UpdateSnapshotLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSnapshotLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).UpdateSnapshotLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_UpdateSnapshotLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).UpdateSnapshotLabels(ctx, req.(*UpdateSnapshotLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageManagementService_SyncResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncResourceRequest)
 	if err := dec(in); err != nil {
@@ -557,6 +869,96 @@ func _StorageManagementService_SyncAllResources_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageManagementService_GetTenantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).GetTenantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_GetTenantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).GetTenantUsage(ctx, req.(*GetTenantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageManagementService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageManagementService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageManagementService_WaitOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).WaitOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_WaitOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).WaitOperation(ctx, req.(*WaitOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageManagementService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageManagementServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageManagementService_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageManagementServiceServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageManagementService_ServiceDesc is the grpc.ServiceDesc for StorageManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -576,6 +978,10 @@ var StorageManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateVolume",
 			Handler:    _StorageManagementService_CreateVolume_Handler,
 		},
+		{
+			MethodName: "CloneVolume",
+			Handler:    _StorageManagementService_CloneVolume_Handler,
+		},
 		{
 			MethodName: "ResizeVolume",
 			Handler:    _StorageManagementService_ResizeVolume_Handler,
@@ -592,6 +998,18 @@ var StorageManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DetachVolume",
 			Handler:    _StorageManagementService_DetachVolume_Handler,
 		},
+		{
+			MethodName: "RevertVolumeToSnapshot",
+			Handler:    _StorageManagementService_RevertVolumeToSnapshot_Handler,
+		},
+		{
+			MethodName: "CopyVolume",
+			Handler:    _StorageManagementService_CopyVolume_Handler,
+		},
+		{
+			MethodName: "UpdateVolumeLabels",
+			Handler:    _StorageManagementService_UpdateVolumeLabels_Handler,
+		},
 		{
 			MethodName: "GetSnapshot",
 			Handler:    _StorageManagementService_GetSnapshot_Handler,
@@ -608,6 +1026,14 @@ var StorageManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSnapshot",
 			Handler:    _StorageManagementService_DeleteSnapshot_Handler,
 		},
+		{
+			MethodName: "CopySnapshot",
+			Handler:    _StorageManagementService_CopySnapshot_Handler,
+		},
+		{
+			MethodName: "UpdateSnapshotLabels",
+			Handler:    _StorageManagementService_UpdateSnapshotLabels_Handler,
+		},
 		{
 			MethodName: "SyncResource",
 			Handler:    _StorageManagementService_SyncResource_Handler,
@@ -616,6 +1042,26 @@ var StorageManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncAllResources",
 			Handler:    _StorageManagementService_SyncAllResources_Handler,
 		},
+		{
+			MethodName: "GetTenantUsage",
+			Handler:    _StorageManagementService_GetTenantUsage_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _StorageManagementService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _StorageManagementService_ListOperations_Handler,
+		},
+		{
+			MethodName: "WaitOperation",
+			Handler:    _StorageManagementService_WaitOperation_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _StorageManagementService_CancelOperation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storms/v1/storms.proto",
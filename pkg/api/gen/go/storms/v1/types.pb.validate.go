@@ -0,0 +1,858 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: storms/v1/types.proto
+
+package stormspb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _types_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Volume with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Volume) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Volume with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in VolumeMultiError, or nil if none found.
+func (m *Volume) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Volume) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = VolumeValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VendorVolumeId
+
+	// no validation rules for Size
+
+	// no validation rules for SectorSize
+
+	// no validation rules for IsAvailable
+
+	if err := m._validateUuid(m.GetSourceSnapshotUuid()); err != nil {
+		err = VolumeValidationError{
+			field:  "SourceSnapshotUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Labels
+
+	// no validation rules for IsFailed
+
+	if m.CreatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VolumeValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VolumeValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VolumeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VolumeMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Volume) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VolumeMultiError is an error wrapping multiple validation errors returned by
+// Volume.ValidateAll() if the designated constraints aren't met.
+type VolumeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VolumeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VolumeMultiError) AllErrors() []error { return m }
+
+// VolumeValidationError is the validation error returned by Volume.Validate if
+// the designated constraints aren't met.
+type VolumeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VolumeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VolumeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VolumeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VolumeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VolumeValidationError) ErrorName() string { return "VolumeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VolumeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVolume.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VolumeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VolumeValidationError{}
+
+// Validate checks the field values on Snapshot with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Snapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Snapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SnapshotMultiError, or nil
+// if none found.
+func (m *Snapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Snapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = SnapshotValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VendorSnapshotId
+
+	// no validation rules for Size
+
+	// no validation rules for SectorSize
+
+	// no validation rules for IsAvailable
+
+	if err := m._validateUuid(m.GetSourceVolumeUuid()); err != nil {
+		err = SnapshotValidationError{
+			field:  "SourceVolumeUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Labels
+
+	// no validation rules for IsFailed
+
+	if m.CreatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SnapshotValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SnapshotValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Snapshot) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SnapshotMultiError is an error wrapping multiple validation errors returned
+// by Snapshot.ValidateAll() if the designated constraints aren't met.
+type SnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnapshotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnapshotMultiError) AllErrors() []error { return m }
+
+// SnapshotValidationError is the validation error returned by
+// Snapshot.Validate if the designated constraints aren't met.
+type SnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnapshotValidationError) ErrorName() string { return "SnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnapshotValidationError{}
+
+// Validate checks the field values on Operation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Operation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Operation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OperationMultiError, or nil
+// if none found.
+func (m *Operation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Operation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Method
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceUuid
+
+	// no validation rules for State
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OperationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OperationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OperationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OperationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OperationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OperationValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Result.(type) {
+	case *Operation_Volume:
+		if v == nil {
+			err := OperationValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetVolume()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OperationValidationError{
+						field:  "Volume",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OperationValidationError{
+						field:  "Volume",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetVolume()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OperationValidationError{
+					field:  "Volume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Operation_Snapshot:
+		if v == nil {
+			err := OperationValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSnapshot()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OperationValidationError{
+						field:  "Snapshot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OperationValidationError{
+						field:  "Snapshot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OperationValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return OperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OperationMultiError is an error wrapping multiple validation errors returned
+// by Operation.ValidateAll() if the designated constraints aren't met.
+type OperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OperationMultiError) AllErrors() []error { return m }
+
+// OperationValidationError is the validation error returned by
+// Operation.Validate if the designated constraints aren't met.
+type OperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OperationValidationError) ErrorName() string { return "OperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OperationValidationError{}
+
+// Validate checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantUsageMultiError, or
+// nil if none found.
+func (m *TenantUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Volumes
+
+	// no validation rules for Snapshots
+
+	// no validation rules for ProvisionedBytes
+
+	if len(errors) > 0 {
+		return TenantUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUsageMultiError is an error wrapping multiple validation errors
+// returned by TenantUsage.ValidateAll() if the designated constraints aren't met.
+type TenantUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUsageMultiError) AllErrors() []error { return m }
+
+// TenantUsageValidationError is the validation error returned by
+// TenantUsage.Validate if the designated constraints aren't met.
+type TenantUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUsageValidationError) ErrorName() string { return "TenantUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUsageValidationError{}
+
+// Validate checks the field values on TenantQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantQuota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantQuotaMultiError, or
+// nil if none found.
+func (m *TenantQuota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantQuota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxVolumes
+
+	// no validation rules for MaxSnapshots
+
+	// no validation rules for MaxProvisionedBytes
+
+	if len(errors) > 0 {
+		return TenantQuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantQuotaMultiError is an error wrapping multiple validation errors
+// returned by TenantQuota.ValidateAll() if the designated constraints aren't met.
+type TenantQuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantQuotaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantQuotaMultiError) AllErrors() []error { return m }
+
+// TenantQuotaValidationError is the validation error returned by
+// TenantQuota.Validate if the designated constraints aren't met.
+type TenantQuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantQuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantQuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantQuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantQuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantQuotaValidationError) ErrorName() string { return "TenantQuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantQuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantQuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantQuotaValidationError{}
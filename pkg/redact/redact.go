@@ -0,0 +1,153 @@
+// Package redact hides sensitive values from logs.
+//
+// Protobuf fields are sensitive if they are marked with the common.field_option.sensitive option set to "true". Fields
+// of config structs are sensitive if they are tagged `sensitive:"true"`.
+package redact
+
+import (
+	"reflect"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	fieldoptionpb "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/common/field_option"
+)
+
+// Placeholder replaces sensitive strings that are set, so that logs still show that they were.
+const Placeholder = "[REDACTED]"
+
+const structTag = "sensitive"
+
+// IsSensitive reports whether a protobuf field is marked sensitive.
+func IsSensitive(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return false
+	}
+	v, _ := proto.GetExtension(opts, fieldoptionpb.E_Sensitive).(string)
+	sensitive, err := strconv.ParseBool(v)
+
+	return err == nil && sensitive
+}
+
+// Message returns a copy of m in which every sensitive field, at any depth, is replaced by Placeholder if it is a
+// string and cleared otherwise. m itself is not modified.
+func Message(m proto.Message) proto.Message {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return m
+	}
+
+	c := proto.Clone(m)
+	redactMessage(c.ProtoReflect())
+
+	return c
+}
+
+// Request redacts a gRPC request if it is a protobuf message, and returns any other value as is.
+func Request(req interface{}) interface{} {
+	if m, ok := req.(proto.Message); ok {
+		return Message(m)
+	}
+
+	return req
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case IsSensitive(fd):
+			if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+				m.Set(fd, protoreflect.ValueOfString(Placeholder))
+			} else {
+				m.Clear(fd)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := range v.List().Len() {
+					redactMessage(v.List().Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redactMessage(v.Message())
+		}
+
+		return true
+	})
+}
+
+// Struct returns a deep copy of v, typically a config struct or a pointer to one, in which every exported field tagged
+// `sensitive:"true"`, at any depth, is replaced by Placeholder if it is a non-empty string and zeroed otherwise. v
+// itself is not modified.
+func Struct[T any](v T) T {
+	out, _ := redactedCopy(reflect.ValueOf(&v).Elem()).Interface().(T)
+
+	return out
+}
+
+//nolint:exhaustive // other kinds hold no fields
+func redactedCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(redactedCopy(v.Elem()))
+
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if sensitive, _ := strconv.ParseBool(field.Tag.Get(structTag)); !sensitive {
+				c.Field(i).Set(redactedCopy(v.Field(i)))
+
+				continue
+			}
+			if field.Type.Kind() == reflect.String && v.Field(i).Len() > 0 {
+				c.Field(i).SetString(Placeholder)
+			} else {
+				c.Field(i).Set(reflect.Zero(field.Type))
+			}
+		}
+
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			c.Index(i).Set(redactedCopy(v.Index(i)))
+		}
+
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), redactedCopy(iter.Value()))
+		}
+
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,159 @@
+package redact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	fieldoptionpb "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/common/field_option"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+)
+
+func sensitiveOption(value string) *descriptorpb.FieldOptions {
+	opts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(opts, fieldoptionpb.E_Sensitive, value)
+
+	return opts
+}
+
+func field(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, opts *descriptorpb.FieldOptions,
+) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     typ.Enum(),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Options:  opts,
+	}
+}
+
+func messageField(name string, number int32, label descriptorpb.FieldDescriptorProto_Label,
+) *descriptorpb.FieldDescriptorProto {
+	f := field(name, number, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, nil)
+	f.TypeName = proto.String(".redact.test.Credentials")
+	f.Label = label.Enum()
+
+	return f
+}
+
+// Returns the descriptor of a message with sensitive fields, at the top level and nested.
+func testDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+
+	hosts := field("hosts", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, sensitiveOption("true"))
+	hosts.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("redact_test.proto"),
+		Package: proto.String("redact.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Credentials"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("user", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, nil),
+					field("password", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, sensitiveOption("true")),
+				},
+			},
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("uuid", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, sensitiveOption("false")),
+					field("pin", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT32, sensitiveOption("true")),
+					hosts,
+					messageField("credentials", 4, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+					messageField("more_credentials", 5, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	return file.Messages().ByName("Request")
+}
+
+func Test_Message(t *testing.T) {
+	m := dynamicpb.NewMessage(testDescriptor(t))
+	original := `{
+		"uuid": "vol-1",
+		"pin": 1234,
+		"hosts": ["host-a"],
+		"credentials": {"user": "admin", "password": "hunter2"},
+		"more_credentials": [{"user": "ops", "password": "letmein"}, {"user": "empty"}]
+	}`
+	require.NoError(t, protojson.Unmarshal([]byte(original), m))
+
+	b, err := protojson.Marshal(Message(m))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"uuid": "vol-1",
+		"credentials": {"user": "admin", "password": "[REDACTED]"},
+		"more_credentials": [{"user": "ops", "password": "[REDACTED]"}, {"user": "empty"}]
+	}`, string(b))
+
+	// The message itself is unchanged.
+	b, err = protojson.Marshal(m)
+	require.NoError(t, err)
+	require.JSONEq(t, original, string(b))
+}
+
+func Test_Message_NotSensitive(t *testing.T) {
+	req := &storms.GetVolumesRequest{PageSize: 10, Filter: &storms.VolumeFilter{Vendor: "lightbits"}}
+
+	require.True(t, proto.Equal(req, Message(req)))
+	require.Nil(t, Message(nil))
+	require.Equal(t, "not a message", Request("not a message"))
+}
+
+type endpoint struct {
+	Addr      string
+	AuthToken string `sensitive:"true"`
+}
+
+type config struct {
+	Username  string
+	Password  string `sensitive:"true"`
+	PIN       int    `sensitive:"true"`
+	Empty     string `sensitive:"true"`
+	Endpoints []endpoint
+	Primary   *endpoint
+	ByName    map[string]endpoint
+	Public    string `sensitive:"false"`
+}
+
+func Test_Struct(t *testing.T) {
+	cfg := config{
+		Username:  "admin",
+		Password:  "hunter2",
+		PIN:       1234,
+		Endpoints: []endpoint{{Addr: "a:443", AuthToken: "token-a"}},
+		Primary:   &endpoint{Addr: "b:443", AuthToken: "token-b"},
+		ByName:    map[string]endpoint{"c": {Addr: "c:443", AuthToken: "token-c"}},
+		Public:    "visible",
+	}
+
+	redacted := Struct(cfg)
+	require.Equal(t, config{
+		Username:  "admin",
+		Password:  Placeholder,
+		Endpoints: []endpoint{{Addr: "a:443", AuthToken: Placeholder}},
+		Primary:   &endpoint{Addr: "b:443", AuthToken: Placeholder},
+		ByName:    map[string]endpoint{"c": {Addr: "c:443", AuthToken: Placeholder}},
+		Public:    "visible",
+	}, redacted)
+	require.Equal(t, Placeholder, Struct(&cfg).Primary.AuthToken)
+
+	// The config itself is unchanged.
+	require.Equal(t, "hunter2", cfg.Password)
+	require.Equal(t, "token-a", cfg.Endpoints[0].AuthToken)
+	require.Equal(t, "token-b", cfg.Primary.AuthToken)
+	require.Equal(t, "token-c", cfg.ByName["c"].AuthToken)
+}
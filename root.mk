@@ -108,6 +108,7 @@ proto: # build-deps lint generate
 		-I third_party \
 		--go_out=$(GEN_DIR) --go_opt=paths=source_relative \
 		--go-grpc_out=$(GEN_DIR) --go-grpc_opt=paths=source_relative \
+		--validate_out=$(GEN_DIR) --validate_opt=paths=source_relative,lang=go \
 		$(PROTO_FILES)
 
 # .PHONY: help
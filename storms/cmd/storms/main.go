@@ -6,6 +6,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
+	"gitlab.com/crusoeenergy/island/storage/storms/pkg/redact"
 	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/app"
 	appconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/app/configs"
 )
@@ -40,7 +41,7 @@ func serveCmdFunc(cmd *cobra.Command, args []string) error {
 	}
 
 	appConfig := appconfigs.Get()
-	log.Info().Msgf("StorMS configuration: %#v\n", appConfig)
+	log.Info().Msgf("StorMS configuration: %#v\n", redact.Struct(appConfig))
 
 	a, err := app.NewApp(&appConfig)
 	if err != nil {
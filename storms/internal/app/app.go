@@ -30,7 +30,11 @@ func (a *App) Start(ctx context.Context) error {
 	ctx = a.setupSignalHandlers(ctx)
 
 	// Start service
-	a.svc = service.NewService(fmt.Sprintf("%s:%d", a.cfg.LocalIP, a.cfg.GrpcPort))
+	svc, err := service.NewService(fmt.Sprintf("%s:%d", a.cfg.LocalIP, a.cfg.GrpcPort))
+	if err != nil {
+		return fmt.Errorf("failed to create app service: %w", err)
+	}
+	a.svc = svc
 	if err := a.svc.Start(); err != nil {
 		return fmt.Errorf("failed to start app service: %w", err)
 	}
@@ -57,6 +61,10 @@ func (a *App) Start(ctx context.Context) error {
 
 	<-ctx.Done() // Blocking call so application continues to serve.
 
+	if err := a.svc.Stop(); err != nil {
+		return fmt.Errorf("failed to stop app service: %w", err)
+	}
+
 	return nil
 }
 
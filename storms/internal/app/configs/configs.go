@@ -9,16 +9,85 @@ import (
 )
 
 const (
-	configFileFlag           = "config"
-	configFileDefault        = "dev/storms.yaml"
-	localIPFlag              = "local_ip"
-	localIPDefault           = "127.0.0.1"
-	grpcPortFlag             = "grpc_port"
-	grpcPortDefault          = 55554
-	clusterFileFlag          = "cluster_file"
-	clusterFileDefault       = "dev/clusters.yaml"
-	syncIntervalHrsFlag      = "sync_interval_hrs"
-	syncIntervalHoursDefault = 24
+	configFileFlag              = "config"
+	configFileDefault           = "dev/storms.yaml"
+	localIPFlag                 = "local_ip"
+	localIPDefault              = "127.0.0.1"
+	grpcPortFlag                = "grpc_port"
+	grpcPortDefault             = 55554
+	clusterFileFlag             = "cluster_file"
+	clusterFileDefault          = "dev/clusters.yaml"
+	syncIntervalHrsFlag         = "sync_interval_hrs"
+	syncIntervalHoursDefault    = 24
+	resourceStoreFlag           = "resource_store"
+	resourceStoreDefault        = ResourceStoreMemory
+	resourceStoreFileFlag       = "resource_store_file"
+	resourceStoreFileDefault    = "dev/resources.log"
+	listTimeoutSecsFlag         = "list_timeout_secs"
+	listTimeoutSecsDefault      = 30
+	allocationStrategyFlag      = "allocation_strategy"
+	allocationStrategyDefault   = AllocationStrategyRandom
+	healthIntervalSecsFlag      = "health_check_interval_secs"
+	healthIntervalSecsDefault   = 30
+	healthTimeoutSecsFlag       = "health_check_timeout_secs"
+	healthTimeoutSecsDefault    = 10
+	healthFailuresFlag          = "health_check_failure_threshold"
+	healthFailuresDefault       = 3
+	opRetentionSecsFlag         = "operation_retention_secs"
+	opRetentionSecsDefault      = 3600
+	quotaFileFlag               = "quota_file"
+	quotaFileDefault            = ""
+	tlsCertFileFlag             = "tls_cert_file"
+	tlsCertFileDefault          = ""
+	tlsKeyFileFlag              = "tls_key_file"
+	tlsKeyFileDefault           = ""
+	tlsClientCAFileFlag         = "tls_client_ca_file"
+	tlsClientCAFileDefault      = ""
+	tlsRequireClientCertFlag    = "tls_require_client_cert"
+	tlsRequireClientCertDefault = false
+	authEnabledFlag             = "auth_enabled"
+	authEnabledDefault          = false
+	authIdentitiesFlag          = "auth_identities"
+	auditFileFlag               = "audit_file"
+	auditFileDefault            = ""
+	auditMaxSizeMBFlag          = "audit_max_size_mb"
+	auditMaxSizeMBDefault       = 100
+	auditMaxFilesFlag           = "audit_max_files"
+	auditMaxFilesDefault        = 10
+	metricsPortFlag             = "metrics_port"
+	metricsPortDefault          = 0
+	tracingEndpointFlag         = "tracing_endpoint"
+	tracingEndpointDefault      = ""
+	tracingInsecureFlag         = "tracing_insecure"
+	tracingInsecureDefault      = false
+	tracingSampleRatioFlag      = "tracing_sample_ratio"
+	tracingSampleRatioDefault   = 1.0
+	clusterRetryMinSecsFlag     = "cluster_retry_min_secs"
+	clusterRetryMinSecsDefault  = 5
+	clusterRetryMaxSecsFlag     = "cluster_retry_max_secs"
+	clusterRetryMaxSecsDefault  = 300
+)
+
+// Supported values for AppConfig.ResourceStore.
+const (
+	// Resource mappings are kept in memory and rebuilt from every cluster on start.
+	ResourceStoreMemory = "memory"
+	// Resource mappings are persisted to ResourceStoreFile and reconciled in the background on start.
+	ResourceStoreFile = "file"
+)
+
+// Supported values for AppConfig.AllocationStrategy.
+const (
+	// Picks uniformly at random among qualified clusters.
+	AllocationStrategyRandom = "random"
+	// Cycles through qualified clusters.
+	AllocationStrategyRoundRobin = "round_robin"
+	// Picks at random in proportion to the weight set on each cluster in the cluster file.
+	AllocationStrategyWeighted = "weighted"
+	// Picks by hashing the volume UUID, so a volume always maps to the same cluster.
+	AllocationStrategyConsistentHash = "consistent_hash"
+	// Picks the qualified cluster holding the fewest resources.
+	AllocationStrategyFewestResources = "fewest_resources"
 )
 
 var appConfig *AppConfig //nolint:gochecknoglobals // using a global to avoid passing large config struct around
@@ -30,12 +99,70 @@ type AppConfig struct {
 	LocalIP string `mapstructure:"local_ip"`
 	// port for listening gRPC request
 	GrpcPort int `mapstructure:"grpc_port"`
-	// // information needed for HTTP and gRPC authentication
-	// AuthInfo *rpc.AuthInfo `mapstructure:"auth_info"`
 	// cluster file
 	ClusterFile string `mapstructure:"cluster_file"`
 	// sync interval in hours for SyncAllResources
 	SyncIntervalHrs int `mapstructure:"sync_interval_hrs"`
+	// backing store for resource-to-cluster mappings, one of "memory" or "file"
+	ResourceStore string `mapstructure:"resource_store"`
+	// filepath of the resource store, used when ResourceStore is "file"
+	ResourceStoreFile string `mapstructure:"resource_store_file"`
+	// timeout in seconds for each cluster to answer a list request
+	ListTimeoutSecs int `mapstructure:"list_timeout_secs"`
+	// strategy for placing new volumes among qualified clusters, one of the AllocationStrategy values
+	AllocationStrategy string `mapstructure:"allocation_strategy"`
+	// interval in seconds between health probes of each cluster
+	HealthCheckIntervalSecs int `mapstructure:"health_check_interval_secs"`
+	// timeout in seconds for each health probe
+	HealthCheckTimeoutSecs int `mapstructure:"health_check_timeout_secs"`
+	// consecutive failed health probes after which a cluster is considered unreachable
+	HealthCheckFailureThreshold int `mapstructure:"health_check_failure_threshold"`
+	// time in seconds that completed operations are remembered for
+	OperationRetentionSecs int `mapstructure:"operation_retention_secs"`
+	// filepath of the tenant quota file; tenants are not limited if unset
+	QuotaFile string `mapstructure:"quota_file"`
+	// filepath of the PEM certificate served over gRPC; the server is plaintext if unset
+	TLSCertFile string `mapstructure:"tls_cert_file"`
+	// filepath of the PEM private key of TLSCertFile
+	TLSKeyFile string `mapstructure:"tls_key_file"`
+	// filepath of the PEM CA bundle that client certificates are verified against
+	TLSClientCAFile string `mapstructure:"tls_client_ca_file"`
+	// whether clients must present a certificate signed by TLSClientCAFile (mTLS)
+	TLSRequireClientCert bool `mapstructure:"tls_require_client_cert"`
+	// whether callers must authenticate as one of AuthIdentities and are limited to its role
+	AuthEnabled bool `mapstructure:"auth_enabled"`
+	// callers allowed when AuthEnabled is set
+	AuthIdentities []AuthIdentity `mapstructure:"auth_identities"`
+	// filepath of the audit log of mutating requests; requests are not audited if unset
+	AuditFile string `mapstructure:"audit_file"`
+	// size in MiB at which the audit log is rotated
+	AuditMaxSizeMB int `mapstructure:"audit_max_size_mb"`
+	// number of rotated audit logs kept
+	AuditMaxFiles int `mapstructure:"audit_max_files"`
+	// port of the HTTP listener serving Prometheus metrics on LocalIP; metrics are not served if 0
+	MetricsPort int `mapstructure:"metrics_port"`
+	// host:port of the OTLP gRPC collector that traces are exported to; requests are not traced if unset
+	TracingEndpoint string `mapstructure:"tracing_endpoint"`
+	// whether traces are exported to TracingEndpoint without TLS
+	TracingInsecure bool `mapstructure:"tracing_insecure"`
+	// fraction of traces started by StorMS that are sampled, between 0 and 1
+	TracingSampleRatio float64 `mapstructure:"tracing_sample_ratio"`
+	// seconds before the first retry of a cluster whose client could not be created; doubled after every failure
+	ClusterRetryMinSecs int `mapstructure:"cluster_retry_min_secs"`
+	// longest time in seconds between retries of a cluster whose client could not be created
+	ClusterRetryMaxSecs int `mapstructure:"cluster_retry_max_secs"`
+}
+
+// AuthIdentity maps a caller, known by its client certificate or bearer token, to a role.
+type AuthIdentity struct {
+	// name of the caller, used in logs
+	Name string `mapstructure:"name"`
+	// common name or DNS name of the caller's client certificate
+	Subject string `mapstructure:"subject"`
+	// hex-encoded SHA-256 digest of the caller's bearer token
+	TokenSHA256 string `mapstructure:"token_sha256" sensitive:"true"`
+	// one of "read_only", "operator" or "admin"
+	Role string `mapstructure:"role"`
 }
 
 func Parse(cmd *cobra.Command) error {
@@ -81,6 +208,53 @@ func setViperDefaults() {
 	viper.SetDefault(clusterFileFlag, clusterFileDefault)
 	mustBindEnv(syncIntervalHrsFlag)
 	viper.SetDefault(syncIntervalHrsFlag, syncIntervalHoursDefault)
+	mustBindEnv(resourceStoreFlag)
+	viper.SetDefault(resourceStoreFlag, resourceStoreDefault)
+	mustBindEnv(resourceStoreFileFlag)
+	viper.SetDefault(resourceStoreFileFlag, resourceStoreFileDefault)
+	mustBindEnv(listTimeoutSecsFlag)
+	viper.SetDefault(listTimeoutSecsFlag, listTimeoutSecsDefault)
+	mustBindEnv(allocationStrategyFlag)
+	viper.SetDefault(allocationStrategyFlag, allocationStrategyDefault)
+	mustBindEnv(healthIntervalSecsFlag)
+	viper.SetDefault(healthIntervalSecsFlag, healthIntervalSecsDefault)
+	mustBindEnv(healthTimeoutSecsFlag)
+	viper.SetDefault(healthTimeoutSecsFlag, healthTimeoutSecsDefault)
+	mustBindEnv(healthFailuresFlag)
+	viper.SetDefault(healthFailuresFlag, healthFailuresDefault)
+	mustBindEnv(opRetentionSecsFlag)
+	viper.SetDefault(opRetentionSecsFlag, opRetentionSecsDefault)
+	mustBindEnv(quotaFileFlag)
+	viper.SetDefault(quotaFileFlag, quotaFileDefault)
+	mustBindEnv(tlsCertFileFlag)
+	viper.SetDefault(tlsCertFileFlag, tlsCertFileDefault)
+	mustBindEnv(tlsKeyFileFlag)
+	viper.SetDefault(tlsKeyFileFlag, tlsKeyFileDefault)
+	mustBindEnv(tlsClientCAFileFlag)
+	viper.SetDefault(tlsClientCAFileFlag, tlsClientCAFileDefault)
+	mustBindEnv(tlsRequireClientCertFlag)
+	viper.SetDefault(tlsRequireClientCertFlag, tlsRequireClientCertDefault)
+	mustBindEnv(authEnabledFlag)
+	viper.SetDefault(authEnabledFlag, authEnabledDefault)
+	viper.SetDefault(authIdentitiesFlag, []AuthIdentity{})
+	mustBindEnv(auditFileFlag)
+	viper.SetDefault(auditFileFlag, auditFileDefault)
+	mustBindEnv(auditMaxSizeMBFlag)
+	viper.SetDefault(auditMaxSizeMBFlag, auditMaxSizeMBDefault)
+	mustBindEnv(auditMaxFilesFlag)
+	viper.SetDefault(auditMaxFilesFlag, auditMaxFilesDefault)
+	mustBindEnv(metricsPortFlag)
+	viper.SetDefault(metricsPortFlag, metricsPortDefault)
+	mustBindEnv(tracingEndpointFlag)
+	viper.SetDefault(tracingEndpointFlag, tracingEndpointDefault)
+	mustBindEnv(tracingInsecureFlag)
+	viper.SetDefault(tracingInsecureFlag, tracingInsecureDefault)
+	mustBindEnv(tracingSampleRatioFlag)
+	viper.SetDefault(tracingSampleRatioFlag, tracingSampleRatioDefault)
+	mustBindEnv(clusterRetryMinSecsFlag)
+	viper.SetDefault(clusterRetryMinSecsFlag, clusterRetryMinSecsDefault)
+	mustBindEnv(clusterRetryMaxSecsFlag)
+	viper.SetDefault(clusterRetryMaxSecsFlag, clusterRetryMaxSecsDefault)
 
 	// Bind more env vars here.
 }
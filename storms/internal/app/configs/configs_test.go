@@ -18,6 +18,30 @@ func Test_Default(t *testing.T) {
 			require.Equal(t, grpcPortDefault, Get().GrpcPort)
 			require.Equal(t, localIPDefault, Get().LocalIP)
 			require.Equal(t, clusterFileDefault, Get().ClusterFile)
+			require.Equal(t, ResourceStoreMemory, Get().ResourceStore)
+			require.Equal(t, resourceStoreFileDefault, Get().ResourceStoreFile)
+			require.Equal(t, listTimeoutSecsDefault, Get().ListTimeoutSecs)
+			require.Equal(t, AllocationStrategyRandom, Get().AllocationStrategy)
+			require.Equal(t, healthIntervalSecsDefault, Get().HealthCheckIntervalSecs)
+			require.Equal(t, healthTimeoutSecsDefault, Get().HealthCheckTimeoutSecs)
+			require.Equal(t, healthFailuresDefault, Get().HealthCheckFailureThreshold)
+			require.Equal(t, opRetentionSecsDefault, Get().OperationRetentionSecs)
+			require.Equal(t, quotaFileDefault, Get().QuotaFile)
+			require.Equal(t, tlsCertFileDefault, Get().TLSCertFile)
+			require.Equal(t, tlsKeyFileDefault, Get().TLSKeyFile)
+			require.Equal(t, tlsClientCAFileDefault, Get().TLSClientCAFile)
+			require.False(t, Get().TLSRequireClientCert)
+			require.False(t, Get().AuthEnabled)
+			require.Empty(t, Get().AuthIdentities)
+			require.Equal(t, auditFileDefault, Get().AuditFile)
+			require.Equal(t, auditMaxSizeMBDefault, Get().AuditMaxSizeMB)
+			require.Equal(t, auditMaxFilesDefault, Get().AuditMaxFiles)
+			require.Equal(t, metricsPortDefault, Get().MetricsPort)
+			require.Equal(t, tracingEndpointDefault, Get().TracingEndpoint)
+			require.False(t, Get().TracingInsecure)
+			require.InDelta(t, tracingSampleRatioDefault, Get().TracingSampleRatio, 0)
+			require.Equal(t, clusterRetryMinSecsDefault, Get().ClusterRetryMinSecs)
+			require.Equal(t, clusterRetryMaxSecsDefault, Get().ClusterRetryMaxSecs)
 
 			return nil
 		},
@@ -57,6 +81,28 @@ func Test_Parse(t *testing.T) {
 			require.Equal(t, 8888, Get().GrpcPort)
 			require.Equal(t, "127.127.127.127", Get().LocalIP)
 			require.Equal(t, "/some_dir/clusters.yaml", Get().ClusterFile)
+			require.Equal(t, ResourceStoreFile, Get().ResourceStore)
+			require.Equal(t, "/some_dir/resources.log", Get().ResourceStoreFile)
+			require.Equal(t, AllocationStrategyWeighted, Get().AllocationStrategy)
+			require.Equal(t, "/some_dir/quotas.yaml", Get().QuotaFile)
+			require.Equal(t, "/some_dir/server.crt", Get().TLSCertFile)
+			require.Equal(t, "/some_dir/server.key", Get().TLSKeyFile)
+			require.Equal(t, "/some_dir/ca.crt", Get().TLSClientCAFile)
+			require.True(t, Get().TLSRequireClientCert)
+			require.True(t, Get().AuthEnabled)
+			require.Equal(t, []AuthIdentity{
+				{Name: "sre", Subject: "sre.storms.internal", Role: "read_only"},
+				{Name: "ci", TokenSHA256: "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", Role: "operator"},
+			}, Get().AuthIdentities)
+			require.Equal(t, "/some_dir/audit.log", Get().AuditFile)
+			require.Equal(t, 10, Get().AuditMaxSizeMB)
+			require.Equal(t, 5, Get().AuditMaxFiles)
+			require.Equal(t, 9291, Get().MetricsPort)
+			require.Equal(t, "otel-collector:4317", Get().TracingEndpoint)
+			require.True(t, Get().TracingInsecure)
+			require.InDelta(t, 0.25, Get().TracingSampleRatio, 0)
+			require.Equal(t, 10, Get().ClusterRetryMinSecs)
+			require.Equal(t, 600, Get().ClusterRetryMaxSecs)
 
 			return nil
 		},
@@ -3,11 +3,16 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/rs/zerolog/log"
-	"github.com/samber/lo"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	alloc "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health"
 	resource "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Service) ReloadConfig(_ context.Context, _ *admin.ReloadConfigRequest,
@@ -16,6 +21,9 @@ func (s *Service) ReloadConfig(_ context.Context, _ *admin.ReloadConfigRequest,
 	if err != nil {
 		return nil, fmt.Errorf("failed to re-load cluster configuration: %w", err)
 	}
+	if err := s.loadQuotaConfig(); err != nil {
+		return nil, fmt.Errorf("failed to re-load quota configuration: %w", err)
+	}
 
 	s.syncClusterManager()
 	s.syncResourceManager()
@@ -25,22 +33,26 @@ func (s *Service) ReloadConfig(_ context.Context, _ *admin.ReloadConfigRequest,
 	return &admin.ReloadConfigResponse{}, nil
 }
 
-func (s *Service) ShowClusters(context.Context, *admin.ShowClustersRequest) (*admin.ShowClustersResponse, error) {
+func (s *Service) ShowClusters(ctx context.Context, _ *admin.ShowClustersRequest,
+) (*admin.ShowClustersResponse, error) {
 	clusters := []*admin.Cluster{}
 	clusterIDs := s.clusterManager.AllIDs()
-	for _, clusterID := range clusterIDs {
-		resources := s.resourceManager.GetResourcesOfCluster(clusterID)
+	capacities := s.getClusterCapacities(ctx, clusterIDs)
+	for i, clusterID := range clusterIDs {
+		counts := s.resourceManager.CountResourcesOfCluster(clusterID)
 		vendor := s.getClusterVendor(clusterID)
 		cluster := &admin.Cluster{
 			Id:     clusterID,
 			Vendor: vendor,
 			ResourceCount: map[string]int32{
-				"volume": int32(lo.CountBy(resources, func(r *resource.Resource) bool {
-					return r.ResourceType == resource.TypeVolume
-				})),
-				"snapshot": int32(lo.CountBy(resources, func(r *resource.Resource) bool {
-					return r.ResourceType == resource.TypeSnapshot
-				}))},
+				"volume":   int32(counts[resource.TypeVolume]),
+				"snapshot": int32(counts[resource.TypeSnapshot]),
+			},
+			Capacity: capacities[i],
+			Health:   translateClusterHealth(s.clusterHealth(clusterID)),
+		}
+		if c, err := s.clusterManager.Get(clusterID); err == nil {
+			cluster.Lifecycle = translateClusterLifecycle(c)
 		}
 		clusters = append(clusters, cluster)
 	}
@@ -50,6 +62,101 @@ func (s *Service) ShowClusters(context.Context, *admin.ShowClustersRequest) (*ad
 	}, nil
 }
 
+// Returns the capacity of each cluster, asking them concurrently and giving each the capacity timeout to answer.
+func (s *Service) getClusterCapacities(ctx context.Context, clusterIDs []string) []*admin.Capacity {
+	timeout := s.capacityTimeout
+	if timeout == 0 {
+		timeout = alloc.DefaultCapacityTimeout
+	}
+
+	capacities := make([]*admin.Capacity, len(clusterIDs))
+	wg := sync.WaitGroup{}
+	for i, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func(i int, clusterID string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			capacities[i] = s.getClusterCapacity(ctx, clusterID)
+		}(i, clusterID)
+	}
+	wg.Wait()
+
+	return capacities
+}
+
+// Returns nil if the capacity of the cluster cannot be determined.
+func (s *Service) getClusterCapacity(ctx context.Context, clusterID string) *admin.Capacity {
+	c, err := s.getRoutableCluster(clusterID)
+	if err != nil {
+		return nil
+	}
+
+	resp, err := c.Client.GetCapacity(ctx, &models.GetCapacityRequest{})
+	if err != nil {
+		log.Warn().Err(err).Str("cluster_id", clusterID).Msg("failed to get cluster capacity")
+
+		return nil
+	}
+	if resp.Capacity == nil {
+		return nil
+	}
+
+	return &admin.Capacity{
+		TotalBytes:       resp.Capacity.TotalBytes,
+		UsedBytes:        resp.Capacity.UsedBytes,
+		ProvisionedBytes: resp.Capacity.ProvisionedBytes,
+		FreeBytes:        resp.Capacity.FreeBytes(),
+	}
+}
+
+func translateClusterHealth(st health.Status) *admin.ClusterHealth {
+	out := &admin.ClusterHealth{
+		LastError:           st.LastError,
+		ConsecutiveFailures: uint32(st.ConsecutiveFailures), //nolint:gosec // failure count is non-negative
+	}
+	switch st.State {
+	case health.StateHealthy:
+		out.State = admin.HealthState_HEALTH_STATE_HEALTHY
+	case health.StateDegraded:
+		out.State = admin.HealthState_HEALTH_STATE_DEGRADED
+	case health.StateUnreachable:
+		out.State = admin.HealthState_HEALTH_STATE_UNREACHABLE
+	case health.StateUnknown:
+		out.State = admin.HealthState_HEALTH_STATE_UNSPECIFIED
+	}
+	if !st.LastCheck.IsZero() {
+		out.LastCheck = timestamppb.New(st.LastCheck)
+	}
+	if !st.LastSuccess.IsZero() {
+		out.LastSuccess = timestamppb.New(st.LastSuccess)
+	}
+
+	return out
+}
+
+func translateClusterLifecycle(c *cluster.Cluster) *admin.ClusterLifecycle {
+	out := &admin.ClusterLifecycle{
+		LastError: c.LastError,
+		Attempts:  uint32(c.Attempts), //nolint:gosec // attempt count is non-negative
+	}
+	switch c.CurrentState() {
+	case cluster.StateInitializing:
+		out.State = admin.ClusterState_CLUSTER_STATE_INITIALIZING
+	case cluster.StateReady:
+		out.State = admin.ClusterState_CLUSTER_STATE_READY
+	case cluster.StateFailed:
+		out.State = admin.ClusterState_CLUSTER_STATE_FAILED
+	case cluster.StateRemoving:
+		out.State = admin.ClusterState_CLUSTER_STATE_REMOVING
+	}
+	if !c.NextRetry.IsZero() {
+		out.NextRetry = timestamppb.New(c.NextRetry)
+	}
+
+	return out
+}
+
 func (s *Service) getClusterVendor(clusterID string) string {
 	for _, cluster := range s.clusterConfigs.Clusters {
 		if cluster.ClusterID == clusterID {
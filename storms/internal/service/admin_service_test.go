@@ -1,6 +1,25 @@
 package service
 
-import "testing"
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	clientmocks "gitlab.com/crusoeenergy/island/storage/storms/client/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	serviceconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/configs"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+)
 
 func Test_ReloadConfig(t *testing.T) {
 
@@ -9,3 +28,150 @@ func Test_ReloadConfig(t *testing.T) {
 func Test_ShowClusters(t *testing.T) {
 
 }
+
+func Test_ShowClusters_SlowCapacity(t *testing.T) {
+	// Every cluster answers only once all of them were asked, or never, so a sequential query could not finish.
+	var asked sync.WaitGroup
+	asked.Add(2)
+	answering := &clientmocks.MockClient{
+		MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			asked.Done()
+			asked.Wait()
+
+			return &models.GetCapacityResponse{Capacity: &models.Capacity{TotalBytes: 100, UsedBytes: 40}}, nil
+		},
+	}
+	hanging := &clientmocks.MockClient{
+		MockGetCapacity: func(ctx context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			asked.Done()
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		},
+	}
+	clusters := map[string]*cluster.Cluster{
+		clusterID1: {Config: mockCluster1.Config, Client: answering},
+		clusterID2: {Config: mockCluster2.Config, Client: hanging},
+	}
+	s := &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockAllIDs: func() []string { return []string{clusterID1, clusterID2} },
+			MockGet:    func(clusterID string) (*cluster.Cluster, error) { return clusters[clusterID], nil },
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockCountResourcesOfCluster: func(string) map[resource.Type]int { return nil },
+		},
+		clusterConfigs:  &serviceconfigs.ClustersConfig{},
+		capacityTimeout: 10 * time.Millisecond,
+	}
+
+	resp, err := s.ShowClusters(context.Background(), &admin.ShowClustersRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetClusters(), 2)
+	require.Equal(t, uint64(60), resp.GetClusters()[0].GetCapacity().GetFreeBytes())
+	// The cluster that timed out is shown without its capacity.
+	require.Nil(t, resp.GetClusters()[1].GetCapacity())
+}
+
+func Test_ShowClusters_Removing(t *testing.T) {
+	mockClient := &clientmocks.MockClient{
+		MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			return &models.GetCapacityResponse{Capacity: &models.Capacity{}}, nil
+		},
+	}
+	resizing, resized := make(chan struct{}), make(chan struct{})
+	resourceManager := resource.NewInMemoryManager()
+	require.NoError(t, resourceManager.Map(&resource.Resource{
+		ID:           resourceID1,
+		ClusterID:    clusterID1,
+		ResourceType: resource.TypeVolume,
+	}))
+	clusterManager := cluster.NewInMemoryManager()
+	s := &Service{
+		clusterManager:  clusterManager,
+		resourceManager: resourceManager,
+		clusterConfigs:  &serviceconfigs.ClustersConfig{},
+		clientTranslator: &translatormocks.MockClientTranslator{
+			MockResizeVolume: func(_ context.Context, _ client.Client, _ *storms.ResizeVolumeRequest,
+			) (*storms.ResizeVolumeResponse, error) {
+				close(resizing)
+				<-resized
+
+				return &storms.ResizeVolumeResponse{}, nil
+			},
+		},
+	}
+	lifecycle := cluster.NewLifecycle(clusterManager, cluster.LifecycleConfig{
+		NewCluster: func(cfg *cluster.Config) (*cluster.Cluster, error) {
+			return &cluster.Cluster{Config: cfg, Client: mockClient, State: cluster.StateReady}, nil
+		},
+		Drain: s.drainCluster,
+	})
+	defer lifecycle.Stop()
+	lifecycle.Add(mockCluster1.Config)
+
+	resizeErr := make(chan error)
+	go func() {
+		_, err := s.ResizeVolume(context.Background(), &storms.ResizeVolumeRequest{Uuid: resourceID1})
+		resizeErr <- err
+	}()
+	<-resizing
+	require.NoError(t, lifecycle.Remove(clusterID1))
+
+	// The cluster is shown as removing, and no longer routed to, until the resize running on it finishes.
+	resp, err := s.ShowClusters(context.Background(), &admin.ShowClustersRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetClusters(), 1)
+	require.Equal(t, admin.ClusterState_CLUSTER_STATE_REMOVING, resp.GetClusters()[0].GetLifecycle().GetState())
+	require.Equal(t, map[string]int32{"volume": 1, "snapshot": 0}, resp.GetClusters()[0].GetResourceCount())
+	_, err = s.getRoutableCluster(clusterID1)
+	require.Error(t, err)
+
+	close(resized)
+	require.NoError(t, <-resizeErr)
+	require.Eventually(t, func() bool {
+		resp, err := s.ShowClusters(context.Background(), &admin.ShowClustersRequest{})
+
+		return err == nil && len(resp.GetClusters()) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func Test_translateClusterLifecycle(t *testing.T) {
+	nextRetry := time.Now().Add(time.Minute)
+	tests := []struct {
+		name   string
+		input  *cluster.Cluster
+		expect *admin.ClusterLifecycle
+	}{
+		{
+			name:   "ready without state",
+			input:  mockCluster1,
+			expect: &admin.ClusterLifecycle{State: admin.ClusterState_CLUSTER_STATE_READY},
+		},
+		{
+			name: "failed",
+			input: &cluster.Cluster{
+				Config:    mockCluster1.Config,
+				State:     cluster.StateFailed,
+				LastError: "no such host",
+				Attempts:  3,
+				NextRetry: nextRetry,
+			},
+			expect: &admin.ClusterLifecycle{
+				State:     admin.ClusterState_CLUSTER_STATE_FAILED,
+				LastError: "no such host",
+				Attempts:  3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := translateClusterLifecycle(tt.input)
+			require.Equal(t, tt.expect.GetState(), actual.GetState())
+			require.Equal(t, tt.expect.GetLastError(), actual.GetLastError())
+			require.Equal(t, tt.expect.GetAttempts(), actual.GetAttempts())
+			require.Equal(t, tt.input.NextRetry.IsZero(), actual.GetNextRetry() == nil)
+		})
+	}
+}
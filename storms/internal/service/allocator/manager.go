@@ -1,12 +1,41 @@
 package allocator
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health"
+)
+
+const (
+	tracerName = "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
+
+	// Time each cluster is given to report its capacity during an allocation.
+	DefaultCapacityTimeout = 5 * time.Second
+)
+
+var (
+	errNoQualifiedClusters  = errors.New("no qualified clusters")
+	errInsufficientCapacity = status.Error(codes.ResourceExhausted,
+		"no qualified cluster has enough free capacity")
+	errNoHealthyClusters = status.Error(codes.Unavailable, "no qualified cluster is healthy")
 )
 
 type clusterManager interface {
@@ -14,18 +43,62 @@ type clusterManager interface {
 	Get(string) (*cluster.Cluster, error)
 }
 
+type healthChecker interface {
+	Status(clusterID string) health.Status
+}
+
 type Manager struct {
 	clusterManager clusterManager
+	health         healthChecker
+	strategy       Strategy
+	// Time each cluster is given to report its capacity.
+	capacityTimeout time.Duration
 }
 
-func NewManager(cm clusterManager) *Manager {
+// NewManager creates an allocator that places volumes with the given strategy, or at random if strategy is nil.
+// Clusters that hc does not report as allocatable are skipped; a nil hc treats every cluster as healthy.
+func NewManager(cm clusterManager, hc healthChecker, strategy Strategy) *Manager {
+	if strategy == nil {
+		strategy = NewRandomStrategy()
+	}
+
 	return &Manager{
-		clusterManager: cm,
+		clusterManager:  cm,
+		health:          hc,
+		strategy:        strategy,
+		capacityTimeout: DefaultCapacityTimeout,
 	}
 }
 
-func (a *Manager) AllocateCluster(affinityTags map[string]string) (string, error) {
-	qualifiedClusters := []*cluster.Cluster{}
+// Request describes the volume a cluster is being allocated for.
+type Request struct {
+	VolumeUUID   string
+	AffinityTags map[string]string
+	// Size of the volume in bytes. Clusters without this much free capacity are not considered.
+	SizeBytes uint64
+}
+
+func (a *Manager) AllocateCluster(ctx context.Context, req *Request) (string, error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "allocator.AllocateCluster",
+		trace.WithAttributes(attribute.String("volume_uuid", req.VolumeUUID)))
+	defer span.End()
+
+	clusterID, err := a.allocateCluster(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+
+		return "", err
+	}
+	span.SetAttributes(attribute.String("assigned_cluster_id", clusterID))
+
+	return clusterID, nil
+}
+
+func (a *Manager) allocateCluster(ctx context.Context, req *Request) (string, error) {
+	affinityTags := req.AffinityTags
+	tagMatched := 0
+	healthyClusters := []*cluster.Cluster{}
 	clusterIDs := a.clusterManager.AllIDs()
 	for _, clusterID := range clusterIDs {
 		c, err := a.clusterManager.Get(clusterID)
@@ -35,32 +108,63 @@ func (a *Manager) AllocateCluster(affinityTags map[string]string) (string, error
 			continue
 		}
 
-		qualified := tagMatch(affinityTags, c.Config.AffinityTags)
-		if qualified {
-			qualifiedClusters = append(qualifiedClusters, c)
+		if !tagMatch(affinityTags, c.Config.AffinityTags) {
+			continue
 		}
-	}
+		tagMatched++
 
-	if len(qualifiedClusters) == 0 {
-		return "", fmt.Errorf("no qualified clusters")
-	}
+		if !c.Ready() {
+			log.Info().
+				Str("cluster_id", clusterID).
+				Str("state", string(c.CurrentState())).
+				Msg("skipping cluster that is not ready for allocation")
+
+			continue
+		}
+
+		if a.health != nil {
+			if st := a.health.Status(clusterID); !st.Allocatable() {
+				log.Info().
+					Str("cluster_id", clusterID).
+					Str("health", string(st.State)).
+					Msg("skipping unhealthy cluster for allocation")
 
-	if len(qualifiedClusters) == 1 {
-		clusterID := qualifiedClusters[0].Config.ClusterID
-		log.Info().Str("assigned_cluster_id", clusterID).Msg("identified 1 qualified cluster")
+				continue
+			}
+		}
+		healthyClusters = append(healthyClusters, c)
+	}
 
-		return clusterID, nil
+	qualifiedClusters := a.withCapacity(ctx, healthyClusters, req.SizeBytes)
+	if len(qualifiedClusters) == 0 {
+		switch {
+		case tagMatched == 0:
+			return "", errNoQualifiedClusters
+		case len(healthyClusters) == 0:
+			return "", errNoHealthyClusters
+		default:
+			return "", errInsufficientCapacity
+		}
 	}
 
+	slices.SortFunc(qualifiedClusters, func(a, b *cluster.Cluster) int {
+		return strings.Compare(a.Config.ClusterID, b.Config.ClusterID)
+	})
 	qualifiedClusterIDs := lo.Map[*cluster.Cluster, string](qualifiedClusters,
 		func(c *cluster.Cluster, index int) string {
 			return c.Config.ClusterID
 		})
 
-	// If there are more than one match, randomly assign!
-	randomQualifiedClusterID := randomElement(qualifiedClusterIDs)
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.StringSlice("qualified_cluster_ids", qualifiedClusterIDs),
+		attribute.String("strategy", fmt.Sprintf("%T", a.strategy)),
+	)
+	picked, err := a.strategy.Pick(req, qualifiedClusters)
+	if err != nil {
+		return "", fmt.Errorf("failed to pick cluster: %w", err)
+	}
 
-	clusterID := randomQualifiedClusterID
+	clusterID := picked.Config.ClusterID
 	log.Info().
 		Str("assigned_cluster_id", clusterID).
 		Strs("qualified_cluster_ids", qualifiedClusterIDs).
@@ -69,6 +173,54 @@ func (a *Manager) AllocateCluster(affinityTags map[string]string) (string, error
 	return clusterID, nil
 }
 
+// Returns the clusters with room for a volume of size, asking them for their capacity concurrently and giving each
+// the capacity timeout to answer.
+func (a *Manager) withCapacity(ctx context.Context, clusters []*cluster.Cluster, size uint64) []*cluster.Cluster {
+	fits := make([]bool, len(clusters))
+	wg := sync.WaitGroup{}
+	for i, c := range clusters {
+		wg.Add(1)
+		go func(i int, c *cluster.Cluster) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, a.capacityTimeout)
+			defer cancel()
+			fits[i] = hasCapacity(ctx, c, size)
+		}(i, c)
+	}
+	wg.Wait()
+
+	return lo.Filter(clusters, func(_ *cluster.Cluster, i int) bool { return fits[i] })
+}
+
+// Returns false if the cluster reports less free capacity than size. A cluster whose capacity cannot be determined
+// is assumed to have room, so that a flaky stats endpoint does not block allocation.
+func hasCapacity(ctx context.Context, c *cluster.Cluster, size uint64) bool {
+	if size == 0 || c.Client == nil {
+		return true
+	}
+
+	resp, err := c.Client.GetCapacity(ctx, &models.GetCapacityRequest{})
+	if err != nil || resp.Capacity == nil {
+		log.Warn().Err(err).Str("cluster_id", c.Config.ClusterID).
+			Msg("could not get cluster capacity, considering it for allocation anyway")
+
+		return true
+	}
+
+	free := resp.Capacity.FreeBytes()
+	if free < size {
+		log.Info().
+			Str("cluster_id", c.Config.ClusterID).
+			Uint64("free_bytes", free).
+			Uint64("requested_bytes", size).
+			Msg("skipping cluster without enough free capacity")
+
+		return false
+	}
+
+	return true
+}
+
 // Returns true if all key-value pairs in 'a' exist in 'b'.
 func tagMatch(a, b map[string]string) bool {
 	for k, v := range a {
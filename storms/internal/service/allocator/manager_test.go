@@ -1,14 +1,26 @@
 package allocator
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	clientmocks "gitlab.com/crusoeenergy/island/storage/storms/client/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
 
 	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health"
 )
 
 var (
@@ -106,7 +118,7 @@ var (
 	}
 
 	setupAllocator = func() *Manager {
-		return NewManager(mockClusterManager)
+		return NewManager(mockClusterManager, nil, nil)
 	}
 )
 
@@ -148,7 +160,7 @@ func Test_AllocateCluster(t *testing.T) {
 	allocationManager := setupAllocator()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual, err := allocationManager.AllocateCluster(tt.input)
+			actual, err := allocationManager.AllocateCluster(context.Background(), &Request{AffinityTags: tt.input})
 			if tt.expectErr {
 				require.NotNil(t, err)
 				require.Equal(t, "", actual)
@@ -160,6 +172,210 @@ func Test_AllocateCluster(t *testing.T) {
 	}
 }
 
+func Test_AllocateCluster_Capacity(t *testing.T) {
+	const gib = 1 << 30
+	withFreeBytes := func(free uint64) *clientmocks.MockClient {
+		return &clientmocks.MockClient{
+			MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+				return &models.GetCapacityResponse{
+					Capacity: &models.Capacity{TotalBytes: 100 * gib, UsedBytes: 100*gib - free},
+				}, nil
+			},
+		}
+	}
+	failing := &clientmocks.MockClient{
+		MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			return nil, fmt.Errorf("stats unavailable")
+		},
+	}
+	clusters := map[string]*cluster.Cluster{
+		clusterID1: {Config: cluster1.Config, Client: withFreeBytes(10 * gib)},
+		clusterID2: {Config: cluster2.Config, Client: withFreeBytes(50 * gib)},
+		clusterID3: {Config: cluster3.Config, Client: failing},
+	}
+	allocationManager := NewManager(&clustermocks.MockClusterManager{
+		MockGet: func(clusterID string) (*cluster.Cluster, error) {
+			return clusters[clusterID], nil
+		},
+		MockAllIDs: func() []string {
+			return []string{clusterID1, clusterID2, clusterID3}
+		},
+	}, nil, nil)
+
+	tests := []struct {
+		name            string
+		input           *Request
+		possibleExpects []string
+		expectCode      codes.Code
+	}{
+		{
+			name:            "fits everywhere",
+			input:           &Request{AffinityTags: map[string]string{"type": "type-a"}, SizeBytes: 10 * gib},
+			possibleExpects: []string{clusterID1, clusterID2, clusterID3},
+		},
+		{
+			name:            "skips full cluster",
+			input:           &Request{AffinityTags: map[string]string{"tier": "tier-1"}, SizeBytes: 20 * gib},
+			possibleExpects: []string{clusterID3},
+		},
+		{
+			name:            "unknown capacity is considered",
+			input:           &Request{AffinityTags: map[string]string{"type": "type-a"}, SizeBytes: 80 * gib},
+			possibleExpects: []string{clusterID3},
+		},
+		{
+			name: "no tag-matching cluster fits",
+			input: &Request{
+				AffinityTags: map[string]string{"region": "us-south-1"},
+				SizeBytes:    80 * gib,
+			},
+			expectCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := allocationManager.AllocateCluster(context.Background(), tt.input)
+			if tt.expectCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.expectCode, status.Code(err))
+				require.Equal(t, "", actual)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, tt.possibleExpects, actual)
+		})
+	}
+}
+
+func Test_AllocateCluster_SlowCapacity(t *testing.T) {
+	// Every cluster answers only once all of them were asked, or never, so a sequential check could not finish.
+	var asked sync.WaitGroup
+	asked.Add(2)
+	answering := &clientmocks.MockClient{
+		MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			asked.Done()
+			asked.Wait()
+
+			return &models.GetCapacityResponse{Capacity: &models.Capacity{}}, nil
+		},
+	}
+	hanging := &clientmocks.MockClient{
+		MockGetCapacity: func(ctx context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			asked.Done()
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		},
+	}
+	clusters := map[string]*cluster.Cluster{
+		clusterID1: {Config: cluster1.Config, Client: answering},
+		clusterID2: {Config: cluster2.Config, Client: hanging},
+	}
+	allocationManager := NewManager(&clustermocks.MockClusterManager{
+		MockGet:    func(clusterID string) (*cluster.Cluster, error) { return clusters[clusterID], nil },
+		MockAllIDs: func() []string { return []string{clusterID1, clusterID2} },
+	}, nil, nil)
+	allocationManager.capacityTimeout = 10 * time.Millisecond
+
+	// The cluster without free capacity is skipped, and the one that timed out is considered anyway.
+	actual, err := allocationManager.AllocateCluster(context.Background(), &Request{SizeBytes: 1})
+	require.NoError(t, err)
+	require.Equal(t, clusterID2, actual)
+}
+
+type mockHealthChecker map[string]health.State
+
+func (m mockHealthChecker) Status(clusterID string) health.Status {
+	st, ok := m[clusterID]
+	if !ok {
+		st = health.StateUnknown
+	}
+
+	return health.Status{State: st}
+}
+
+func Test_AllocateCluster_Health(t *testing.T) {
+	tests := []struct {
+		name            string
+		states          mockHealthChecker
+		possibleExpects []string
+		expectCode      codes.Code
+	}{
+		{
+			name:            "not yet probed",
+			states:          mockHealthChecker{},
+			possibleExpects: []string{clusterID1, clusterID3},
+		},
+		{
+			name:            "skips degraded and unreachable",
+			states:          mockHealthChecker{clusterID1: health.StateDegraded, clusterID3: health.StateHealthy},
+			possibleExpects: []string{clusterID3},
+		},
+		{
+			name:       "none healthy",
+			states:     mockHealthChecker{clusterID1: health.StateUnreachable, clusterID3: health.StateDegraded},
+			expectCode: codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocationManager := NewManager(mockClusterManager, tt.states, nil)
+			actual, err := allocationManager.AllocateCluster(context.Background(), &Request{
+				AffinityTags: map[string]string{"region": "us-east-1"},
+			})
+			if tt.expectCode != codes.OK {
+				require.Equal(t, tt.expectCode, status.Code(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, tt.possibleExpects, actual)
+		})
+	}
+}
+
+func Test_AllocateCluster_NotReady(t *testing.T) {
+	failed := &cluster.Cluster{Config: cluster4.Config, State: cluster.StateFailed, LastError: "no such host"}
+	allocationManager := NewManager(&clustermocks.MockClusterManager{
+		MockGet:    func(string) (*cluster.Cluster, error) { return failed, nil },
+		MockAllIDs: func() []string { return []string{clusterID4} },
+	}, nil, nil)
+
+	_, err := allocationManager.AllocateCluster(context.Background(), &Request{AffinityTags: affinityTags4})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func Test_AllocateCluster_Span(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prev)
+
+	allocationManager := setupAllocator()
+	clusterID, err := allocationManager.AllocateCluster(context.Background(), &Request{
+		VolumeUUID:   "vol-1",
+		AffinityTags: affinityTags4,
+	})
+	require.NoError(t, err)
+	_, err = allocationManager.AllocateCluster(context.Background(), &Request{
+		AffinityTags: map[string]string{"region": "nowhere"},
+	})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "allocator.AllocateCluster", spans[0].Name())
+	require.Subset(t, spans[0].Attributes(), []attribute.KeyValue{
+		attribute.String("volume_uuid", "vol-1"),
+		attribute.StringSlice("qualified_cluster_ids", []string{clusterID4}),
+		attribute.String("assigned_cluster_id", clusterID),
+	})
+	require.Len(t, spans[1].Events(), 1, "the error is recorded")
+}
+
 func Test_tagMatch(t *testing.T) {
 	type input struct {
 		a map[string]string
@@ -1,9 +1,15 @@
 package mocks
 
+import (
+	"context"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
+)
+
 type MockAllocator struct {
-	MockAllocateCluster func(affinityTags map[string]string) (string, error)
+	MockAllocateCluster func(ctx context.Context, req *allocator.Request) (string, error)
 }
 
-func (m *MockAllocator) AllocateCluster(affinityTags map[string]string) (string, error) {
-	return m.MockAllocateCluster(affinityTags)
+func (m *MockAllocator) AllocateCluster(ctx context.Context, req *allocator.Request) (string, error) {
+	return m.MockAllocateCluster(ctx, req)
 }
@@ -0,0 +1,138 @@
+package allocator
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+var errZeroWeights = status.Error(codes.ResourceExhausted, "all qualified clusters have a weight of 0")
+
+// Strategy picks the cluster a new volume is placed on. Candidates have already been filtered by affinity tags and
+// capacity, are sorted by cluster ID, and are never empty.
+type Strategy interface {
+	Pick(req *Request, candidates []*cluster.Cluster) (*cluster.Cluster, error)
+}
+
+type resourceCounter interface {
+	CountResourcesOfCluster(clusterID string) map[resource.Type]int
+}
+
+// RandomStrategy picks uniformly at random.
+type RandomStrategy struct{}
+
+func NewRandomStrategy() *RandomStrategy {
+	return &RandomStrategy{}
+}
+
+func (*RandomStrategy) Pick(_ *Request, candidates []*cluster.Cluster) (*cluster.Cluster, error) {
+	return randomElement(candidates), nil
+}
+
+// RoundRobinStrategy cycles through the candidates. The position is shared across requests with different
+// candidate sets, so the rotation is only exact while the set of qualified clusters is stable.
+type RoundRobinStrategy struct {
+	next atomic.Uint64
+}
+
+func NewRoundRobinStrategy() *RoundRobinStrategy {
+	return &RoundRobinStrategy{}
+}
+
+func (s *RoundRobinStrategy) Pick(_ *Request, candidates []*cluster.Cluster) (*cluster.Cluster, error) {
+	i := (s.next.Add(1) - 1) % uint64(len(candidates))
+
+	return candidates[i], nil
+}
+
+// WeightedStrategy picks at random in proportion to each cluster's configured weight, so a newly added cluster can
+// be ramped up gradually. Clusters with a weight of 0 are never picked.
+type WeightedStrategy struct{}
+
+func NewWeightedStrategy() *WeightedStrategy {
+	return &WeightedStrategy{}
+}
+
+func (*WeightedStrategy) Pick(_ *Request, candidates []*cluster.Cluster) (*cluster.Cluster, error) {
+	var total uint64
+	for _, c := range candidates {
+		total += uint64(c.Config.AllocationWeight())
+	}
+	if total == 0 {
+		return nil, errZeroWeights
+	}
+
+	r := rand.Uint64N(total)
+	for _, c := range candidates {
+		w := uint64(c.Config.AllocationWeight())
+		if r < w {
+			return c, nil
+		}
+		r -= w
+	}
+
+	return nil, errZeroWeights // unreachable
+}
+
+// ConsistentHashStrategy picks by rendezvous hashing of the volume UUID, so the same volume always maps to the same
+// cluster, and adding or removing a cluster only moves the volumes that hash to it.
+type ConsistentHashStrategy struct{}
+
+func NewConsistentHashStrategy() *ConsistentHashStrategy {
+	return &ConsistentHashStrategy{}
+}
+
+func (*ConsistentHashStrategy) Pick(req *Request, candidates []*cluster.Cluster) (*cluster.Cluster, error) {
+	var best *cluster.Cluster
+	var bestScore uint64
+	for _, c := range candidates {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(req.VolumeUUID))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(c.Config.ClusterID))
+		if score := h.Sum64(); best == nil || score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+
+	return best, nil
+}
+
+// FewestResourcesStrategy picks the cluster holding the fewest volumes and snapshots, breaking ties by cluster ID.
+type FewestResourcesStrategy struct {
+	resources resourceCounter
+}
+
+func NewFewestResourcesStrategy(rc resourceCounter) *FewestResourcesStrategy {
+	return &FewestResourcesStrategy{
+		resources: rc,
+	}
+}
+
+func (s *FewestResourcesStrategy) Pick(_ *Request, candidates []*cluster.Cluster) (*cluster.Cluster, error) {
+	best := candidates[0]
+	bestCount := s.count(best.Config.ClusterID)
+	for _, c := range candidates[1:] {
+		if count := s.count(c.Config.ClusterID); count < bestCount {
+			best, bestCount = c, count
+		}
+	}
+
+	return best, nil
+}
+
+// Returns the number of volumes and snapshots on a cluster.
+func (s *FewestResourcesStrategy) count(clusterID string) int {
+	total := 0
+	for _, count := range s.resources.CountResourcesOfCluster(clusterID) {
+		total += count
+	}
+
+	return total
+}
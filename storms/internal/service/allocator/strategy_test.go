@@ -0,0 +1,138 @@
+package allocator
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+)
+
+func weighted(c *cluster.Cluster, weight uint) *cluster.Cluster {
+	cfg := *c.Config
+	cfg.Weight = &weight
+
+	return &cluster.Cluster{Config: &cfg, Client: c.Client}
+}
+
+func Test_RoundRobinStrategy(t *testing.T) {
+	candidates := []*cluster.Cluster{cluster2, cluster1, cluster3}
+	s := NewRoundRobinStrategy()
+
+	picked := []string{}
+	for range 6 {
+		c, err := s.Pick(&Request{}, candidates)
+		require.NoError(t, err)
+		picked = append(picked, c.Config.ClusterID)
+	}
+	require.Equal(t, []string{clusterID2, clusterID1, clusterID3, clusterID2, clusterID1, clusterID3}, picked)
+}
+
+func Test_WeightedStrategy(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []*cluster.Cluster
+		possible   []string
+		expectErr  bool
+	}{
+		{
+			name:       "default weights",
+			candidates: []*cluster.Cluster{cluster1, cluster2},
+			possible:   []string{clusterID1, clusterID2},
+		},
+		{
+			name:       "zero weight is never picked",
+			candidates: []*cluster.Cluster{weighted(cluster1, 0), weighted(cluster2, 5)},
+			possible:   []string{clusterID2},
+		},
+		{
+			name:       "all zero weights",
+			candidates: []*cluster.Cluster{weighted(cluster1, 0), weighted(cluster2, 0)},
+			expectErr:  true,
+		},
+	}
+
+	s := NewWeightedStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 20 {
+				c, err := s.Pick(&Request{}, tt.candidates)
+				if tt.expectErr {
+					require.Error(t, err)
+					require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+					return
+				}
+				require.NoError(t, err)
+				require.Contains(t, tt.possible, c.Config.ClusterID)
+			}
+		})
+	}
+}
+
+func Test_WeightedStrategy_Distribution(t *testing.T) {
+	candidates := []*cluster.Cluster{weighted(cluster1, 1), weighted(cluster2, 9)}
+	s := NewWeightedStrategy()
+
+	counts := map[string]int{}
+	for range 10000 {
+		c, err := s.Pick(&Request{}, candidates)
+		require.NoError(t, err)
+		counts[c.Config.ClusterID]++
+	}
+	// Expect ~1000 and ~9000; the bounds are loose enough to never flake.
+	require.InDelta(t, 1000, counts[clusterID1], 300)
+	require.InDelta(t, 9000, counts[clusterID2], 300)
+}
+
+func Test_ConsistentHashStrategy(t *testing.T) {
+	s := NewConsistentHashStrategy()
+	all := []*cluster.Cluster{cluster1, cluster2, cluster3, cluster4}
+	withoutFirst := []*cluster.Cluster{cluster2, cluster3, cluster4}
+
+	moved := 0
+	for range 200 {
+		req := &Request{VolumeUUID: uuid.NewString()}
+		first, err := s.Pick(req, all)
+		require.NoError(t, err)
+		again, err := s.Pick(req, all)
+		require.NoError(t, err)
+		require.Equal(t, first, again)
+
+		// Removing a cluster only moves the volumes that were placed on it.
+		after, err := s.Pick(req, withoutFirst)
+		require.NoError(t, err)
+		if first != cluster1 {
+			require.Equal(t, first, after)
+		} else {
+			moved++
+		}
+	}
+	require.Positive(t, moved)
+}
+
+func Test_FewestResourcesStrategy(t *testing.T) {
+	counts := map[string]int{
+		clusterID1: 3,
+		clusterID2: 1,
+		clusterID3: 1,
+	}
+	s := NewFewestResourcesStrategy(&resourcemocks.MockResourceManager{
+		MockCountResourcesOfCluster: func(clusterID string) map[resource.Type]int {
+			return map[resource.Type]int{resource.TypeVolume: counts[clusterID] - 1, resource.TypeSnapshot: 1}
+		},
+	})
+
+	c, err := s.Pick(&Request{}, []*cluster.Cluster{cluster1, cluster2, cluster3})
+	require.NoError(t, err)
+	require.Equal(t, clusterID2, c.Config.ClusterID, "ties are broken by candidate order")
+
+	c, err = s.Pick(&Request{}, []*cluster.Cluster{cluster1, cluster4})
+	require.NoError(t, err)
+	require.Equal(t, clusterID4, c.Config.ClusterID)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/pkg/redact"
+	appconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/app/configs"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/audit"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/auth"
+)
+
+const bytesPerMiB = 1 << 20
+
+var errAuditDisabled = status.Error(codes.FailedPrecondition, "audit log is not enabled")
+
+// Mutating requests are recorded by an interceptor once they return, and by authorization if they are denied. Requests sent with async are recorded again when
+// their operation completes, with its final result, so that failed background deletions are not mistaken for
+// successful ones.
+
+// Implemented by requests acting on a single resource.
+type resourceRequest interface {
+	GetUuid() string
+}
+
+// Implemented by responses of mutating requests, which carry an operation ID if sent with async.
+type operationResponse interface {
+	GetOperationId() string
+}
+
+// Creates the audit logger selected by the app configuration, or returns nil if requests are not audited.
+func newAuditLogger(cfg appconfigs.AppConfig) (auditLogger, error) {
+	if cfg.AuditFile == "" {
+		return nil, nil //nolint:nilnil // no logger when auditing is disabled
+	}
+
+	l, err := audit.NewFileLogger(audit.Config{
+		Path:         cfg.AuditFile,
+		MaxSizeBytes: int64(cfg.AuditMaxSizeMB) * bytesPerMiB,
+		MaxFiles:     cfg.AuditMaxFiles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", cfg.AuditFile, err)
+	}
+
+	return l, nil
+}
+
+// Reports whether a method changes state, and so is audited. Methods that need more than read-only access do, except
+// for reading the audit log itself.
+func isMutating(fullMethod string) bool {
+	return fullMethod != admin.AdminService_QueryAuditLog_FullMethodName && requiredRole(fullMethod) != auth.RoleReadOnly
+}
+
+// Starts an audit entry for a request received now, recording who sent it. The request is recorded if not nil.
+func (s *Service) newAuditEntry(ctx context.Context, fullMethod, resourceID string, req proto.Message,
+) *audit.Entry {
+	e := &audit.Entry{Time: time.Now(), Method: fullMethod, ResourceID: resourceID}
+	if caller, ok := auth.CallerFromContext(ctx); ok {
+		e.Caller, e.Role = caller.Name, string(caller.Role)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		e.Peer = p.Addr.String()
+	}
+	if req != nil {
+		b, err := protojson.Marshal(redact.Message(req))
+		if err != nil {
+			log.Warn().Str("grpc_method", fullMethod).Err(err).Msg("failed to encode request for audit log")
+		} else {
+			e.Request = b
+		}
+	}
+	s.setAuditCluster(e)
+
+	return e
+}
+
+// Fills in the cluster holding the resource of an entry, if it is mapped.
+func (s *Service) setAuditCluster(e *audit.Entry) {
+	if e.ClusterID != "" || e.ResourceID == "" {
+		return
+	}
+	clusterID, err := s.resourceManager.GetResourceCluster(e.ResourceID)
+	if err != nil {
+		return
+	}
+	e.ClusterID = clusterID
+	if c, err := s.clusterManager.Get(clusterID); err == nil && c.Config != nil {
+		e.Vendor = c.Config.Vendor
+	}
+}
+
+// Completes an audit entry with the result of its request and records it. Failures to record are logged, rather than
+// failing a request that has already run.
+func (s *Service) recordAudit(e *audit.Entry, err error) {
+	e.Duration = time.Since(e.Time)
+	e.Code = status.Code(err).String()
+	if err != nil {
+		e.Error = err.Error()
+	}
+	// Resources created by the request are only mapped once it returns.
+	s.setAuditCluster(e)
+
+	if err := s.audit.Record(e); err != nil {
+		log.Error().Err(err).Str("grpc_method", e.Method).Str("resource_id", e.ResourceID).
+			Msg("failed to record audit entry")
+	}
+}
+
+// Records mutating requests in the audit log.
+func (s *Service) auditUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !isMutating(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	e := s.newRequestAuditEntry(ctx, info.FullMethod, req)
+	resp, err := handler(ctx, req)
+	if r, ok := resp.(operationResponse); ok && err == nil {
+		e.OperationID = r.GetOperationId()
+	}
+	s.recordAudit(e, err)
+
+	return resp, err
+}
+
+// Records a mutating request denied by authorization, which never reaches auditUnaryInterceptor.
+func (s *Service) auditDenied(ctx context.Context, fullMethod string, req interface{}, err error) {
+	if s.audit == nil || !isMutating(fullMethod) {
+		return
+	}
+
+	s.recordAudit(s.newRequestAuditEntry(ctx, fullMethod, req), err)
+}
+
+// Starts an audit entry for a unary request, recording the resource it acts on.
+func (s *Service) newRequestAuditEntry(ctx context.Context, fullMethod string, req interface{}) *audit.Entry {
+	resourceID := ""
+	if r, ok := req.(resourceRequest); ok {
+		resourceID = r.GetUuid()
+	}
+	message, _ := req.(proto.Message)
+
+	return s.newAuditEntry(ctx, fullMethod, resourceID, message)
+}
+
+// Returns the full gRPC method of a storage service method name.
+func storageMethod(method string) string {
+	return "/" + storms.StorageManagementService_ServiceDesc.ServiceName + "/" + method
+}
+
+func (s *Service) QueryAuditLog(_ context.Context, req *admin.QueryAuditLogRequest,
+) (*admin.QueryAuditLogResponse, error) {
+	if s.audit == nil {
+		return nil, errAuditDisabled
+	}
+
+	filter := audit.Filter{ResourceID: req.GetResourceUuid(), Limit: int(req.GetLimit())}
+	if req.GetStartTime() != nil {
+		filter.Start = req.GetStartTime().AsTime()
+	}
+	if req.GetEndTime() != nil {
+		filter.End = req.GetEndTime().AsTime()
+	}
+
+	entries, err := s.audit.Query(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+
+	out := make([]*admin.AuditEntry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, &admin.AuditEntry{
+			Time:         timestamppb.New(e.Time),
+			Caller:       e.Caller,
+			Role:         e.Role,
+			Peer:         e.Peer,
+			Method:       e.Method,
+			ResourceUuid: e.ResourceID,
+			ClusterId:    e.ClusterID,
+			Vendor:       e.Vendor,
+			OperationId:  e.OperationID,
+			Request:      string(e.Request),
+			Code:         e.Code,
+			Error:        e.Error,
+			Duration:     durationpb.New(e.Duration),
+		})
+	}
+
+	return &admin.QueryAuditLogResponse{Entries: out}, nil
+}
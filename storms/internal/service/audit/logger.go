@@ -0,0 +1,323 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DefaultMaxSizeBytes = 100 << 20
+	DefaultMaxFiles     = 10
+	DefaultQueryLimit   = 100
+	MaxQueryLimit       = 1000
+
+	fileMode = 0o600
+	dirMode  = 0o750
+	// Longest entry that can be read back.
+	maxEntryBytes = 1 << 20
+)
+
+var errLoggerClosed = errors.New("audit logger is closed")
+
+// Entry records a single mutating request, or the completion of an async operation it started.
+type Entry struct {
+	Time        time.Time       `json:"time"`
+	Caller      string          `json:"caller,omitempty"`
+	Role        string          `json:"role,omitempty"`
+	Peer        string          `json:"peer,omitempty"`
+	Method      string          `json:"method"`
+	ResourceID  string          `json:"resourceId,omitempty"`
+	ClusterID   string          `json:"clusterId,omitempty"`
+	Vendor      string          `json:"vendor,omitempty"`
+	OperationID string          `json:"operationId,omitempty"`
+	Request     json.RawMessage `json:"request,omitempty"`
+	Code        string          `json:"code"`
+	Error       string          `json:"error,omitempty"`
+	Duration    time.Duration   `json:"durationNs"`
+}
+
+// Filter selects the entries returned by a query. Zero fields match every entry.
+type Filter struct {
+	ResourceID string
+	// Entries recorded at or after Start and before End.
+	Start time.Time
+	End   time.Time
+	// Maximum number of entries to return, keeping the most recent.
+	Limit int
+}
+
+func (f Filter) matches(e *Entry) bool {
+	if f.ResourceID != "" && e.ResourceID != f.ResourceID {
+		return false
+	}
+	if !f.Start.IsZero() && e.Time.Before(f.Start) {
+		return false
+	}
+	if !f.End.IsZero() && !e.Time.Before(f.End) {
+		return false
+	}
+
+	return true
+}
+
+type Config struct {
+	Path string
+	// The file is rotated once writing an entry would take it over this size.
+	MaxSizeBytes int64
+	// Number of rotated files kept besides the current one. The oldest is deleted on rotation.
+	MaxFiles int
+}
+
+// FileLogger appends entries as JSON lines to a file, fsync'ing each one so that no acknowledged request goes
+// unrecorded. When the file grows too large it is renamed to Path.1, shifting older files up to Path.MaxFiles.
+type FileLogger struct {
+	cfg Config
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileLogger opens (or creates) the audit log at cfg.Path for appending.
+func NewFileLogger(cfg Config) (*FileLogger, error) {
+	if cfg.MaxSizeBytes <= 0 {
+		cfg.MaxSizeBytes = DefaultMaxSizeBytes
+	}
+	if cfg.MaxFiles <= 0 {
+		cfg.MaxFiles = DefaultMaxFiles
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), dirMode); err != nil {
+		return nil, fmt.Errorf("failed to create directory for audit log: %w", err)
+	}
+
+	l := &FileLogger{cfg: cfg}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Record appends an entry to the log.
+func (l *FileLogger) Record(e *Entry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return errLoggerClosed
+	}
+	if l.size > 0 && l.size+int64(len(b)) > l.cfg.MaxSizeBytes {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(b)
+	l.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+
+	return nil
+}
+
+// Query returns the entries matching the filter, oldest first, reading the rotated files before the current one.
+func (l *FileLogger) Query(filter Filter) ([]*Entry, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultQueryLimit
+	}
+	filter.Limit = min(filter.Limit, MaxQueryLimit)
+
+	files, err := l.openFiles()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, f := range files {
+			_ = f.file.Close()
+		}
+	}()
+
+	entries := []*Entry{}
+	for _, f := range files {
+		var fileEntries []*Entry
+		fileEntries, err = readEntries(f.file.Name(), io.LimitReader(f.file, f.size), filter)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+		if len(entries) > filter.Limit {
+			entries = entries[len(entries)-filter.Limit:]
+		}
+	}
+
+	return entries, nil
+}
+
+// A file of the log opened for reading, of which the first size bytes are read.
+type openedFile struct {
+	file *os.File
+	size int64
+}
+
+// Opens the rotated files, oldest first, and then the current one. Only the lock is held while opening them, so that
+// a rotation cannot move files between opens, but not while they are read, so that entries keep being recorded. A
+// later rotation renames or removes the files, which leaves the opened ones readable, and the current file is read
+// up to its size when opened.
+func (l *FileLogger) openFiles() ([]openedFile, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	files := []openedFile{}
+	for i := l.cfg.MaxFiles; i >= 0; i-- {
+		f, err := os.Open(l.rotatedPath(i))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			for _, opened := range files {
+				_ = opened.file.Close()
+			}
+
+			return nil, fmt.Errorf("failed to open audit log: %w", err)
+		}
+		size := l.size
+		if i > 0 {
+			// Rotated files no longer change.
+			size = math.MaxInt64
+		}
+		files = append(files, openedFile{file: f, size: size})
+	}
+
+	return files, nil
+}
+
+// Close syncs and closes the log. The logger must not be used afterwards.
+func (l *FileLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	err := l.file.Sync()
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	l.file = nil
+	if err != nil {
+		return fmt.Errorf("failed to close audit log: %w", err)
+	}
+
+	return nil
+}
+
+// Opens the current file for appending. A partial line left by a crash is terminated, so that the next entry is
+// not appended to it.
+func (l *FileLogger) open() error {
+	f, err := os.OpenFile(l.cfg.Path, os.O_CREATE|os.O_APPEND|os.O_RDWR, fileMode)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("failed to stat audit log: %w", err)
+	}
+	size := info.Size()
+
+	if size > 0 {
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, size-1); err != nil {
+			_ = f.Close()
+
+			return fmt.Errorf("failed to read audit log: %w", err)
+		}
+		if last[0] != '\n' {
+			n, err := f.Write([]byte{'\n'})
+			size += int64(n)
+			if err != nil {
+				_ = f.Close()
+
+				return fmt.Errorf("failed to write audit log: %w", err)
+			}
+		}
+	}
+	l.file, l.size = f, size
+
+	return nil
+}
+
+// Shifts each rotated file up by one, dropping the oldest, and starts a new file. Callers must hold l.mu.
+func (l *FileLogger) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit log for rotation: %w", err)
+	}
+	l.file = nil
+
+	if err := os.Remove(l.rotatedPath(l.cfg.MaxFiles)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove oldest audit log: %w", err)
+	}
+	for i := l.cfg.MaxFiles - 1; i >= 0; i-- {
+		err := os.Rename(l.rotatedPath(i), l.rotatedPath(i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to rotate audit log: %w", err)
+		}
+	}
+
+	return l.open()
+}
+
+// Returns the path of the file rotated i times, where 0 is the current file.
+func (l *FileLogger) rotatedPath(i int) string {
+	if i == 0 {
+		return l.cfg.Path
+	}
+
+	return fmt.Sprintf("%s.%d", l.cfg.Path, i)
+}
+
+// Returns the entries of a file matching the filter. Lines that cannot be parsed (e.g. a write interrupted by a
+// crash) are skipped.
+func readEntries(path string, r io.Reader, filter Filter) ([]*Entry, error) {
+	entries := []*Entry{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxEntryBytes)
+	for line := 1; scanner.Scan(); line++ {
+		e := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), e); err != nil {
+			log.Warn().Str("path", path).Int("line", line).Err(err).Msg("skipping malformed audit entry")
+
+			continue
+		}
+		if filter.matches(e) {
+			entries = append(entries, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,179 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var baseTime = time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+
+func testEntry(i int, resourceID string) *Entry {
+	return &Entry{
+		Time:       baseTime.Add(time.Duration(i) * time.Minute),
+		Caller:     "ci",
+		Method:     "/storms.v1.StorageManagementService/DeleteVolume",
+		ResourceID: resourceID,
+		Request:    []byte(fmt.Sprintf(`{"uuid":%q}`, resourceID)),
+		Code:       "OK",
+		Duration:   time.Second,
+	}
+}
+
+func Test_FileLogger_Query(t *testing.T) {
+	l, err := NewFileLogger(Config{Path: filepath.Join(t.TempDir(), "audit", "audit.log")})
+	require.NoError(t, err)
+	defer l.Close()
+
+	for i := range 10 {
+		resourceID := "vol-a"
+		if i%2 == 1 {
+			resourceID = "vol-b"
+		}
+		require.NoError(t, l.Record(testEntry(i, resourceID)))
+	}
+
+	tests := []struct {
+		name          string
+		filter        Filter
+		expectedTimes []int
+	}{
+		{name: "all", filter: Filter{}, expectedTimes: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{name: "resource", filter: Filter{ResourceID: "vol-b"}, expectedTimes: []int{1, 3, 5, 7, 9}},
+		{
+			name:          "time range",
+			filter:        Filter{Start: baseTime.Add(2 * time.Minute), End: baseTime.Add(5 * time.Minute)},
+			expectedTimes: []int{2, 3, 4},
+		},
+		{name: "limit keeps most recent", filter: Filter{ResourceID: "vol-a", Limit: 2}, expectedTimes: []int{6, 8}},
+		{name: "no match", filter: Filter{ResourceID: "vol-c"}, expectedTimes: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := l.Query(tt.filter)
+			require.NoError(t, err)
+
+			times := []int{}
+			for _, e := range entries {
+				times = append(times, int(e.Time.Sub(baseTime)/time.Minute))
+			}
+			require.Equal(t, tt.expectedTimes, times)
+		})
+	}
+
+	entries, err := l.Query(Filter{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, testEntry(9, "vol-b").Request, entries[0].Request)
+	require.Equal(t, time.Second, entries[0].Duration)
+}
+
+func Test_FileLogger_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	entrySize := func() int64 {
+		l, err := NewFileLogger(Config{Path: filepath.Join(t.TempDir(), "size.log")})
+		require.NoError(t, err)
+		defer l.Close()
+		require.NoError(t, l.Record(testEntry(0, "vol-a")))
+
+		return l.size
+	}()
+
+	// Two entries fit in each file, and two rotated files are kept.
+	l, err := NewFileLogger(Config{Path: path, MaxSizeBytes: 2 * entrySize, MaxFiles: 2})
+	require.NoError(t, err)
+	for i := range 7 {
+		require.NoError(t, l.Record(testEntry(i, "vol-a")))
+	}
+
+	for _, p := range []string{path, path + ".1", path + ".2"} {
+		_, err := os.Stat(p)
+		require.NoError(t, err)
+	}
+	_, err = os.Stat(path + ".3")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// The oldest file was dropped, and the remaining entries are returned in order across files.
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	for i, e := range entries {
+		require.Equal(t, baseTime.Add(time.Duration(i+2)*time.Minute), e.Time)
+	}
+
+	// Reopening appends to the current file.
+	require.NoError(t, l.Close())
+	l, err = NewFileLogger(Config{Path: path, MaxSizeBytes: 2 * entrySize, MaxFiles: 2})
+	require.NoError(t, err)
+	defer l.Close()
+	require.Equal(t, entrySize, l.size)
+}
+
+func Test_FileLogger_QueryWhileRecording(t *testing.T) {
+	// Entries rotate every few records while queries run, which must neither block recording nor skip ahead.
+	l, err := NewFileLogger(Config{Path: filepath.Join(t.TempDir(), "audit.log"), MaxSizeBytes: 1024, MaxFiles: 100})
+	require.NoError(t, err)
+	defer l.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range 200 {
+			if err := l.Record(testEntry(i, "vol-a")); err != nil {
+				t.Error(err)
+
+				return
+			}
+		}
+	}()
+
+	for {
+		entries, err := l.Query(Filter{Limit: MaxQueryLimit})
+		require.NoError(t, err)
+		for i, e := range entries {
+			require.Equal(t, baseTime.Add(time.Duration(i)*time.Minute), e.Time)
+		}
+		select {
+		case <-done:
+			entries, err = l.Query(Filter{Limit: MaxQueryLimit})
+			require.NoError(t, err)
+			require.Len(t, entries, 200)
+
+			return
+		default:
+		}
+	}
+}
+
+func Test_FileLogger_MalformedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := NewFileLogger(Config{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, l.Record(testEntry(0, "vol-a")))
+	require.NoError(t, l.Close())
+
+	// A write interrupted by a crash leaves a partial line.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, fileMode)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"time":"2026-09-01T12:01:00Z","meth`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = NewFileLogger(Config{Path: path})
+	require.NoError(t, err)
+	defer l.Close()
+
+	// The partial line is skipped, and entries recorded after it are intact.
+	require.NoError(t, l.Record(testEntry(2, "vol-a")))
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, baseTime.Add(2*time.Minute), entries[1].Time)
+
+	require.NoError(t, l.Close())
+	require.ErrorIs(t, l.Record(testEntry(1, "vol-a")), errLoggerClosed)
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/audit"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/auth"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/operation"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+)
+
+// Returns a service auditing to a file in a temporary directory, whose resources all live on cluster 1.
+func newAuditedService(t *testing.T) *Service {
+	t.Helper()
+
+	l, err := audit.NewFileLogger(audit.Config{Path: filepath.Join(t.TempDir(), "audit.log")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	return &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockGet: func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockGetResourceCluster: func(string) (string, error) { return clusterID1, nil },
+		},
+		audit: l,
+	}
+}
+
+func Test_auditUnaryInterceptor(t *testing.T) {
+	s := newAuditedService(t)
+	ctx := auth.WithCaller(context.Background(), auth.Caller{Name: "ci", Role: auth.RoleOperator})
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4242}})
+
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.FailedPrecondition, "volume is attached")
+	}
+	_, err := s.auditUnaryInterceptor(ctx, &storms.DeleteVolumeRequest{Uuid: resourceID1},
+		&grpc.UnaryServerInfo{FullMethod: storms.StorageManagementService_DeleteVolume_FullMethodName}, handler)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Reads are not audited.
+	_, err = s.auditUnaryInterceptor(ctx, &storms.GetVolumeRequest{Uuid: resourceID1},
+		&grpc.UnaryServerInfo{FullMethod: storms.StorageManagementService_GetVolume_FullMethodName},
+		func(context.Context, interface{}) (interface{}, error) { return &storms.GetVolumeResponse{}, nil })
+	require.NoError(t, err)
+
+	// Async requests record the operation they started.
+	_, err = s.auditUnaryInterceptor(ctx, &storms.DetachVolumeRequest{Uuid: resourceID1, Async: true},
+		&grpc.UnaryServerInfo{FullMethod: storms.StorageManagementService_DetachVolume_FullMethodName},
+		func(context.Context, interface{}) (interface{}, error) {
+			return &storms.DetachVolumeResponse{OperationId: "op-1"}, nil
+		})
+	require.NoError(t, err)
+
+	resp, err := s.QueryAuditLog(context.Background(), &admin.QueryAuditLogRequest{ResourceUuid: resourceID1})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEntries(), 2)
+
+	deleted := resp.GetEntries()[0]
+	require.Equal(t, storms.StorageManagementService_DeleteVolume_FullMethodName, deleted.GetMethod())
+	require.Equal(t, "ci", deleted.GetCaller())
+	require.Equal(t, string(auth.RoleOperator), deleted.GetRole())
+	require.Equal(t, "10.0.0.1:4242", deleted.GetPeer())
+	require.Equal(t, clusterID1, deleted.GetClusterId())
+	require.Equal(t, vendor1, deleted.GetVendor())
+	require.JSONEq(t, `{"uuid":"`+resourceID1+`"}`, deleted.GetRequest())
+	require.Equal(t, codes.FailedPrecondition.String(), deleted.GetCode())
+	require.Equal(t, "rpc error: code = FailedPrecondition desc = volume is attached", deleted.GetError())
+
+	detached := resp.GetEntries()[1]
+	require.Equal(t, "op-1", detached.GetOperationId())
+	require.Equal(t, codes.OK.String(), detached.GetCode())
+}
+
+func Test_auditDenied(t *testing.T) {
+	s := newAuditedService(t)
+	authn := &mockAuthenticator{caller: auth.Caller{Name: "sre", Role: auth.RoleReadOnly}}
+	handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+
+	_, err := authorizationUnaryInterceptor(authn, s.auditDenied)(context.Background(),
+		&storms.DeleteVolumeRequest{Uuid: resourceID1},
+		&grpc.UnaryServerInfo{FullMethod: storms.StorageManagementService_DeleteVolume_FullMethodName}, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	authn = &mockAuthenticator{err: auth.ErrUnauthenticated}
+	_, err = authorizationUnaryInterceptor(authn, s.auditDenied)(context.Background(),
+		&storms.DeleteVolumeRequest{Uuid: resourceID1},
+		&grpc.UnaryServerInfo{FullMethod: storms.StorageManagementService_DeleteVolume_FullMethodName}, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Denied reads are not audited.
+	_, err = authorizationUnaryInterceptor(authn, s.auditDenied)(context.Background(),
+		&storms.GetVolumeRequest{Uuid: resourceID1},
+		&grpc.UnaryServerInfo{FullMethod: storms.StorageManagementService_GetVolume_FullMethodName}, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	resp, err := s.QueryAuditLog(context.Background(), &admin.QueryAuditLogRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEntries(), 2)
+	require.Equal(t, "sre", resp.GetEntries()[0].GetCaller())
+	require.Equal(t, codes.PermissionDenied.String(), resp.GetEntries()[0].GetCode())
+	require.Empty(t, resp.GetEntries()[1].GetCaller())
+	require.Equal(t, codes.Unauthenticated.String(), resp.GetEntries()[1].GetCode())
+}
+
+func Test_runMutation_Audit(t *testing.T) {
+	s := newAuditedService(t)
+	s.operations = operation.NewManager(0)
+	defer s.operations.Stop()
+	ctx := auth.WithCaller(context.Background(), auth.Caller{Name: "ci", Role: auth.RoleOperator})
+
+	opID, err := s.runMutation(ctx, mutation{
+		async:        true,
+		method:       "DeleteVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   resourceID1,
+		run:          func(context.Context) error { return status.Error(codes.Unavailable, "cluster is down") },
+	})
+	require.NoError(t, err)
+	_, err = s.WaitOperation(context.Background(), &storms.WaitOperationRequest{Id: opID})
+	require.NoError(t, err)
+
+	// The completion of the operation is recorded with its result and the caller that started it.
+	resp, err := s.QueryAuditLog(context.Background(), &admin.QueryAuditLogRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEntries(), 1)
+	entry := resp.GetEntries()[0]
+	require.Equal(t, storms.StorageManagementService_DeleteVolume_FullMethodName, entry.GetMethod())
+	require.Equal(t, opID, entry.GetOperationId())
+	require.Equal(t, "ci", entry.GetCaller())
+	require.Equal(t, codes.Unavailable.String(), entry.GetCode())
+	require.Equal(t, clusterID1, entry.GetClusterId())
+}
+
+func Test_QueryAuditLog_Disabled(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.QueryAuditLog(context.Background(), &admin.QueryAuditLogRequest{})
+	require.ErrorIs(t, err, errAuditDisabled)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func Test_isMutating(t *testing.T) {
+	require.True(t, isMutating(storms.StorageManagementService_DeleteVolume_FullMethodName))
+	require.True(t, isMutating(admin.AdminService_ReloadConfig_FullMethodName))
+	require.False(t, isMutating(storms.StorageManagementService_GetVolumes_FullMethodName))
+	require.False(t, isMutating(admin.AdminService_QueryAuditLog_FullMethodName))
+}
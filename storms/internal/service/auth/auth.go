@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+var (
+	ErrUnauthenticated  = status.Error(codes.Unauthenticated, "caller is not authenticated")
+	ErrPermissionDenied = status.Error(codes.PermissionDenied, "caller's role does not allow this method")
+
+	errInvalidRole     = errors.New("invalid role")
+	errInvalidIdentity = errors.New("identity must set exactly one of subject and token_sha256")
+	errInvalidToken    = errors.New("token_sha256 must be a hex-encoded SHA-256 digest")
+	errDuplicateKey    = errors.New("subject or token is set on more than one identity")
+)
+
+// Role is the set of methods a caller may call. Each role may call every method of the roles before it.
+type Role string
+
+const (
+	// May read volumes, snapshots, operations and cluster state.
+	RoleReadOnly Role = "read_only"
+	// May also create, change and delete volumes and snapshots.
+	RoleOperator Role = "operator"
+	// May also call the admin service, such as to reload config.
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) rank() int {
+	switch r {
+	case RoleReadOnly:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	}
+
+	return 0
+}
+
+// Allows reports whether callers with the role may call methods requiring the given role.
+func (r Role) Allows(required Role) bool {
+	return r.rank() > 0 && r.rank() >= required.rank()
+}
+
+// Identity maps a caller, known by its client certificate or bearer token, to a role.
+type Identity struct {
+	// Name of the caller, used in logs.
+	Name string
+	// Matched against the common name and DNS names of a verified client certificate.
+	Subject string
+	// Hex-encoded SHA-256 digest of a bearer token, so that the token itself is not stored in config.
+	TokenSHA256 string
+	Role        Role
+}
+
+// Caller is the authenticated identity of a request.
+type Caller struct {
+	Name string
+	Role Role
+}
+
+// Authenticator resolves the caller of a request from its bearer token or verified client certificate.
+type Authenticator struct {
+	subjects map[string]Caller
+	tokens   map[string]Caller
+}
+
+// NewAuthenticator fails if an identity has an invalid role or does not set exactly one way to authenticate.
+func NewAuthenticator(identities []Identity) (*Authenticator, error) {
+	a := &Authenticator{subjects: map[string]Caller{}, tokens: map[string]Caller{}}
+	for _, identity := range identities {
+		if identity.Role.rank() == 0 {
+			return nil, fmt.Errorf("%w %q for identity %q", errInvalidRole, identity.Role, identity.Name)
+		}
+		if (identity.Subject == "") == (identity.TokenSHA256 == "") {
+			return nil, fmt.Errorf("%w: %q", errInvalidIdentity, identity.Name)
+		}
+
+		caller := Caller{Name: identity.Name, Role: identity.Role}
+		if identity.Subject != "" {
+			if _, ok := a.subjects[identity.Subject]; ok {
+				return nil, fmt.Errorf("%w: %q", errDuplicateKey, identity.Name)
+			}
+			a.subjects[identity.Subject] = caller
+
+			continue
+		}
+
+		digest, err := hex.DecodeString(identity.TokenSHA256)
+		if err != nil || len(digest) != sha256.Size {
+			return nil, fmt.Errorf("%w: %q", errInvalidToken, identity.Name)
+		}
+		key := string(digest)
+		if _, ok := a.tokens[key]; ok {
+			return nil, fmt.Errorf("%w: %q", errDuplicateKey, identity.Name)
+		}
+		a.tokens[key] = caller
+	}
+
+	return a, nil
+}
+
+// Authenticate returns the caller of a request. A bearer token takes precedence over the client certificate, and
+// an unknown token fails even if the certificate is known.
+func (a *Authenticator) Authenticate(ctx context.Context) (Caller, error) {
+	if token, ok := bearerToken(ctx); ok {
+		digest := sha256.Sum256([]byte(token))
+		if caller, ok := a.tokens[string(digest[:])]; ok {
+			return caller, nil
+		}
+
+		return Caller{}, ErrUnauthenticated
+	}
+
+	for _, subject := range certificateSubjects(ctx) {
+		if caller, ok := a.subjects[subject]; ok {
+			return caller, nil
+		}
+	}
+
+	return Caller{}, ErrUnauthenticated
+}
+
+// Returns the bearer token in the authorization header of a request.
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get(authorizationHeader) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):]), true
+		}
+	}
+
+	return "", false
+}
+
+// Returns the common name and DNS names of the verified client certificate of a request. Certificates that were not
+// verified against the client CAs are ignored.
+func certificateSubjects(ctx context.Context) []string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	leaf := tlsInfo.State.VerifiedChains[0][0]
+	subjects := []string{}
+	if leaf.Subject.CommonName != "" {
+		subjects = append(subjects, leaf.Subject.CommonName)
+	}
+
+	return append(subjects, leaf.DNSNames...)
+}
+
+type callerKey struct{}
+
+// WithCaller returns a context carrying the caller of a request.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the caller of a request, if it was authenticated.
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+
+	return caller, ok
+}
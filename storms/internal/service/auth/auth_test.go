@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func tokenDigest(token string) string {
+	digest := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(digest[:])
+}
+
+func Test_Role_Allows(t *testing.T) {
+	tests := []struct {
+		role     Role
+		required Role
+		expected bool
+	}{
+		{role: RoleReadOnly, required: RoleReadOnly, expected: true},
+		{role: RoleReadOnly, required: RoleOperator, expected: false},
+		{role: RoleOperator, required: RoleOperator, expected: true},
+		{role: RoleOperator, required: RoleAdmin, expected: false},
+		{role: RoleAdmin, required: RoleReadOnly, expected: true},
+		{role: RoleAdmin, required: RoleAdmin, expected: true},
+		{role: Role("unknown"), required: RoleReadOnly, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" "+string(tt.required), func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.role.Allows(tt.required))
+		})
+	}
+}
+
+func Test_NewAuthenticator(t *testing.T) {
+	tests := []struct {
+		name        string
+		identities  []Identity
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			identities: []Identity{
+				{Name: "sre", Subject: "sre", Role: RoleReadOnly},
+				{Name: "ci", TokenSHA256: tokenDigest("secret"), Role: RoleOperator},
+			},
+		},
+		{
+			name:        "invalid role",
+			identities:  []Identity{{Name: "sre", Subject: "sre", Role: "root"}},
+			expectedErr: errInvalidRole,
+		},
+		{
+			name:        "no subject or token",
+			identities:  []Identity{{Name: "sre", Role: RoleReadOnly}},
+			expectedErr: errInvalidIdentity,
+		},
+		{
+			name:        "subject and token",
+			identities:  []Identity{{Name: "sre", Subject: "sre", TokenSHA256: tokenDigest("a"), Role: RoleReadOnly}},
+			expectedErr: errInvalidIdentity,
+		},
+		{
+			name:        "token not a digest",
+			identities:  []Identity{{Name: "ci", TokenSHA256: "secret", Role: RoleOperator}},
+			expectedErr: errInvalidToken,
+		},
+		{
+			name: "duplicate subject",
+			identities: []Identity{
+				{Name: "sre", Subject: "sre", Role: RoleReadOnly},
+				{Name: "admin", Subject: "sre", Role: RoleAdmin},
+			},
+			expectedErr: errDuplicateKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAuthenticator(tt.identities)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// Returns a context of a request over TLS with the given client certificate, verified or not.
+func tlsContext(commonName string, dnsNames []string, verified bool) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}, DNSNames: dnsNames}
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	if verified {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func Test_Authenticate(t *testing.T) {
+	authn, err := NewAuthenticator([]Identity{
+		{Name: "sre", Subject: "sre.storms.internal", Role: RoleReadOnly},
+		{Name: "ci", TokenSHA256: tokenDigest("secret"), Role: RoleOperator},
+	})
+	require.NoError(t, err)
+
+	withToken := func(ctx context.Context, value string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", value))
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context //nolint:containedctx // the context under test
+		expected  Caller
+		expectErr bool
+	}{
+		{
+			name:     "bearer token",
+			ctx:      withToken(context.Background(), "Bearer secret"),
+			expected: Caller{Name: "ci", Role: RoleOperator},
+		},
+		{
+			name:     "bearer token lowercase scheme",
+			ctx:      withToken(context.Background(), "bearer secret"),
+			expected: Caller{Name: "ci", Role: RoleOperator},
+		},
+		{
+			name:      "unknown bearer token",
+			ctx:       withToken(context.Background(), "Bearer other"),
+			expectErr: true,
+		},
+		{
+			name:      "unknown bearer token with known certificate",
+			ctx:       withToken(tlsContext("sre.storms.internal", nil, true), "Bearer other"),
+			expectErr: true,
+		},
+		{
+			name:     "certificate common name",
+			ctx:      tlsContext("sre.storms.internal", nil, true),
+			expected: Caller{Name: "sre", Role: RoleReadOnly},
+		},
+		{
+			name:     "certificate DNS name",
+			ctx:      tlsContext("other", []string{"sre.storms.internal"}, true),
+			expected: Caller{Name: "sre", Role: RoleReadOnly},
+		},
+		{
+			name:      "unverified certificate",
+			ctx:       tlsContext("sre.storms.internal", nil, false),
+			expectErr: true,
+		},
+		{
+			name:      "unknown certificate",
+			ctx:       tlsContext("other", nil, true),
+			expectErr: true,
+		},
+		{
+			name:      "anonymous",
+			ctx:       context.Background(),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := authn.Authenticate(tt.ctx)
+			if tt.expectErr {
+				require.ErrorIs(t, err, ErrUnauthenticated)
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, caller)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	appconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/app/configs"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/auth"
+)
+
+// Returns the role a caller needs to call a method. Methods not listed need the admin role, so that new methods are
+// denied to read-only and operator callers until they are classified here.
+func requiredRole(fullMethod string) auth.Role {
+	switch fullMethod {
+	case storms.StorageManagementService_GetVolume_FullMethodName,
+		storms.StorageManagementService_GetVolumes_FullMethodName,
+		storms.StorageManagementService_GetSnapshot_FullMethodName,
+		storms.StorageManagementService_GetSnapshots_FullMethodName,
+		storms.StorageManagementService_GetTenantUsage_FullMethodName,
+		storms.StorageManagementService_GetOperation_FullMethodName,
+		storms.StorageManagementService_ListOperations_FullMethodName,
+		storms.StorageManagementService_WaitOperation_FullMethodName,
+		admin.AdminService_ShowClusters_FullMethodName,
+		grpc_reflection_v1.ServerReflection_ServerReflectionInfo_FullMethodName,
+		grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfo_FullMethodName:
+		return auth.RoleReadOnly
+	case storms.StorageManagementService_CreateVolume_FullMethodName,
+		storms.StorageManagementService_CloneVolume_FullMethodName,
+		storms.StorageManagementService_ResizeVolume_FullMethodName,
+		storms.StorageManagementService_DeleteVolume_FullMethodName,
+		storms.StorageManagementService_AttachVolume_FullMethodName,
+		storms.StorageManagementService_DetachVolume_FullMethodName,
+		storms.StorageManagementService_RevertVolumeToSnapshot_FullMethodName,
+		storms.StorageManagementService_CopyVolume_FullMethodName,
+		storms.StorageManagementService_UpdateVolumeLabels_FullMethodName,
+		storms.StorageManagementService_CreateSnapshot_FullMethodName,
+		storms.StorageManagementService_DeleteSnapshot_FullMethodName,
+		storms.StorageManagementService_CopySnapshot_FullMethodName,
+		storms.StorageManagementService_UpdateSnapshotLabels_FullMethodName,
+		storms.StorageManagementService_SyncResource_FullMethodName,
+		storms.StorageManagementService_SyncAllResources_FullMethodName,
+		storms.StorageManagementService_CancelOperation_FullMethodName:
+		return auth.RoleOperator
+	}
+
+	return auth.RoleAdmin
+}
+
+type authenticator interface {
+	Authenticate(ctx context.Context) (auth.Caller, error)
+}
+
+// Returns the authenticator of the callers configured in the app config, or nil if authorization is disabled.
+func newAuthenticator() (authenticator, error) {
+	cfg := appconfigs.Get()
+	if !cfg.AuthEnabled {
+		log.Warn().Msg("Authorization disabled, every caller may call every method")
+
+		return nil, nil //nolint:nilnil // no authenticator when authorization is disabled
+	}
+
+	identities := make([]auth.Identity, 0, len(cfg.AuthIdentities))
+	for _, identity := range cfg.AuthIdentities {
+		identities = append(identities, auth.Identity{
+			Name:        identity.Name,
+			Subject:     identity.Subject,
+			TokenSHA256: identity.TokenSHA256,
+			Role:        auth.Role(identity.Role),
+		})
+	}
+	authn, err := auth.NewAuthenticator(identities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+	if len(identities) == 0 {
+		log.Warn().Msg("Authorization enabled without identities, every caller will be denied")
+	}
+
+	return authn, nil
+}
+
+// Authenticates the caller of a request and checks that its role allows the method, adding the caller to the
+// context of the handler. A denied caller is still added to the returned context, so that the denial can be audited.
+func authorize(ctx context.Context, authn authenticator, fullMethod string) (context.Context, error) {
+	// Health checks are public, since load balancers and Kubernetes probes have no identity of their own.
+	if isHealthCheck(fullMethod) {
+		return ctx, nil
+	}
+	caller, err := authn.Authenticate(ctx)
+	if err != nil {
+		log.Warn().Str("grpc_method", fullMethod).Msg("unauthenticated request")
+
+		return ctx, err
+	}
+	ctx = auth.WithCaller(ctx, caller)
+	if !caller.Role.Allows(requiredRole(fullMethod)) {
+		log.Warn().Str("grpc_method", fullMethod).Str("caller", caller.Name).Str("role", string(caller.Role)).
+			Msg("permission denied")
+
+		return ctx, fmt.Errorf("%w: %s", auth.ErrPermissionDenied, fullMethod)
+	}
+
+	return ctx, nil
+}
+
+// Called with a unary request denied by authorization and why. The context carries the caller if it was
+// authenticated.
+type deniedFunc func(ctx context.Context, fullMethod string, req interface{}, err error)
+
+// Rejects unary requests from callers whose role does not allow the method, passing them to onDenied if set.
+func authorizationUnaryInterceptor(authn authenticator, onDenied deniedFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := authorize(ctx, authn, info.FullMethod)
+		if err != nil {
+			if onDenied != nil {
+				onDenied(ctx, info.FullMethod, req, err)
+			}
+
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Rejects streams from callers whose role does not allow the method.
+func authorizationStreamInterceptor(authn authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		if _, err := authorize(ss.Context(), authn, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
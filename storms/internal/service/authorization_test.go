@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/auth"
+)
+
+type mockAuthenticator struct {
+	caller auth.Caller
+	err    error
+}
+
+func (m *mockAuthenticator) Authenticate(context.Context) (auth.Caller, error) {
+	return m.caller, m.err
+}
+
+func Test_requiredRole(t *testing.T) {
+	// Every storage method is classified explicitly, rather than falling back to admin.
+	for _, method := range storms.StorageManagementService_ServiceDesc.Methods {
+		fullMethod := "/" + storms.StorageManagementService_ServiceDesc.ServiceName + "/" + method.MethodName
+		require.NotEqual(t, auth.RoleAdmin, requiredRole(fullMethod), fullMethod)
+	}
+
+	require.Equal(t, auth.RoleReadOnly, requiredRole(storms.StorageManagementService_GetVolume_FullMethodName))
+	require.Equal(t, auth.RoleOperator, requiredRole(storms.StorageManagementService_DeleteVolume_FullMethodName))
+	require.Equal(t, auth.RoleReadOnly, requiredRole(admin.AdminService_ShowClusters_FullMethodName))
+	require.Equal(t, auth.RoleAdmin, requiredRole(admin.AdminService_ReloadConfig_FullMethodName))
+	require.Equal(t, auth.RoleAdmin, requiredRole("/unknown.v1.Service/Method"))
+}
+
+func Test_authorizationUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name         string
+		authn        *mockAuthenticator
+		method       string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "read only reads",
+			authn:        &mockAuthenticator{caller: auth.Caller{Name: "sre", Role: auth.RoleReadOnly}},
+			method:       storms.StorageManagementService_GetVolume_FullMethodName,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "read only deletes",
+			authn:        &mockAuthenticator{caller: auth.Caller{Name: "sre", Role: auth.RoleReadOnly}},
+			method:       storms.StorageManagementService_DeleteVolume_FullMethodName,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "operator deletes",
+			authn:        &mockAuthenticator{caller: auth.Caller{Name: "ci", Role: auth.RoleOperator}},
+			method:       storms.StorageManagementService_DeleteVolume_FullMethodName,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "operator reloads config",
+			authn:        &mockAuthenticator{caller: auth.Caller{Name: "ci", Role: auth.RoleOperator}},
+			method:       admin.AdminService_ReloadConfig_FullMethodName,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "admin reloads config",
+			authn:        &mockAuthenticator{caller: auth.Caller{Name: "root", Role: auth.RoleAdmin}},
+			method:       admin.AdminService_ReloadConfig_FullMethodName,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "unauthenticated",
+			authn:        &mockAuthenticator{err: auth.ErrUnauthenticated},
+			method:       storms.StorageManagementService_GetVolume_FullMethodName,
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				called = true
+				caller, ok := auth.CallerFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, tt.authn.caller, caller)
+
+				return struct{}{}, nil
+			}
+
+			denied := false
+			onDenied := func(ctx context.Context, fullMethod string, _ interface{}, err error) {
+				denied = true
+				require.Equal(t, tt.method, fullMethod)
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				caller, ok := auth.CallerFromContext(ctx)
+				require.Equal(t, tt.authn.err == nil, ok)
+				require.Equal(t, tt.authn.caller, caller)
+			}
+
+			_, err := authorizationUnaryInterceptor(tt.authn, onDenied)(context.Background(), struct{}{},
+				&grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			require.Equal(t, tt.expectedCode, status.Code(err))
+			require.Equal(t, tt.expectedCode == codes.OK, called)
+			require.Equal(t, tt.expectedCode != codes.OK, denied)
+		})
+	}
+}
+
+func Test_authorize_Public(t *testing.T) {
+	authn := &mockAuthenticator{err: auth.ErrUnauthenticated}
+
+	for _, method := range []string{healthpb.Health_Check_FullMethodName, healthpb.Health_Watch_FullMethodName} {
+		ctx, err := authorize(context.Background(), authn, method)
+		require.NoError(t, err, method)
+		_, ok := auth.CallerFromContext(ctx)
+		require.False(t, ok)
+	}
+}
@@ -0,0 +1,209 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	errIncompleteKeyPair = errors.New("certificate and key files must be set together")
+	errClientCARequired  = errors.New("requiring client certificates needs a client CA file")
+	errNoCACertificates  = errors.New("no certificates found in client CA file")
+)
+
+// Config selects the certificate the server presents and the client certificates it accepts.
+type Config struct {
+	CertFile string
+	KeyFile  string
+	// CA bundle that client certificates are verified against. Client certificates are not requested if unset.
+	ClientCAFile string
+	// Reject clients that do not present a certificate signed by ClientCAFile.
+	RequireClientCert bool
+}
+
+// Enabled reports whether the server should serve TLS.
+func (c Config) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+func (c Config) validate() error {
+	if c.CertFile == "" || c.KeyFile == "" {
+		return errIncompleteKeyPair
+	}
+	if c.RequireClientCert && c.ClientCAFile == "" {
+		return errClientCARequired
+	}
+
+	return nil
+}
+
+// Identifies the contents of a file without reading it.
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// Default time between checks of the files for changes.
+const DefaultCheckInterval = 30 * time.Second
+
+// Reloader serves TLS with the certificate and client CAs of a Config, reloading them when their files change so that
+// certificates can be rotated without a restart. The files are checked in the background by Run, and handshakes only
+// read the config of the last successful load.
+type Reloader struct {
+	cfg Config
+
+	// TLS config served to clients, replaced as a whole on each successful load.
+	current atomic.Pointer[tls.Config]
+
+	// Serializes checks of the files, and guards the fields below.
+	mu sync.Mutex
+	// Versions of the files as of the last successful load.
+	versions map[string]fileVersion
+	// Error of the last failed reload, so that a file that stays broken is reported once.
+	lastErr string
+}
+
+// NewReloader loads the files of cfg, failing if they are missing or invalid.
+func NewReloader(cfg Config) (*Reloader, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid TLS config: %w", err)
+	}
+
+	r := &Reloader{cfg: cfg}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// TLSConfig returns a server TLS config picking up the latest certificate and client CAs on each handshake.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current.Load(), nil
+		},
+	}
+}
+
+// Run reloads the files whenever they change, checking them every interval until ctx is done.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		r.Reload()
+	}
+}
+
+// Reload loads the files if they changed since the last successful load. The last good certificate and client CAs
+// keep being served if the files cannot be loaded, such as while they are being rewritten.
+func (r *Reloader) Reload() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.changed() {
+		return
+	}
+	if err := r.load(); err != nil {
+		if err.Error() != r.lastErr {
+			log.Warn().Interface("err", err).Msg("failed to reload TLS certificates, serving previous ones")
+		}
+		r.lastErr = err.Error()
+
+		return
+	}
+	r.lastErr = ""
+	log.Info().Str("cert_file", r.cfg.CertFile).Msg("reloaded TLS certificates")
+}
+
+// Reports whether any file differs from its last loaded version. Files that cannot be read are left to load.
+func (r *Reloader) changed() bool {
+	for _, file := range r.files() {
+		version, err := statFile(file)
+		if err != nil || version != r.versions[file] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Loads the files, replacing the current certificate and client CAs only if all of them load.
+func (r *Reloader) load() error {
+	versions := map[string]fileVersion{}
+	for _, file := range r.files() {
+		version, err := statFile(file)
+		if err != nil {
+			return err
+		}
+		versions[file] = version
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("%w: %s", errNoCACertificates, r.cfg.ClientCAFile)
+		}
+	}
+
+	clientAuth := tls.NoClientCert
+	switch {
+	case r.cfg.RequireClientCert:
+		clientAuth = tls.RequireAndVerifyClientCert
+	case clientCAs != nil:
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+	r.current.Store(&tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   clientAuth,
+	})
+	r.versions = versions
+
+	return nil
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+
+	return files
+}
+
+func statFile(file string) (fileVersion, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return fileVersion{}, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	return fileVersion{modTime: info.ModTime(), size: info.Size()}, nil
+}
@@ -0,0 +1,328 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// A certificate and its key, signed by parent or self-signed if parent is nil.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCert(t *testing.T, serial int64, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "storms"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+// Writes the certificate and key as PEM files in dir, returning their paths.
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+
+	return certFile, keyFile
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+func Test_NewReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, 1, nil)
+	caFile, _ := ca.write(t, dir, "ca")
+	certFile, keyFile := newTestCert(t, 2, ca).write(t, dir, "server")
+	invalidFile := filepath.Join(dir, "invalid.crt")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("not a certificate"), 0o600))
+
+	tests := []struct {
+		name        string
+		cfg         Config
+		expectErr   bool
+		expectedErr error
+	}{
+		{name: "server certificate", cfg: Config{CertFile: certFile, KeyFile: keyFile}},
+		{
+			name: "client CA",
+			cfg:  Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile, RequireClientCert: true},
+		},
+		{
+			name:        "missing key",
+			cfg:         Config{CertFile: certFile},
+			expectErr:   true,
+			expectedErr: errIncompleteKeyPair,
+		},
+		{
+			name:        "client certificate required without CA",
+			cfg:         Config{CertFile: certFile, KeyFile: keyFile, RequireClientCert: true},
+			expectErr:   true,
+			expectedErr: errClientCARequired,
+		},
+		{
+			name:      "missing file",
+			cfg:       Config{CertFile: filepath.Join(dir, "missing.crt"), KeyFile: keyFile},
+			expectErr: true,
+		},
+		{
+			name:      "invalid key pair",
+			cfg:       Config{CertFile: invalidFile, KeyFile: keyFile},
+			expectErr: true,
+		},
+		{
+			name:        "invalid client CA",
+			cfg:         Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: invalidFile},
+			expectErr:   true,
+			expectedErr: errNoCACertificates,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReloader(tt.cfg)
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					require.ErrorIs(t, err, tt.expectedErr)
+				}
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_Reloader_Handshake(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, 1, nil)
+	caFile, _ := ca.write(t, dir, "ca")
+	certFile, keyFile := newTestCert(t, 2, ca).write(t, dir, "server")
+	clientCert := newTestCert(t, 3, ca)
+	untrustedCert := newTestCert(t, 4, newTestCert(t, 5, nil))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	tests := []struct {
+		name              string
+		clientCAFile      string
+		requireClientCert bool
+		clientCert        *testCert
+		expectErr         bool
+	}{
+		{name: "TLS", clientCert: nil},
+		{name: "TLS ignores client certificate", clientCert: untrustedCert},
+		{name: "optional client certificate given", clientCAFile: caFile, clientCert: clientCert},
+		{name: "optional client certificate missing", clientCAFile: caFile, clientCert: nil},
+		{
+			name:         "optional client certificate untrusted",
+			clientCAFile: caFile,
+			clientCert:   untrustedCert,
+			expectErr:    true,
+		},
+		{name: "mTLS", clientCAFile: caFile, requireClientCert: true, clientCert: clientCert},
+		{
+			name:              "mTLS client certificate missing",
+			clientCAFile:      caFile,
+			requireClientCert: true,
+			clientCert:        nil,
+			expectErr:         true,
+		},
+		{
+			name:              "mTLS client certificate untrusted",
+			clientCAFile:      caFile,
+			requireClientCert: true,
+			clientCert:        untrustedCert,
+			expectErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReloader(Config{
+				CertFile:          certFile,
+				KeyFile:           keyFile,
+				ClientCAFile:      tt.clientCAFile,
+				RequireClientCert: tt.requireClientCert,
+			})
+			require.NoError(t, err)
+
+			clientConfig := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: roots, ServerName: "127.0.0.1"}
+			if tt.clientCert != nil {
+				clientConfig.Certificates = []tls.Certificate{tt.clientCert.tlsCertificate()}
+			}
+
+			err = handshake(t, r.TLSConfig(), clientConfig)
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_Reloader_Reload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, 1, nil)
+	certFile, keyFile := newTestCert(t, 2, ca).write(t, dir, "server")
+
+	r, err := NewReloader(Config{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), servedSerial(t, r))
+
+	// Unchanged files are not reloaded.
+	r.Reload()
+	require.Equal(t, int64(2), servedSerial(t, r))
+
+	// A rotated certificate is served once its files are checked after they change, and not before.
+	newTestCert(t, 3, ca).write(t, dir, "server")
+	touch(t, certFile, keyFile)
+	require.Equal(t, int64(2), servedSerial(t, r))
+	r.Reload()
+	require.Equal(t, int64(3), servedSerial(t, r))
+
+	// A broken certificate keeps the previous one served.
+	require.NoError(t, os.WriteFile(certFile, []byte("not a certificate"), 0o600))
+	touch(t, certFile)
+	r.Reload()
+	require.Equal(t, int64(3), servedSerial(t, r))
+
+	// A missing file keeps the previous one served too.
+	require.NoError(t, os.Remove(keyFile))
+	r.Reload()
+	require.Equal(t, int64(3), servedSerial(t, r))
+}
+
+func Test_Reloader_Run(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, 1, nil)
+	certFile, keyFile := newTestCert(t, 2, ca).write(t, dir, "server")
+
+	r, err := NewReloader(Config{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Run(ctx, time.Millisecond)
+		close(done)
+	}()
+
+	newTestCert(t, 3, ca).write(t, dir, "server")
+	touch(t, certFile, keyFile)
+	require.Eventually(t, func() bool { return servedSerial(t, r) == 3 }, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+// Returns the serial number of the certificate the reloader serves next.
+func servedSerial(t *testing.T, r *Reloader) int64 {
+	t.Helper()
+
+	cfg, err := r.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	require.Len(t, cfg.Certificates, 1)
+	cert, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+
+	return cert.SerialNumber.Int64()
+}
+
+// Moves the modification time of files forward, so that a change is seen even within the timestamp resolution.
+func touch(t *testing.T, files ...string) {
+	t.Helper()
+
+	for _, file := range files {
+		info, err := os.Stat(file)
+		require.NoError(t, err)
+		modTime := info.ModTime().Add(time.Second)
+		require.NoError(t, os.Chtimes(file, modTime, modTime))
+	}
+}
+
+// Runs a TLS handshake between a server and client over an in-memory connection, returning the first error of
+// either side.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) error {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	clientErr := make(chan error, 1)
+	go func() {
+		client := tls.Client(clientConn, clientConfig)
+		err := client.Handshake()
+		// TLS 1.3 clients finish before the server verifies their certificate; read to see the server's verdict.
+		if err == nil {
+			_, err = client.Read(make([]byte, 1))
+		}
+		clientErr <- err
+		clientConn.Close()
+	}()
+
+	server := tls.Server(serverConn, serverConfig)
+	err := server.Handshake()
+	if err == nil {
+		_, err = server.Write([]byte{0})
+	}
+	serverConn.Close()
+	if err != nil {
+		return err
+	}
+
+	return <-clientErr
+}
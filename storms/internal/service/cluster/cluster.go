@@ -1,7 +1,10 @@
 package cluster
 
 import (
-	"github.com/rs/zerolog/log"
+	"fmt"
+	"reflect"
+	"time"
+
 	"gitlab.com/crusoeenergy/island/storage/storms/client"
 )
 
@@ -11,21 +14,84 @@ type Config struct {
 	ClusterID    string                 `yaml:"cluster_id"`
 	AffinityTags map[string]string      `yaml:"affinity_tags"`
 	VendorConfig map[string]interface{} `yaml:"vendor_config"` // This is for vendor-specific configuration.
+	// Relative share of new volumes placed on this cluster by the weighted allocation strategy.
+	// Defaults to DefaultWeight when unset; 0 stops new placements on the cluster.
+	Weight *uint `yaml:"weight"`
+}
+
+const DefaultWeight = 1
+
+// Equal reports whether two configs configure a cluster identically.
+func (c *Config) Equal(other *Config) bool {
+	return reflect.DeepEqual(c, other)
+}
+
+// AllocationWeight returns the configured weight of the cluster, or DefaultWeight if none is set.
+func (c *Config) AllocationWeight() uint {
+	if c.Weight == nil {
+		return DefaultWeight
+	}
+
+	return *c.Weight
 }
 
+type State string
+
+const (
+	// The client of the cluster is being created.
+	StateInitializing State = "initializing"
+	// The cluster has a client.
+	StateReady State = "ready"
+	// The client could not be created, and is retried in the background.
+	StateFailed State = "failed"
+	// The cluster was dropped from the configuration, and is drained before it is removed.
+	StateRemoving State = "removing"
+)
+
+// Cluster is a configured cluster and its client. Clusters are not modified once managed; a change of state
+// replaces the cluster in the manager.
 type Cluster struct {
 	Config *Config
+	// Nil unless the cluster is ready.
 	Client client.Client
+	// Unset on clusters created directly rather than by NewCluster or a Lifecycle, which are ready if and only if
+	// they have a client.
+	State State
+	// Error returned by the last failed attempt at creating the client.
+	LastError string
+	// Failed attempts at creating the client since the cluster was configured.
+	Attempts int
+	// Time of the next attempt at creating the client, while the cluster has failed.
+	NextRetry time.Time
 }
 
+// NewCluster creates a ready cluster, failing if its client cannot be created.
 func NewCluster(cfg *Config) (*Cluster, error) {
 	c, err := client.NewClient(cfg.Vendor, cfg.VendorConfig)
 	if err != nil {
-		log.Err(err).Str("cluster_id", cfg.ClusterID).Msg("failed to create new client for cluster")
+		return nil, fmt.Errorf("failed to create client for cluster %s: %w", cfg.ClusterID, err)
 	}
 
 	return &Cluster{
 		Config: cfg,
 		Client: c,
+		State:  StateReady,
 	}, nil
 }
+
+// CurrentState returns the state of the cluster.
+func (c *Cluster) CurrentState() State {
+	switch {
+	case c.State != "":
+		return c.State
+	case c.Client != nil:
+		return StateReady
+	default:
+		return StateFailed
+	}
+}
+
+// Ready reports whether requests may be sent to the cluster.
+func (c *Cluster) Ready() bool {
+	return c.Client != nil && c.CurrentState() == StateReady
+}
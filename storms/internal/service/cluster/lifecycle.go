@@ -0,0 +1,269 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Default backoff between attempts at creating the client of a failed cluster.
+	DefaultMinBackoff = 5 * time.Second
+	DefaultMaxBackoff = 5 * time.Minute
+	// Default longest time a removed cluster is drained for before it is dropped.
+	DefaultDrainTimeout = 10 * time.Minute
+)
+
+type manager interface {
+	Get(clusterID string) (*Cluster, error)
+	Set(clusterID string, c *Cluster) error
+	Remove(clusterID string) error
+}
+
+type LifecycleConfig struct {
+	// Time before the first retry of a failed cluster, doubled after every failed retry.
+	MinBackoff time.Duration
+	// Longest time between retries of a failed cluster.
+	MaxBackoff time.Duration
+	// Creates a ready cluster from its config. Defaults to NewCluster.
+	NewCluster func(cfg *Config) (*Cluster, error)
+	// Called after a failed cluster became ready in the background, if set.
+	OnReady func(clusterID string)
+	// Waits for the requests running on a removed cluster to finish, if set. ctx is done after DrainTimeout, or once
+	// the lifecycle is stopped.
+	Drain func(ctx context.Context, clusterID string)
+	// Longest time a removed cluster is drained for. Defaults to DefaultDrainTimeout.
+	DrainTimeout time.Duration
+}
+
+// Lifecycle adds clusters to and removes them from a manager, moving each through its states. Clusters whose
+// client cannot be created are managed as failed, and retried in the background with exponential backoff until they
+// are ready, removed or added again, or the lifecycle is stopped. Removed clusters are managed as removing while they
+// are drained in the background.
+type Lifecycle struct {
+	clusters manager
+	cfg      LifecycleConfig
+	// Serializes changes of state, so that a retry never replaces a cluster that was removed or added again.
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewLifecycle(clusters manager, cfg LifecycleConfig) *Lifecycle {
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = DefaultMinBackoff
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = max(DefaultMaxBackoff, cfg.MinBackoff)
+	}
+	if cfg.NewCluster == nil {
+		cfg.NewCluster = NewCluster
+	}
+	if cfg.DrainTimeout <= 0 {
+		cfg.DrainTimeout = DefaultDrainTimeout
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Lifecycle{
+		clusters: clusters,
+		cfg:      cfg,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Add manages the cluster of cfg and returns it as managed. A new cluster is initializing while its client is created,
+// then ready, or failed and retried in the background. A ready cluster is kept as is if its config is unchanged, and
+// otherwise keeps serving with its previous config until a client for the new one has been created, which replaces
+// it only on success. Any other cluster with the same ID is replaced.
+func (l *Lifecycle) Add(cfg *Config) *Cluster {
+	if current, err := l.clusters.Get(cfg.ClusterID); err == nil && current.Ready() {
+		return l.reconfigure(current, cfg)
+	}
+
+	l.set(&Cluster{Config: cfg, State: StateInitializing})
+
+	c, err := l.cfg.NewCluster(cfg)
+	if err == nil {
+		l.set(c)
+
+		return c
+	}
+
+	failed := &Cluster{
+		Config:    cfg,
+		State:     StateFailed,
+		LastError: err.Error(),
+		Attempts:  1,
+		NextRetry: time.Now().Add(l.cfg.MinBackoff),
+	}
+	log.Err(err).Str("cluster_id", cfg.ClusterID).Dur("retry_in", l.cfg.MinBackoff).
+		Msg("failed to initialize cluster")
+	l.set(failed)
+	l.wg.Add(1)
+	go l.retry(failed, l.cfg.MinBackoff)
+
+	return failed
+}
+
+// Replaces a ready cluster with one for cfg, unless cfg is unchanged or its client cannot be created.
+func (l *Lifecycle) reconfigure(current *Cluster, cfg *Config) *Cluster {
+	if current.Config.Equal(cfg) {
+		return current
+	}
+
+	c, err := l.cfg.NewCluster(cfg)
+	if err != nil {
+		log.Err(err).Str("cluster_id", cfg.ClusterID).Msg("failed to reconfigure cluster, keeping its previous config")
+
+		return current
+	}
+	if !l.replace(current, c) {
+		log.Warn().Str("cluster_id", cfg.ClusterID).Msg("cluster changed while being reconfigured")
+
+		return current
+	}
+	log.Info().Str("cluster_id", cfg.ClusterID).Msg("reconfigured cluster")
+
+	return c
+}
+
+// Remove marks a cluster as removing, so that requests are no longer routed to it, then stops managing it in the
+// background once it is drained. A cluster added again before then replaces it and is kept.
+func (l *Lifecycle) Remove(clusterID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c, err := l.clusters.Get(clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+	if c.State == StateRemoving {
+		return nil
+	}
+	removing := *c
+	removing.State = StateRemoving
+	removing.NextRetry = time.Time{}
+	err = l.clusters.Set(clusterID, &removing)
+	if err != nil {
+		return fmt.Errorf("failed to mark cluster as removing: %w", err)
+	}
+	log.Info().Str("cluster_id", clusterID).Msg("removing cluster")
+	l.wg.Add(1)
+	go l.drain(&removing)
+
+	return nil
+}
+
+// Stop stops retrying failed clusters and draining removed ones, and waits for both to finish. Clusters being drained
+// are dropped.
+func (l *Lifecycle) Stop() {
+	l.cancel()
+	l.wg.Wait()
+}
+
+// Retries creating a failed cluster with exponential backoff, while it is still the one managed.
+func (l *Lifecycle) retry(failed *Cluster, backoff time.Duration) {
+	defer l.wg.Done()
+
+	clusterID := failed.Config.ClusterID
+	for {
+		timer := time.NewTimer(time.Until(failed.NextRetry))
+		select {
+		case <-l.ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C:
+		}
+
+		next, err := l.cfg.NewCluster(failed.Config)
+		if err != nil {
+			backoff = min(2*backoff, l.cfg.MaxBackoff)
+			next = &Cluster{
+				Config:    failed.Config,
+				State:     StateFailed,
+				LastError: err.Error(),
+				Attempts:  failed.Attempts + 1,
+				NextRetry: time.Now().Add(backoff),
+			}
+		}
+		if !l.replace(failed, next) {
+			log.Info().Str("cluster_id", clusterID).Msg("stopped retrying cluster that was removed or reconfigured")
+
+			return
+		}
+		if err == nil {
+			log.Info().Str("cluster_id", clusterID).Int("failed_attempts", failed.Attempts).Msg("cluster is ready")
+			if l.cfg.OnReady != nil {
+				l.cfg.OnReady(clusterID)
+			}
+
+			return
+		}
+		log.Err(err).Str("cluster_id", clusterID).Int("attempts", next.Attempts).Dur("retry_in", backoff).
+			Msg("failed to initialize cluster")
+		failed = next
+	}
+}
+
+// Drains a removing cluster, then stops managing it if it is still the one managed.
+func (l *Lifecycle) drain(removing *Cluster) {
+	defer l.wg.Done()
+
+	clusterID := removing.Config.ClusterID
+	if l.cfg.Drain != nil {
+		ctx, cancel := context.WithTimeout(l.ctx, l.cfg.DrainTimeout)
+		l.cfg.Drain(ctx, clusterID)
+		cancel()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, err := l.clusters.Get(clusterID)
+	if err != nil || current != removing {
+		log.Info().Str("cluster_id", clusterID).Msg("kept cluster that was added again while being removed")
+
+		return
+	}
+	err = l.clusters.Remove(clusterID)
+	if err != nil {
+		log.Err(err).Str("cluster_id", clusterID).Msg("failed to remove cluster")
+
+		return
+	}
+	log.Info().Str("cluster_id", clusterID).Msg("removed cluster")
+}
+
+// Replaces old with c if old is still the managed cluster, and reports whether it was.
+func (l *Lifecycle) replace(old, c *Cluster) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, err := l.clusters.Get(old.Config.ClusterID)
+	if err != nil || current != old {
+		return false
+	}
+	err = l.clusters.Set(c.Config.ClusterID, c)
+	if err != nil {
+		log.Err(err).Str("cluster_id", c.Config.ClusterID).Msg("failed to update cluster")
+
+		return false
+	}
+
+	return true
+}
+
+func (l *Lifecycle) set(c *Cluster) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.clusters.Set(c.Config.ClusterID, c); err != nil {
+		log.Err(err).Str("cluster_id", c.Config.ClusterID).Msg("failed to add cluster to cluster manager")
+	}
+}
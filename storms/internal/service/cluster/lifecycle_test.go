@@ -0,0 +1,279 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errNoSuchHost = errors.New("no such host")
+
+// Fails to create a cluster until it has been asked failures times.
+type flakyClusters struct {
+	failures int32
+	calls    atomic.Int32
+}
+
+func (f *flakyClusters) newCluster(cfg *Config) (*Cluster, error) {
+	if f.calls.Add(1) <= f.failures {
+		return nil, errNoSuchHost
+	}
+
+	return &Cluster{Config: cfg, Client: client1, State: StateReady}, nil
+}
+
+// Records the states clusters are set to.
+type recordingManager struct {
+	*InMemoryManager
+	mu     sync.Mutex
+	states []State
+}
+
+func (m *recordingManager) Set(clusterID string, c *Cluster) error {
+	m.mu.Lock()
+	m.states = append(m.states, c.State)
+	m.mu.Unlock()
+
+	return m.InMemoryManager.Set(clusterID, c)
+}
+
+func Test_Lifecycle_Add(t *testing.T) {
+	tests := []struct {
+		name          string
+		failures      int32
+		expectStates  []State
+		expectReadies int
+	}{
+		{
+			name:         "ready",
+			expectStates: []State{StateInitializing, StateReady},
+		},
+		{
+			name:          "ready after retries",
+			failures:      3,
+			expectStates:  []State{StateInitializing, StateFailed, StateFailed, StateFailed, StateReady},
+			expectReadies: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flaky := &flakyClusters{failures: tt.failures}
+			manager := &recordingManager{InMemoryManager: NewInMemoryManager()}
+			var readies atomic.Int32
+			l := NewLifecycle(manager, LifecycleConfig{
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 2 * time.Millisecond,
+				NewCluster: flaky.newCluster,
+				OnReady:    func(string) { readies.Add(1) },
+			})
+			defer l.Stop()
+
+			added := l.Add(cluster1.Config)
+			if tt.failures > 0 {
+				require.Equal(t, StateFailed, added.State)
+				require.Equal(t, errNoSuchHost.Error(), added.LastError)
+				require.Equal(t, 1, added.Attempts)
+				require.False(t, added.Ready())
+			}
+			require.Eventually(t, func() bool {
+				c, err := manager.Get(clusterID1)
+
+				return err == nil && c.Ready()
+			}, time.Second, time.Millisecond)
+
+			manager.mu.Lock()
+			defer manager.mu.Unlock()
+			require.Equal(t, tt.expectStates, manager.states)
+			require.Equal(t, int32(tt.expectReadies), readies.Load())
+		})
+	}
+}
+
+func Test_Lifecycle_Remove(t *testing.T) {
+	flaky := &flakyClusters{failures: 1000}
+	manager := &recordingManager{InMemoryManager: NewInMemoryManager()}
+	l := NewLifecycle(manager, LifecycleConfig{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond,
+		NewCluster: flaky.newCluster,
+	})
+
+	l.Add(cluster1.Config)
+	require.Eventually(t, func() bool { return flaky.calls.Load() > 2 }, time.Second, time.Millisecond)
+	require.NoError(t, l.Remove(clusterID1))
+	require.Eventually(t, func() bool {
+		_, err := manager.Get(clusterID1)
+
+		return err != nil
+	}, time.Second, time.Millisecond)
+	require.Error(t, l.Remove(clusterID1))
+
+	// Retries of the removed cluster stop on their own, without waiting for Stop.
+	calls := flaky.calls.Load()
+	time.Sleep(20 * time.Millisecond)
+	require.LessOrEqual(t, flaky.calls.Load(), calls+1)
+	l.Stop()
+
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	require.Equal(t, StateRemoving, manager.states[len(manager.states)-1])
+}
+
+func Test_Lifecycle_RemoveDrains(t *testing.T) {
+	tests := []struct {
+		name         string
+		addAgain     bool
+		expectRemove bool
+	}{
+		{name: "removed once drained", expectRemove: true},
+		{name: "kept if added again", addAgain: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewInMemoryManager()
+			drained := make(chan struct{})
+			var drains atomic.Int32
+			l := NewLifecycle(manager, LifecycleConfig{
+				NewCluster: (&flakyClusters{}).newCluster,
+				Drain: func(ctx context.Context, _ string) {
+					drains.Add(1)
+					select {
+					case <-drained:
+					case <-ctx.Done():
+					}
+				},
+			})
+			defer l.Stop()
+
+			l.Add(cluster1.Config)
+			require.NoError(t, l.Remove(clusterID1))
+			// The cluster stays removing while it is drained, and removing it again does not drain it twice.
+			c, err := manager.Get(clusterID1)
+			require.NoError(t, err)
+			require.Equal(t, StateRemoving, c.State)
+			require.False(t, c.Ready())
+			require.NoError(t, l.Remove(clusterID1))
+			if tt.addAgain {
+				require.True(t, l.Add(cluster1.Config).Ready())
+			}
+			close(drained)
+
+			require.Eventually(t, func() bool {
+				_, err := manager.Get(clusterID1)
+
+				return (err != nil) == tt.expectRemove
+			}, time.Second, time.Millisecond)
+			l.Stop()
+			require.Equal(t, int32(1), drains.Load())
+			c, err = manager.Get(clusterID1)
+			if tt.expectRemove {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.True(t, c.Ready())
+			}
+		})
+	}
+}
+
+func Test_Lifecycle_DrainTimeout(t *testing.T) {
+	manager := NewInMemoryManager()
+	l := NewLifecycle(manager, LifecycleConfig{
+		NewCluster:   (&flakyClusters{}).newCluster,
+		Drain:        func(ctx context.Context, _ string) { <-ctx.Done() },
+		DrainTimeout: 10 * time.Millisecond,
+	})
+	defer l.Stop()
+
+	l.Add(cluster1.Config)
+	require.NoError(t, l.Remove(clusterID1))
+	// A drain that never finishes on its own is bounded by the drain timeout.
+	require.Eventually(t, func() bool {
+		_, err := manager.Get(clusterID1)
+
+		return err != nil
+	}, time.Second, time.Millisecond)
+}
+
+func Test_Lifecycle_AddAgain(t *testing.T) {
+	flaky := &flakyClusters{failures: 1}
+	manager := NewInMemoryManager()
+	var readies atomic.Int32
+	l := NewLifecycle(manager, LifecycleConfig{
+		MinBackoff: time.Hour,
+		NewCluster: flaky.newCluster,
+		OnReady:    func(string) { readies.Add(1) },
+	})
+
+	require.Equal(t, StateFailed, l.Add(cluster1.Config).State)
+	// Adding the cluster again, as on a reload, replaces the failed one rather than waiting for its retry.
+	require.True(t, l.Add(cluster1.Config).Ready())
+	l.Stop()
+
+	c, err := manager.Get(clusterID1)
+	require.NoError(t, err)
+	require.True(t, c.Ready())
+	require.Zero(t, readies.Load())
+}
+
+func Test_Lifecycle_Reconfigure(t *testing.T) {
+	var fail atomic.Bool
+	var calls atomic.Int32
+	manager := &recordingManager{InMemoryManager: NewInMemoryManager()}
+	l := NewLifecycle(manager, LifecycleConfig{
+		MinBackoff: time.Hour,
+		NewCluster: func(cfg *Config) (*Cluster, error) {
+			calls.Add(1)
+			if fail.Load() {
+				return nil, errNoSuchHost
+			}
+
+			return &Cluster{Config: cfg, Client: client1, State: StateReady}, nil
+		},
+	})
+	defer l.Stop()
+
+	added := l.Add(cluster1.Config)
+	require.True(t, added.Ready())
+
+	// An unchanged config keeps the ready cluster, without creating another client.
+	unchanged := *cluster1.Config
+	require.Same(t, added, l.Add(&unchanged))
+	require.Equal(t, int32(1), calls.Load())
+
+	// A changed config that fails keeps the cluster serving with its previous config.
+	changed := *cluster1.Config
+	changed.AffinityTags = map[string]string{"region": "us-west-1"}
+	fail.Store(true)
+	require.Same(t, added, l.Add(&changed))
+	c, err := manager.Get(clusterID1)
+	require.NoError(t, err)
+	require.Same(t, added, c)
+
+	// Once it succeeds, the new cluster replaces the old one without passing through another state.
+	fail.Store(false)
+	reconfigured := l.Add(&changed)
+	require.True(t, reconfigured.Ready())
+	require.Equal(t, &changed, reconfigured.Config)
+	c, err = manager.Get(clusterID1)
+	require.NoError(t, err)
+	require.Same(t, reconfigured, c)
+
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	require.Equal(t, []State{StateInitializing, StateReady, StateReady}, manager.states)
+}
+
+func Test_Cluster_CurrentState(t *testing.T) {
+	require.Equal(t, StateReady, cluster1.CurrentState())
+	require.True(t, cluster1.Ready())
+	require.Equal(t, StateFailed, (&Cluster{Config: cluster1.Config}).CurrentState())
+	require.False(t, (&Cluster{Config: cluster1.Config, Client: client1, State: StateRemoving}).Ready())
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/quota"
 	"gopkg.in/yaml.v2"
 )
 
@@ -27,3 +28,23 @@ func LoadClusterConfig(filename string) (*ClustersConfig, error) {
 
 	return &clustersConfig, nil
 }
+
+// Limits of each tenant. Tenants not listed in Tenants get Default.
+type QuotasConfig struct {
+	Default quota.Limits            `yaml:"default"`
+	Tenants map[string]quota.Limits `yaml:"tenants"`
+}
+
+func LoadQuotaConfig(filename string) (*QuotasConfig, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var quotasConfig QuotasConfig
+	if err := yaml.UnmarshalStrict(data, &quotasConfig); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	return &quotasConfig, nil
+}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/quota"
 )
 
 func Test_LoadClusterConfig_Invalid(t *testing.T) {
@@ -28,6 +31,7 @@ func Test_LoadClusterConfig_Valid(t *testing.T) {
 		},
 	)
 	require.NotNil(t, cfg.Clusters[0].VendorConfig)
+	require.Equal(t, uint(cluster.DefaultWeight), cfg.Clusters[0].AllocationWeight())
 
 	require.Equal(t, cfg.Clusters[1].Vendor, "lightbits")
 	require.Equal(t, cfg.Clusters[1].ClusterID, "eee4019a-3d0f-4fea-8142-a5a8d0a3c20a")
@@ -38,4 +42,20 @@ func Test_LoadClusterConfig_Valid(t *testing.T) {
 		},
 	)
 	require.NotNil(t, cfg.Clusters[1].VendorConfig)
+	require.Equal(t, uint(4), cfg.Clusters[1].AllocationWeight())
+}
+
+func Test_LoadQuotaConfig_Invalid(t *testing.T) {
+	_, err := LoadQuotaConfig("testdata/invalidQuotas.yaml")
+	require.Error(t, err)
+}
+
+func Test_LoadQuotaConfig_Valid(t *testing.T) {
+	cfg, err := LoadQuotaConfig("testdata/quotas.yaml")
+	require.NoError(t, err)
+
+	require.Equal(t, quota.Limits{MaxVolumes: 100, MaxSnapshots: 200}, cfg.Default)
+	require.Equal(t, map[string]quota.Limits{
+		"tenant-a": {MaxVolumes: 10, MaxProvisionedBytes: 10 << 40},
+	}, cfg.Tenants)
 }
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+const (
+	defaultWorkflowPollInterval = 5 * time.Second
+	// Longest a copy is watched after its request stopped waiting for it. A copy still running after that is left
+	// unmapped, along with its temporary snapshot.
+	copyWatchTimeout = 24 * time.Hour
+)
+
+var (
+	errCopyToSameCluster = status.Error(codes.InvalidArgument, "resource is already on the destination cluster")
+	errCopyFailed        = status.Error(codes.Aborted, "copy failed")
+	errCopyInProgress    = status.Error(codes.Unavailable, "copy is still running")
+)
+
+// Copies are run by the backend: the source snapshot is thick cloned to the destination cluster by a workflow, which
+// is polled until it completes. Volumes are copied through a temporary snapshot, deleted once the copy completes.
+// If the request stops waiting first, the workflow is watched in the background until it completes, and only then is
+// the copy mapped and its temporary snapshot deleted. The UUID of the copy is refused until then.
+func (s *Service) CopyVolume(ctx context.Context, req *storms.CopyVolumeRequest,
+) (*storms.CopyVolumeResponse, error) {
+	release := s.createLocks.Lock(req.GetUuid())
+	defer func() {
+		// Once the mutation starts, it releases the lock when it completes.
+		if release != nil {
+			release()
+		}
+	}()
+
+	srcClusterID, src, dst, err := s.copyClusters(ctx, req.GetUuid(), req.GetSrcVolumeUuid(), req.GetDstClusterId(),
+		resource.TypeVolume)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copies are owned by the tenant of the source.
+	owner, _ := s.resourceManager.GetOwnership(req.GetSrcVolumeUuid())
+	err = s.requireTenant(owner.TenantID, errSourceWithoutTenant)
+	if err != nil {
+		return nil, err
+	}
+	releaseQuota, err := s.reserveQuota(owner.TenantID, resource.Usage{Volumes: 1, ProvisionedBytes: owner.SizeBytes})
+	if err != nil {
+		return nil, err
+	}
+	// Both clusters are used until the copy completes, so that neither is dropped while it runs.
+	releaseCopy := releaseBoth(releaseQuota, s.clusterUsage.Acquire(srcClusterID, req.GetDstClusterId()))
+	releaseLock := release
+	release = releaseBoth(releaseLock, releaseCopy)
+
+	// Set if the copy is left to a background watcher, which then releases the quota and clusters once the copy
+	// completes.
+	var watched bool
+	m := mutation{
+		async:        req.GetAsync(),
+		method:       "CopyVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   req.GetUuid(),
+		run: func(ctx context.Context) error {
+			tmpSnapshotID := uuid.NewString()
+			_, err := s.clientTranslator.CreateSnapshot(ctx, src.Client, &storms.CreateSnapshotRequest{
+				Uuid:          tmpSnapshotID,
+				SrcVolumeUuid: req.GetSrcVolumeUuid(),
+			})
+			if err != nil {
+				return clientError(src, fmt.Errorf("failed to create snapshot to copy: %w", err))
+			}
+
+			workflowID, err := s.clientTranslator.CopySnapshotToVolume(ctx, src.Client, dst.Client, tmpSnapshotID,
+				req.GetUuid())
+			if err != nil {
+				s.deleteTemporarySnapshot(ctx, src, tmpSnapshotID)
+
+				return clientError(src, fmt.Errorf("failed to start copy: %w", err))
+			}
+			watched, err = s.awaitCopy(ctx, src, req.GetUuid(), workflowID, releaseCopy, func(copyErr error) error {
+				s.deleteTemporarySnapshot(ctx, src, tmpSnapshotID)
+				if copyErr != nil {
+					return copyErr
+				}
+				s.mapCopy(req.GetUuid(), req.GetDstClusterId(), resource.TypeVolume)
+				s.recordOwnership(req.GetUuid(), owner.TenantID, owner.SizeBytes)
+				log.Info().Str("cluster_id", req.GetDstClusterId()).Str("src_cluster_id", srcClusterID).
+					Str("resource_id", req.GetUuid()).Str("src_resource_id", req.GetSrcVolumeUuid()).
+					Msg("copied volume")
+
+				return nil
+			})
+
+			return err
+		},
+		result: s.volumeResult(req.GetUuid()),
+	}
+
+	m.release, release = func() {
+		releaseLock()
+		if !watched {
+			releaseCopy()
+		}
+	}, nil
+	opID, err := s.runMutation(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	if m.async {
+		return &storms.CopyVolumeResponse{OperationId: opID}, nil
+	}
+	volume, _ := m.result(ctx).(*storms.Volume)
+
+	return &storms.CopyVolumeResponse{
+		Volume:    volume,
+		ClusterId: req.GetDstClusterId(),
+	}, nil
+}
+
+func (s *Service) CopySnapshot(ctx context.Context, req *storms.CopySnapshotRequest,
+) (*storms.CopySnapshotResponse, error) {
+	release := s.createLocks.Lock(req.GetUuid())
+	defer func() {
+		// Once the mutation starts, it releases the lock when it completes.
+		if release != nil {
+			release()
+		}
+	}()
+
+	srcClusterID, src, dst, err := s.copyClusters(ctx, req.GetUuid(), req.GetSrcSnapshotUuid(),
+		req.GetDstClusterId(), resource.TypeSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, _ := s.resourceManager.GetOwnership(req.GetSrcSnapshotUuid())
+	err = s.requireTenant(owner.TenantID, errSourceWithoutTenant)
+	if err != nil {
+		return nil, err
+	}
+	releaseQuota, err := s.reserveQuota(owner.TenantID, resource.Usage{Snapshots: 1})
+	if err != nil {
+		return nil, err
+	}
+	// Both clusters are used until the copy completes, so that neither is dropped while it runs.
+	releaseCopy := releaseBoth(releaseQuota, s.clusterUsage.Acquire(srcClusterID, req.GetDstClusterId()))
+	releaseLock := release
+	release = releaseBoth(releaseLock, releaseCopy)
+
+	// Set if the copy is left to a background watcher, which then releases the quota and clusters once the copy
+	// completes.
+	var watched bool
+	m := mutation{
+		async:        req.GetAsync(),
+		method:       "CopySnapshot",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_SNAPSHOT,
+		resourceID:   req.GetUuid(),
+		run: func(ctx context.Context) error {
+			workflowID, err := s.clientTranslator.CopySnapshotToSnapshot(ctx, src.Client, dst.Client,
+				req.GetSrcSnapshotUuid(), req.GetUuid())
+			if err != nil {
+				return clientError(src, fmt.Errorf("failed to start copy: %w", err))
+			}
+			watched, err = s.awaitCopy(ctx, src, req.GetUuid(), workflowID, releaseCopy, func(copyErr error) error {
+				if copyErr != nil {
+					return copyErr
+				}
+				s.mapCopy(req.GetUuid(), req.GetDstClusterId(), resource.TypeSnapshot)
+				s.recordOwnership(req.GetUuid(), owner.TenantID, 0)
+				log.Info().Str("cluster_id", req.GetDstClusterId()).Str("src_cluster_id", srcClusterID).
+					Str("resource_id", req.GetUuid()).Str("src_resource_id", req.GetSrcSnapshotUuid()).
+					Msg("copied snapshot")
+
+				return nil
+			})
+
+			return err
+		},
+		result: s.snapshotResult(req.GetUuid()),
+	}
+
+	m.release, release = func() {
+		releaseLock()
+		if !watched {
+			releaseCopy()
+		}
+	}, nil
+	opID, err := s.runMutation(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	if m.async {
+		return &storms.CopySnapshotResponse{OperationId: opID}, nil
+	}
+	snapshot, _ := m.result(ctx).(*storms.Snapshot)
+
+	return &storms.CopySnapshotResponse{
+		Snapshot:  snapshot,
+		ClusterId: req.GetDstClusterId(),
+	}, nil
+}
+
+// Returns the source cluster ID, and the source and destination clusters of a copy, after checking that the copy's
+// UUID is free and that the clusters differ. The UUID is free if it is neither mapped, nor held by a copy still
+// running, nor found on the destination cluster.
+func (s *Service) copyClusters(ctx context.Context, id, srcID, dstClusterID string, resourceType resource.Type,
+) (string, *cluster.Cluster, *cluster.Cluster, error) {
+	if clusterID, err := s.resourceManager.GetResourceCluster(id); err == nil {
+		return "", nil, nil, fmt.Errorf("%w: %s on cluster %s", errResourceConflict, id, clusterID)
+	}
+	if _, running := s.runningCopies.Load(id); running {
+		return "", nil, nil, fmt.Errorf("%w: %s", errCopyInProgress, id)
+	}
+
+	srcClusterID, src, err := s.getClientForResource(srcID)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to get client for resource: %w", err)
+	}
+	if srcClusterID == dstClusterID {
+		return "", nil, nil, errCopyToSameCluster
+	}
+	dst, err := s.getRoutableCluster(dstClusterID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	err = s.checkCopyDestination(ctx, dst, id, resourceType)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return srcClusterID, src, dst, nil
+}
+
+// Fails if a resource with the UUID of a copy already exists on the destination cluster, such as a copy that
+// completed while nothing was watching it.
+func (s *Service) checkCopyDestination(ctx context.Context, dst *cluster.Cluster, id string,
+	resourceType resource.Type,
+) error {
+	var err error
+	switch resourceType {
+	case resource.TypeVolume:
+		_, err = s.clientTranslator.GetVolume(ctx, dst.Client, &storms.GetVolumeRequest{Uuid: id})
+	case resource.TypeSnapshot:
+		_, err = s.clientTranslator.GetSnapshot(ctx, dst.Client, &storms.GetSnapshotRequest{Uuid: id})
+	}
+	switch {
+	case err == nil:
+		return fmt.Errorf("%w: %s on cluster %s", errResourceConflict, id, dst.Config.ClusterID)
+	case errors.Is(err, models.ErrNotFound):
+		return nil
+	}
+
+	return clientError(dst, fmt.Errorf("failed to look up resource on destination cluster: %w", err))
+}
+
+// Waits for the workflow of a copy, then calls finish with its outcome and returns what finish returns. If the wait
+// ends before the workflow does, the copy is left to a background watcher, which calls finish and then release once
+// the workflow completes, and true is returned along with why the wait ended.
+func (s *Service) awaitCopy(ctx context.Context, src *cluster.Cluster, id, workflowID string, release func(),
+	finish func(err error) error,
+) (bool, error) {
+	err := s.waitForWorkflow(ctx, src, workflowID)
+	if err == nil || errors.Is(err, errCopyFailed) {
+		return false, finish(err)
+	}
+
+	log.Warn().Str("resource_id", id).Str("workflow_id", workflowID).Err(err).
+		Msg("stopped waiting for copy, watching it in the background")
+	s.runningCopies.Store(id, workflowID)
+	go func() {
+		defer release()
+		defer s.runningCopies.Delete(id)
+		s.watchCopy(ctx, src, id, workflowID, finish)
+	}()
+
+	return true, fmt.Errorf("%w: %w", errCopyInProgress, err)
+}
+
+// Polls the workflow of a copy until it completes, then calls finish. Failures to poll are retried until
+// copyWatchTimeout, after which the copy is abandoned.
+func (s *Service) watchCopy(ctx context.Context, src *cluster.Cluster, id, workflowID string,
+	finish func(err error) error,
+) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), copyWatchTimeout)
+	defer cancel()
+
+	for {
+		err := s.waitForWorkflow(ctx, src, workflowID)
+		if err == nil || errors.Is(err, errCopyFailed) {
+			if finishErr := finish(err); finishErr != nil {
+				log.Warn().Str("resource_id", id).Str("workflow_id", workflowID).Err(finishErr).Msg("copy failed")
+			}
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Error().Str("resource_id", id).Str("workflow_id", workflowID).Err(err).
+				Msg("stopped watching copy, leaving it unmapped")
+
+			return
+		case <-time.After(s.pollInterval()):
+			log.Warn().Str("resource_id", id).Str("workflow_id", workflowID).Err(err).
+				Msg("failed to poll copy, retrying")
+		}
+	}
+}
+
+// Polls a workflow started on c until it completes or ctx is done.
+func (s *Service) waitForWorkflow(ctx context.Context, c *cluster.Cluster, workflowID string) error {
+	ticker := time.NewTicker(s.pollInterval())
+	defer ticker.Stop()
+
+	for {
+		workflow, err := s.clientTranslator.GetWorkflow(ctx, c.Client, workflowID)
+		if err != nil {
+			return clientError(c, fmt.Errorf("failed to get workflow %s: %w", workflowID, err))
+		}
+		switch workflow.State {
+		case models.WorkflowStateSucceeded:
+			return nil
+		case models.WorkflowStateFailed, models.WorkflowStateCancelled:
+			return fmt.Errorf("%w: workflow %s is %s: %s", errCopyFailed, workflowID, workflow.State, workflow.Message)
+		case models.WorkflowStateRunning:
+		}
+
+		select {
+		case <-ctx.Done():
+			// The backend keeps running the workflow; only the wait is abandoned.
+			return fmt.Errorf("stopped waiting for workflow %s: %w", workflowID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) pollInterval() time.Duration {
+	if s.workflowPollInterval <= 0 {
+		return defaultWorkflowPollInterval
+	}
+
+	return s.workflowPollInterval
+}
+
+func (s *Service) mapCopy(id, clusterID string, resourceType resource.Type) {
+	err := s.resourceManager.Map(&resource.Resource{ID: id, ClusterID: clusterID, ResourceType: resourceType})
+	if err != nil {
+		log.Warn().Str("resource_id", id).Interface("err", err).Msg("failed to map resource")
+	}
+}
+
+// Deletes a snapshot created for a copy, even if ctx is cancelled. Failures are logged, leaving the snapshot behind.
+func (s *Service) deleteTemporarySnapshot(ctx context.Context, c *cluster.Cluster, snapshotID string) {
+	_, err := s.clientTranslator.DeleteSnapshot(context.WithoutCancel(ctx), c.Client,
+		&storms.DeleteSnapshotRequest{Uuid: snapshotID})
+	if err != nil {
+		log.Warn().Str("resource_id", snapshotID).Err(err).Msg("failed to delete temporary snapshot")
+	}
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/operation"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+)
+
+// Runs copies against a translator whose workflows report the given states in turn, recording the snapshots created
+// and deleted on the source cluster. Resources are found on clusters if they are mapped or unmapped.
+type copyFixture struct {
+	mu       sync.Mutex
+	mappings map[string]string
+	unmapped map[string]bool
+	states   []models.WorkflowState
+	created  []string
+	deleted  []string
+	copied   string
+	// Called on every poll of a workflow, if set.
+	onPoll func()
+}
+
+func (f *copyFixture) exists(id string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, mapped := f.mappings[id]
+
+	return mapped || f.unmapped[id]
+}
+
+func (f *copyFixture) snapshots() ([]string, []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string{}, f.created...), append([]string{}, f.deleted...)
+}
+
+func (f *copyFixture) mapping(id string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.mappings[id]
+}
+
+func (f *copyFixture) service() *Service {
+	clusters := map[string]*cluster.Cluster{clusterID1: mockCluster1, clusterID2: mockCluster2}
+
+	return &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockGet: func(clusterID string) (*cluster.Cluster, error) {
+				return clusters[clusterID], nil
+			},
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockGetResourceCluster: func(resourceID string) (string, error) {
+				f.mu.Lock()
+				defer f.mu.Unlock()
+				clusterID, ok := f.mappings[resourceID]
+				if !ok {
+					return "", errUnmapped
+				}
+
+				return clusterID, nil
+			},
+			MockMap: func(r *resource.Resource) error {
+				f.mu.Lock()
+				defer f.mu.Unlock()
+				f.mappings[r.ID] = r.ClusterID
+
+				return nil
+			},
+		},
+		clientTranslator: &translatormocks.MockClientTranslator{
+			MockCreateSnapshot: func(_ context.Context, _ client.Client, req *storms.CreateSnapshotRequest,
+			) (*storms.CreateSnapshotResponse, error) {
+				f.mu.Lock()
+				defer f.mu.Unlock()
+				f.created = append(f.created, req.GetUuid())
+
+				return &storms.CreateSnapshotResponse{}, nil
+			},
+			MockDeleteSnapshot: func(_ context.Context, _ client.Client, req *storms.DeleteSnapshotRequest,
+			) (*storms.DeleteSnapshotResponse, error) {
+				f.mu.Lock()
+				defer f.mu.Unlock()
+				f.deleted = append(f.deleted, req.GetUuid())
+
+				return &storms.DeleteSnapshotResponse{}, nil
+			},
+			MockCopySnapshotToVolume: func(_ context.Context, _, _ client.Client, snapshotUUID, _ string,
+			) (string, error) {
+				f.copied = snapshotUUID
+
+				return "workflow", nil
+			},
+			MockCopySnapshotToSnapshot: func(_ context.Context, _, _ client.Client, snapshotUUID, _ string,
+			) (string, error) {
+				f.copied = snapshotUUID
+
+				return "workflow", nil
+			},
+			MockGetWorkflow: func(_ context.Context, _ client.Client, workflowID string) (*models.Workflow, error) {
+				if f.onPoll != nil {
+					f.onPoll()
+				}
+				f.mu.Lock()
+				defer f.mu.Unlock()
+				state := f.states[0]
+				if len(f.states) > 1 {
+					f.states = f.states[1:]
+				}
+
+				return &models.Workflow{ID: workflowID, State: state}, nil
+			},
+			MockGetVolume: func(_ context.Context, _ client.Client, req *storms.GetVolumeRequest,
+			) (*storms.GetVolumeResponse, error) {
+				if !f.exists(req.GetUuid()) {
+					return nil, models.ErrNotFound
+				}
+
+				return &storms.GetVolumeResponse{Volume: &storms.Volume{Uuid: req.GetUuid(), IsAvailable: true}}, nil
+			},
+			MockGetSnapshot: func(_ context.Context, _ client.Client, req *storms.GetSnapshotRequest,
+			) (*storms.GetSnapshotResponse, error) {
+				if !f.exists(req.GetUuid()) {
+					return nil, models.ErrNotFound
+				}
+
+				return &storms.GetSnapshotResponse{
+					Snapshot: &storms.Snapshot{Uuid: req.GetUuid(), IsAvailable: true},
+				}, nil
+			},
+		},
+		workflowPollInterval: time.Millisecond,
+	}
+}
+
+func Test_CopyVolume(t *testing.T) {
+	srcID := uuid.NewString()
+	dstID := uuid.NewString()
+
+	tests := []struct {
+		name          string
+		mappings      map[string]string
+		unmapped      map[string]bool
+		dstClusterID  string
+		states        []models.WorkflowState
+		expectCode    codes.Code
+		expectMapping string
+		expectDeleted bool
+	}{
+		{
+			name:     "copied",
+			mappings: map[string]string{srcID: clusterID1},
+			states: []models.WorkflowState{
+				models.WorkflowStateRunning, models.WorkflowStateRunning, models.WorkflowStateSucceeded,
+			},
+			dstClusterID:  clusterID2,
+			expectCode:    codes.OK,
+			expectMapping: clusterID2,
+			expectDeleted: true,
+		},
+		{
+			name:          "workflow failed",
+			mappings:      map[string]string{srcID: clusterID1},
+			states:        []models.WorkflowState{models.WorkflowStateRunning, models.WorkflowStateFailed},
+			dstClusterID:  clusterID2,
+			expectCode:    codes.Aborted,
+			expectDeleted: true,
+		},
+		{
+			name:         "same cluster",
+			mappings:     map[string]string{srcID: clusterID1},
+			dstClusterID: clusterID1,
+			expectCode:   codes.InvalidArgument,
+		},
+		{
+			name:          "uuid in use",
+			mappings:      map[string]string{srcID: clusterID1, dstID: clusterID1},
+			dstClusterID:  clusterID2,
+			expectCode:    codes.AlreadyExists,
+			expectMapping: clusterID1,
+		},
+		{
+			name:         "uuid on destination",
+			mappings:     map[string]string{srcID: clusterID1},
+			unmapped:     map[string]bool{dstID: true},
+			dstClusterID: clusterID2,
+			expectCode:   codes.AlreadyExists,
+		},
+		{
+			name:         "source not found",
+			mappings:     map[string]string{},
+			dstClusterID: clusterID2,
+			expectCode:   codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &copyFixture{mappings: tt.mappings, unmapped: tt.unmapped, states: tt.states}
+
+			resp, err := f.service().CopyVolume(context.Background(), &storms.CopyVolumeRequest{
+				Uuid:          dstID,
+				SrcVolumeUuid: srcID,
+				DstClusterId:  tt.dstClusterID,
+			})
+			require.Equal(t, tt.expectCode, status.Code(err))
+			require.Equal(t, tt.expectMapping, f.mappings[dstID])
+			if tt.expectDeleted {
+				// The volume is copied through a temporary snapshot, which is deleted whether or not the copy succeeds.
+				require.Len(t, f.created, 1)
+				require.Equal(t, f.created, f.deleted)
+				require.Equal(t, f.created[0], f.copied)
+			}
+			if err == nil {
+				require.Equal(t, dstID, resp.GetVolume().GetUuid())
+				require.Equal(t, clusterID2, resp.GetClusterId())
+			}
+		})
+	}
+}
+
+func Test_CopyVolume_Cancelled(t *testing.T) {
+	srcID := uuid.NewString()
+	dstID := uuid.NewString()
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &copyFixture{
+		mappings: map[string]string{srcID: clusterID1},
+		states: []models.WorkflowState{
+			models.WorkflowStateRunning, models.WorkflowStateRunning, models.WorkflowStateSucceeded,
+		},
+		// The request stops waiting while the workflow is running.
+		onPoll: cancel,
+	}
+	s := f.service()
+	req := &storms.CopyVolumeRequest{Uuid: dstID, SrcVolumeUuid: srcID, DstClusterId: clusterID2}
+
+	_, err := s.CopyVolume(ctx, req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.ErrorIs(t, err, context.Canceled)
+
+	// The copy completes in the background, and only then is the temporary snapshot deleted and the copy mapped.
+	require.Eventually(t, func() bool {
+		_, running := s.runningCopies.Load(dstID)
+
+		return !running
+	}, time.Second, time.Millisecond)
+	created, deleted := f.snapshots()
+	require.Len(t, created, 1)
+	require.Equal(t, created, deleted)
+	require.Equal(t, clusterID2, f.mapping(dstID))
+
+	// A retry finds the copy rather than starting another.
+	_, err = s.CopyVolume(context.Background(), req)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	created, _ = f.snapshots()
+	require.Len(t, created, 1)
+}
+
+func Test_CopyVolume_StillRunning(t *testing.T) {
+	srcID := uuid.NewString()
+	dstID := uuid.NewString()
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &copyFixture{
+		mappings: map[string]string{srcID: clusterID1},
+		states:   []models.WorkflowState{models.WorkflowStateRunning},
+		onPoll:   cancel,
+	}
+	s := f.service()
+	s.workflowPollInterval = time.Hour
+	req := &storms.CopyVolumeRequest{Uuid: dstID, SrcVolumeUuid: srcID, DstClusterId: clusterID2}
+
+	_, err := s.CopyVolume(ctx, req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Neither the source snapshot nor the UUID are released while the copy runs.
+	_, err = s.CopyVolume(context.Background(), req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	created, deleted := f.snapshots()
+	require.Len(t, created, 1)
+	require.Empty(t, deleted)
+	require.Empty(t, f.mapping(dstID))
+}
+
+func Test_CopySnapshot(t *testing.T) {
+	srcID := uuid.NewString()
+	dstID := uuid.NewString()
+	f := &copyFixture{
+		mappings: map[string]string{srcID: clusterID1},
+		states:   []models.WorkflowState{models.WorkflowStateRunning, models.WorkflowStateSucceeded},
+	}
+	s := f.service()
+	s.operations = operation.NewManager(0)
+	defer s.operations.Stop()
+
+	resp, err := s.CopySnapshot(context.Background(), &storms.CopySnapshotRequest{
+		Uuid:            dstID,
+		SrcSnapshotUuid: srcID,
+		DstClusterId:    clusterID2,
+		Async:           true,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetOperationId())
+
+	op, err := s.WaitOperation(context.Background(), &storms.WaitOperationRequest{Id: resp.GetOperationId()})
+	require.NoError(t, err)
+	require.Equal(t, storms.OperationState_OPERATION_STATE_SUCCEEDED, op.GetOperation().GetState())
+	require.Equal(t, dstID, op.GetOperation().GetSnapshot().GetUuid())
+	require.Equal(t, srcID, f.copied)
+	require.Empty(t, f.created)
+	require.Equal(t, clusterID2, f.mappings[dstID])
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Counts the mutations and copies running on each cluster, so that a cluster removed from the configuration is kept
+// as removing until they finish. The zero value is ready for use.
+type clusterUsage struct {
+	mu     sync.Mutex
+	counts map[string]int
+	// Closed and replaced whenever uses are released, waking up waits.
+	released chan struct{}
+}
+
+// Acquire counts a use of each of clusterIDs, until the returned function is called. The function may be called more
+// than once.
+func (u *clusterUsage) Acquire(clusterIDs ...string) func() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.counts == nil {
+		u.counts = make(map[string]int)
+		u.released = make(chan struct{})
+	}
+	for _, clusterID := range clusterIDs {
+		u.counts[clusterID]++
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			u.mu.Lock()
+			defer u.mu.Unlock()
+
+			for _, clusterID := range clusterIDs {
+				u.counts[clusterID]--
+				if u.counts[clusterID] == 0 {
+					delete(u.counts, clusterID)
+				}
+			}
+			close(u.released)
+			u.released = make(chan struct{})
+		})
+	}
+}
+
+// Count returns the number of uses of a cluster.
+func (u *clusterUsage) Count(clusterID string) int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.counts[clusterID]
+}
+
+// Wait blocks until a cluster is no longer used, or ctx is done.
+func (u *clusterUsage) Wait(ctx context.Context, clusterID string) error {
+	for {
+		u.mu.Lock()
+		count, released := u.counts[clusterID], u.released
+		u.mu.Unlock()
+		if count == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for cluster to drain: %w", ctx.Err())
+		case <-released:
+		}
+	}
+}
+
+// Waits for the mutations and copies running on a removed cluster to finish. Those still running once ctx is done
+// keep the client of the cluster, which is no longer managed.
+func (s *Service) drainCluster(ctx context.Context, clusterID string) {
+	log.Info().Str("cluster_id", clusterID).Int("running", s.clusterUsage.Count(clusterID)).
+		Msg("draining removed cluster")
+	err := s.clusterUsage.Wait(ctx, clusterID)
+	if err != nil {
+		log.Warn().Str("cluster_id", clusterID).Int("running", s.clusterUsage.Count(clusterID)).Err(err).
+			Msg("removing cluster with requests still running")
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+)
+
+const errorDomain = "storms"
+
+// Maps the typed errors returned by storage clients to the gRPC code and ErrorInfo reason reported to callers.
+var clientErrorCodes = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{err: models.ErrNotFound, code: codes.NotFound, reason: "RESOURCE_NOT_FOUND"},
+	{err: models.ErrAlreadyExists, code: codes.AlreadyExists, reason: "RESOURCE_ALREADY_EXISTS"},
+	{err: models.ErrInvalidArgument, code: codes.InvalidArgument, reason: "INVALID_ARGUMENT"},
+	{err: models.ErrFailedPrecondition, code: codes.FailedPrecondition, reason: "FAILED_PRECONDITION"},
+	{err: models.ErrResourceExhausted, code: codes.ResourceExhausted, reason: "RESOURCE_EXHAUSTED"},
+	{err: models.ErrUnavailable, code: codes.Unavailable, reason: "CLUSTER_UNAVAILABLE"},
+	{err: models.ErrUnauthenticated, code: codes.Unauthenticated, reason: "CLUSTER_UNAUTHENTICATED"},
+}
+
+// Converts an error returned while calling a cluster's client into a status error whose code matches the typed
+// client error it wraps, with an ErrorInfo detail naming the cluster and vendor. Errors that already carry a status,
+// or that wrap no typed client error, are returned unchanged.
+func clientError(c *cluster.Cluster, err error) error {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return err
+	}
+
+	for _, m := range clientErrorCodes {
+		if !errors.Is(err, m.err) {
+			continue
+		}
+
+		st := status.New(m.code, err.Error())
+		info := &errdetails.ErrorInfo{Reason: m.reason, Domain: errorDomain, Metadata: map[string]string{}}
+		if c != nil && c.Config != nil {
+			info.Metadata["cluster_id"] = c.Config.ClusterID
+			info.Metadata["vendor"] = c.Config.Vendor
+		}
+
+		if withDetails, detailsErr := st.WithDetails(info); detailsErr == nil {
+			st = withDetails
+		}
+
+		return &statusError{status: st, err: err}
+	}
+
+	return err
+}
+
+// A status error that still unwraps to the client error it was built from, so that callers can keep using errors.Is.
+type statusError struct {
+	status *status.Status
+	err    error
+}
+
+func (e *statusError) Error() string {
+	return e.status.Err().Error()
+}
+
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+)
+
+func Test_clientError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectCode   codes.Code
+		expectReason string
+	}{
+		{
+			name:         "not found",
+			err:          fmt.Errorf("failed to get volume: %w", models.ErrNotFound),
+			expectCode:   codes.NotFound,
+			expectReason: "RESOURCE_NOT_FOUND",
+		},
+		{
+			name:         "already exists",
+			err:          fmt.Errorf("failed to create volume: %w", models.ErrAlreadyExists),
+			expectCode:   codes.AlreadyExists,
+			expectReason: "RESOURCE_ALREADY_EXISTS",
+		},
+		{
+			name:         "invalid argument",
+			err:          fmt.Errorf("failed to create volume: %w", models.ErrInvalidArgument),
+			expectCode:   codes.InvalidArgument,
+			expectReason: "INVALID_ARGUMENT",
+		},
+		{
+			name:         "failed precondition",
+			err:          fmt.Errorf("failed to delete volume: %w", models.ErrFailedPrecondition),
+			expectCode:   codes.FailedPrecondition,
+			expectReason: "FAILED_PRECONDITION",
+		},
+		{
+			name:         "resource exhausted",
+			err:          fmt.Errorf("failed to resize volume: %w", models.ErrResourceExhausted),
+			expectCode:   codes.ResourceExhausted,
+			expectReason: "RESOURCE_EXHAUSTED",
+		},
+		{
+			name:         "unavailable",
+			err:          fmt.Errorf("failed to send request: %w", models.ErrUnavailable),
+			expectCode:   codes.Unavailable,
+			expectReason: "CLUSTER_UNAVAILABLE",
+		},
+		{
+			name:         "unauthenticated",
+			err:          fmt.Errorf("failed to get volume: %w", models.ErrUnauthenticated),
+			expectCode:   codes.Unauthenticated,
+			expectReason: "CLUSTER_UNAUTHENTICATED",
+		},
+		{
+			name:       "untyped",
+			err:        errors.New("boom"),
+			expectCode: codes.Unknown,
+		},
+		{
+			name:       "existing status is kept",
+			err:        fmt.Errorf("%w: %w", status.Error(codes.Aborted, "aborted"), models.ErrNotFound),
+			expectCode: codes.Aborted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := clientError(mockCluster1, tt.err)
+			require.Error(t, err)
+			require.ErrorIs(t, err, tt.err)
+
+			st := status.Convert(err)
+			require.Equal(t, tt.expectCode, st.Code())
+			require.Contains(t, st.Message(), tt.err.Error())
+			if tt.expectReason == "" {
+				require.Empty(t, st.Details())
+
+				return
+			}
+
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			require.Equal(t, tt.expectReason, info.GetReason())
+			require.Equal(t, errorDomain, info.GetDomain())
+			require.Equal(t, map[string]string{"cluster_id": clusterID1, "vendor": vendor1}, info.GetMetadata())
+		})
+	}
+}
+
+func Test_GetVolume_ClientErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		clusterErr error
+		clientErr  error
+		expectCode codes.Code
+	}{
+		{
+			name:       "unmapped resource",
+			clusterErr: status.Error(codes.NotFound, "unmapped resource"),
+			expectCode: codes.NotFound,
+		},
+		{
+			name:       "volume not found on cluster",
+			clientErr:  fmt.Errorf("failed to get volume: %w", models.ErrNotFound),
+			expectCode: codes.NotFound,
+		},
+		{
+			name:       "cluster unreachable",
+			clientErr:  fmt.Errorf("failed to get volume: %w", models.ErrUnavailable),
+			expectCode: codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet: func(clusterID string) (*cluster.Cluster, error) {
+						return mockCluster1, nil
+					},
+				},
+				resourceManager: &resourcemocks.MockResourceManager{
+					MockGetResourceCluster: func(resourceID string) (string, error) {
+						return clusterID1, tt.clusterErr
+					},
+				},
+				clientTranslator: &translatormocks.MockClientTranslator{
+					MockGetVolume: func(ctx context.Context, c client.Client, req *storms.GetVolumeRequest,
+					) (*storms.GetVolumeResponse, error) {
+						return nil, tt.clientErr
+					},
+				},
+			}
+
+			_, err := s.GetVolume(context.Background(), &storms.GetVolumeRequest{Uuid: uuid.NewString()})
+			require.Error(t, err)
+			require.Equal(t, tt.expectCode, status.Code(err))
+		})
+	}
+}
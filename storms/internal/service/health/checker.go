@@ -0,0 +1,224 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+)
+
+const (
+	DefaultInterval         = 30 * time.Second
+	DefaultTimeout          = 10 * time.Second
+	DefaultFailureThreshold = 3
+)
+
+var errNoClient = errors.New("cluster has no client")
+
+type State string
+
+const (
+	// The cluster has not been probed yet.
+	StateUnknown State = "unknown"
+	// The last probe succeeded.
+	StateHealthy State = "healthy"
+	// Recent probes failed, but fewer than the failure threshold in a row.
+	StateDegraded State = "degraded"
+	// At least the failure threshold of probes failed in a row, or the cluster has no client.
+	StateUnreachable State = "unreachable"
+)
+
+// Status is the health of a single cluster as of its last probe.
+type Status struct {
+	State               State
+	LastError           string
+	LastCheck           time.Time
+	LastSuccess         time.Time
+	ConsecutiveFailures int
+}
+
+// Allocatable reports whether new resources may be placed on the cluster. Clusters that have not been probed yet
+// are given the benefit of the doubt so that startup does not block allocation.
+func (s Status) Allocatable() bool {
+	return s.State == StateUnknown || s.State == StateHealthy
+}
+
+// Routable reports whether requests for existing resources should be sent to the cluster. Degraded clusters are
+// still routed to, since a single failed probe is often transient.
+func (s Status) Routable() bool {
+	return s.State != StateUnreachable
+}
+
+type clusterManager interface {
+	AllIDs() []string
+	Get(string) (*cluster.Cluster, error)
+}
+
+type Config struct {
+	// Time between probes of each cluster.
+	Interval time.Duration
+	// Time each probe is given to complete.
+	Timeout time.Duration
+	// Number of consecutive failed probes after which a cluster is considered unreachable.
+	FailureThreshold int
+}
+
+// Checker periodically probes every managed cluster through its client and tracks the result.
+type Checker struct {
+	clusterManager clusterManager
+	cfg            Config
+
+	mu       sync.RWMutex
+	statuses map[string]*Status
+}
+
+func NewChecker(cm clusterManager, cfg Config) *Checker {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = DefaultFailureThreshold
+	}
+
+	return &Checker{
+		clusterManager: cm,
+		cfg:            cfg,
+		statuses:       make(map[string]*Status),
+	}
+}
+
+// Run probes all clusters every interval until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll probes every managed cluster concurrently and forgets clusters that are no longer managed.
+func (c *Checker) CheckAll(ctx context.Context) {
+	clusterIDs := c.clusterManager.AllIDs()
+
+	wg := sync.WaitGroup{}
+	for _, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func(cid string) {
+			defer wg.Done()
+			c.check(ctx, cid)
+		}(clusterID)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	managed := make(map[string]struct{}, len(clusterIDs))
+	for _, id := range clusterIDs {
+		managed[id] = struct{}{}
+	}
+	for id := range c.statuses {
+		if _, ok := managed[id]; !ok {
+			delete(c.statuses, id)
+		}
+	}
+}
+
+// Status returns the health of a cluster. Clusters that have not been probed are reported as StateUnknown.
+func (c *Checker) Status(clusterID string) Status {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s, ok := c.statuses[clusterID]
+	if !ok {
+		return Status{State: StateUnknown}
+	}
+
+	return *s
+}
+
+// Reset forgets the health of a cluster and probes it again, for a cluster whose client was replaced. Failures of the
+// previous client, or its absence, are no longer counted against the cluster.
+func (c *Checker) Reset(ctx context.Context, clusterID string) {
+	c.mu.Lock()
+	delete(c.statuses, clusterID)
+	c.mu.Unlock()
+
+	c.check(ctx, clusterID)
+}
+
+func (c *Checker) check(ctx context.Context, clusterID string) {
+	err := c.probe(ctx, clusterID)
+	c.record(clusterID, err, time.Now())
+}
+
+func (c *Checker) probe(ctx context.Context, clusterID string) error {
+	cl, err := c.clusterManager.Get(clusterID)
+	if err != nil {
+		return err //nolint:wrapcheck // reported as-is in the cluster status
+	}
+	if cl.Client == nil {
+		return errNoClient
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	_, err = cl.Client.GetCapacity(probeCtx, &models.GetCapacityRequest{})
+
+	return err //nolint:wrapcheck // reported as-is in the cluster status
+}
+
+func (c *Checker) record(clusterID string, err error, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.statuses[clusterID]
+	if !ok {
+		s = &Status{State: StateUnknown}
+		c.statuses[clusterID] = s
+	}
+	prev := s.State
+	s.LastCheck = now
+
+	switch {
+	case err == nil:
+		s.State = StateHealthy
+		s.LastSuccess = now
+		s.ConsecutiveFailures = 0
+	case errors.Is(err, errNoClient):
+		s.State = StateUnreachable
+		s.LastError = err.Error()
+		s.ConsecutiveFailures++
+	default:
+		s.LastError = err.Error()
+		s.ConsecutiveFailures++
+		s.State = StateDegraded
+		if s.ConsecutiveFailures >= c.cfg.FailureThreshold {
+			s.State = StateUnreachable
+		}
+	}
+
+	if s.State != prev {
+		log.Info().
+			Str("cluster_id", clusterID).
+			Str("from", string(prev)).
+			Str("to", string(s.State)).
+			Str("last_error", s.LastError).
+			Msg("cluster health changed")
+	}
+}
@@ -0,0 +1,192 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clientmocks "gitlab.com/crusoeenergy/island/storage/storms/client/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+)
+
+const (
+	clusterID1 = "b22de56f-600f-4fa1-99ba-8f045e905f8e"
+	clusterID2 = "2ecbb15a-3a55-4085-856a-296565f34f40"
+)
+
+var errProbe = errors.New("connection refused")
+
+func Test_Checker_StateTransitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		probes   []error
+		expected State
+	}{
+		{name: "never probed", probes: nil, expected: StateUnknown},
+		{name: "healthy", probes: []error{nil}, expected: StateHealthy},
+		{name: "one failure", probes: []error{nil, errProbe}, expected: StateDegraded},
+		{name: "failures below threshold", probes: []error{errProbe, errProbe}, expected: StateDegraded},
+		{name: "failures reach threshold", probes: []error{errProbe, errProbe, errProbe}, expected: StateUnreachable},
+		{name: "recovers", probes: []error{errProbe, errProbe, errProbe, nil}, expected: StateHealthy},
+		{name: "failure count resets", probes: []error{errProbe, errProbe, nil, errProbe, errProbe}, expected: StateDegraded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := 0
+			client := &clientmocks.MockClient{
+				MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+					err := tt.probes[i]
+					i++
+
+					return &models.GetCapacityResponse{}, err
+				},
+			}
+			checker := NewChecker(newMockClusterManager(map[string]*cluster.Cluster{
+				clusterID1: {Config: &cluster.Config{ClusterID: clusterID1}, Client: client},
+			}), Config{FailureThreshold: 3})
+
+			for range tt.probes {
+				checker.CheckAll(context.Background())
+			}
+
+			status := checker.Status(clusterID1)
+			require.Equal(t, tt.expected, status.State)
+			if len(tt.probes) > 0 && tt.probes[len(tt.probes)-1] != nil {
+				require.Equal(t, errProbe.Error(), status.LastError)
+			}
+		})
+	}
+}
+
+func Test_Checker_LastSuccess(t *testing.T) {
+	fail := false
+	client := &clientmocks.MockClient{
+		MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			if fail {
+				return nil, errProbe
+			}
+
+			return &models.GetCapacityResponse{}, nil
+		},
+	}
+	checker := NewChecker(newMockClusterManager(map[string]*cluster.Cluster{
+		clusterID1: {Config: &cluster.Config{ClusterID: clusterID1}, Client: client},
+	}), Config{})
+
+	checker.CheckAll(context.Background())
+	success := checker.Status(clusterID1).LastSuccess
+	require.False(t, success.IsZero())
+
+	fail = true
+	checker.CheckAll(context.Background())
+	status := checker.Status(clusterID1)
+	require.Equal(t, success, status.LastSuccess)
+	require.True(t, status.LastCheck.After(success) || status.LastCheck.Equal(success))
+}
+
+func Test_Checker_NoClient(t *testing.T) {
+	checker := NewChecker(newMockClusterManager(map[string]*cluster.Cluster{
+		clusterID1: {Config: &cluster.Config{ClusterID: clusterID1}},
+	}), Config{})
+
+	checker.CheckAll(context.Background())
+	status := checker.Status(clusterID1)
+	require.Equal(t, StateUnreachable, status.State)
+	require.False(t, status.Routable())
+	require.False(t, status.Allocatable())
+}
+
+func Test_Checker_Reset(t *testing.T) {
+	clusters := map[string]*cluster.Cluster{
+		clusterID1: {Config: &cluster.Config{ClusterID: clusterID1}},
+	}
+	checker := NewChecker(newMockClusterManager(clusters), Config{FailureThreshold: 3})
+	checker.CheckAll(context.Background())
+	checker.CheckAll(context.Background())
+	require.Equal(t, StateUnreachable, checker.Status(clusterID1).State)
+
+	// The cluster gets a client that fails once: the failures without a client are not counted.
+	clusters[clusterID1] = &cluster.Cluster{
+		Config: &cluster.Config{ClusterID: clusterID1},
+		Client: &clientmocks.MockClient{
+			MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest,
+			) (*models.GetCapacityResponse, error) {
+				return nil, errProbe
+			},
+		},
+	}
+	checker.Reset(context.Background(), clusterID1)
+	status := checker.Status(clusterID1)
+	require.Equal(t, StateDegraded, status.State)
+	require.Equal(t, 1, status.ConsecutiveFailures)
+	require.True(t, status.Routable())
+}
+
+func Test_Checker_ForgetsRemovedClusters(t *testing.T) {
+	healthy := &clientmocks.MockClient{
+		MockGetCapacity: func(_ context.Context, _ *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+			return &models.GetCapacityResponse{}, nil
+		},
+	}
+	clusters := map[string]*cluster.Cluster{
+		clusterID1: {Config: &cluster.Config{ClusterID: clusterID1}, Client: healthy},
+		clusterID2: {Config: &cluster.Config{ClusterID: clusterID2}, Client: healthy},
+	}
+	checker := NewChecker(newMockClusterManager(clusters), Config{})
+
+	checker.CheckAll(context.Background())
+	require.Equal(t, StateHealthy, checker.Status(clusterID2).State)
+
+	delete(clusters, clusterID2)
+	checker.CheckAll(context.Background())
+	require.Equal(t, StateHealthy, checker.Status(clusterID1).State)
+	require.Equal(t, StateUnknown, checker.Status(clusterID2).State)
+}
+
+func Test_Status(t *testing.T) {
+	tests := []struct {
+		state       State
+		allocatable bool
+		routable    bool
+	}{
+		{state: StateUnknown, allocatable: true, routable: true},
+		{state: StateHealthy, allocatable: true, routable: true},
+		{state: StateDegraded, allocatable: false, routable: true},
+		{state: StateUnreachable, allocatable: false, routable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			s := Status{State: tt.state}
+			require.Equal(t, tt.allocatable, s.Allocatable())
+			require.Equal(t, tt.routable, s.Routable())
+		})
+	}
+}
+
+func newMockClusterManager(clusters map[string]*cluster.Cluster) *clustermocks.MockClusterManager {
+	return &clustermocks.MockClusterManager{
+		MockAllIDs: func() []string {
+			ids := []string{}
+			for id := range clusters {
+				ids = append(ids, id)
+			}
+
+			return ids
+		},
+		MockGet: func(clusterID string) (*cluster.Cluster, error) {
+			c, ok := clusters[clusterID]
+			if !ok {
+				return nil, fmt.Errorf("cluster ID '%s' not found", clusterID)
+			}
+
+			return c, nil
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health"
+)
+
+type MockHealthChecker struct {
+	MockRun    func(ctx context.Context)
+	MockStatus func(clusterID string) health.Status
+	MockReset  func(ctx context.Context, clusterID string)
+}
+
+func (m *MockHealthChecker) Run(ctx context.Context) {
+	m.MockRun(ctx)
+}
+
+func (m *MockHealthChecker) Status(clusterID string) health.Status {
+	return m.MockStatus(clusterID)
+}
+
+func (m *MockHealthChecker) Reset(ctx context.Context, clusterID string) {
+	m.MockReset(ctx, clusterID)
+}
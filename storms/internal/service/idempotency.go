@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+// Creates are keyed on the caller-supplied UUID: before creating a resource, the service looks for an existing
+// resource with the same UUID, first on the cluster it is mapped to and otherwise on every managed cluster. A retry
+// matching the existing resource succeeds without creating anything, while a conflicting request fails with
+// errResourceConflict.
+var errResourceConflict = status.Error(codes.AlreadyExists, "resource already exists with a different spec")
+
+// Serializes operations on the same resource ID, so that a retry cannot race the request it retries. Locks are
+// released from the map once no caller holds or waits on them.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*refMutex
+}
+
+type refMutex struct {
+	sync.Mutex
+	refs int
+}
+
+// Lock blocks until the lock for key is held and returns the function releasing it.
+func (k *keyedMutex) Lock(key string) func() {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = make(map[string]*refMutex)
+	}
+	m, ok := k.locks[key]
+	if !ok {
+		m = &refMutex{}
+		k.locks[key] = m
+	}
+	m.refs++
+	k.mu.Unlock()
+
+	m.Lock()
+
+	return func() {
+		m.Unlock()
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		m.refs--
+		if m.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
+
+// Returns the volume with the given UUID and the cluster holding it, or a nil volume if it exists on no cluster.
+func (s *Service) findExistingVolume(ctx context.Context, volID string) (*storms.Volume, string, error) {
+	return findExistingResource(ctx, s, volID, resource.TypeVolume,
+		func(ctx context.Context, c *cluster.Cluster) (*storms.Volume, error) {
+			resp, err := s.clientTranslator.GetVolume(ctx, c.Client, &storms.GetVolumeRequest{Uuid: volID})
+			if err != nil {
+				return nil, err //nolint:wrapcheck // wrapped by the caller
+			}
+
+			return resp.GetVolume(), nil
+		})
+}
+
+// Returns the snapshot with the given UUID and the cluster holding it, or a nil snapshot if it exists on no cluster.
+func (s *Service) findExistingSnapshot(ctx context.Context, snapshotID string) (*storms.Snapshot, string, error) {
+	return findExistingResource(ctx, s, snapshotID, resource.TypeSnapshot,
+		func(ctx context.Context, c *cluster.Cluster) (*storms.Snapshot, error) {
+			resp, err := s.clientTranslator.GetSnapshot(ctx, c.Client, &storms.GetSnapshotRequest{Uuid: snapshotID})
+			if err != nil {
+				return nil, err //nolint:wrapcheck // wrapped by the caller
+			}
+
+			return resp.GetSnapshot(), nil
+		})
+}
+
+// Looks up a resource on the cluster it is mapped to. A mapping to a cluster that no longer holds the resource is
+// dropped. Unmapped resources are searched for on every routable cluster, each under the list timeout, and mapped
+// when found, so that a resource created by a request that timed out before it was mapped is still detected.
+// Clusters that are not routable, or fail or time out, are skipped so that one bad cluster does not fail every
+// create; a duplicate on the cluster the resource is then created on is still refused by its vendor.
+func findExistingResource[T comparable](
+	ctx context.Context,
+	s *Service,
+	id string,
+	resourceType resource.Type,
+	get func(ctx context.Context, c *cluster.Cluster) (T, error),
+) (T, string, error) {
+	var zero T
+
+	if clusterID, err := s.resourceManager.GetResourceCluster(id); err == nil {
+		c, err := s.getRoutableCluster(clusterID)
+		if err != nil {
+			return zero, "", err
+		}
+
+		found, err := get(ctx, c)
+		switch {
+		case err == nil && found != zero:
+			return found, clusterID, nil
+		case err != nil && !errors.Is(err, models.ErrNotFound):
+			return zero, "", clientError(c, fmt.Errorf("failed to look up existing resource: %w", err))
+		}
+
+		log.Warn().Str("resource_id", id).Str("cluster_id", clusterID).Msg("mapped resource not found on cluster")
+		if err := s.resourceManager.Unmap(id); err != nil {
+			log.Warn().Str("resource_id", id).Interface("err", err).Msg("failed to unmap resource")
+		}
+
+		return zero, "", nil
+	}
+
+	timeout := s.listTimeout
+	if timeout == 0 {
+		timeout = defaultListTimeout
+	}
+
+	type result struct {
+		clusterID string
+		found     T
+	}
+	clusterIDs := s.clusterManager.AllIDs()
+	results := make(chan result, len(clusterIDs))
+	wg := sync.WaitGroup{}
+	for _, clusterID := range clusterIDs {
+		c, err := s.getRoutableCluster(clusterID)
+		if err != nil {
+			log.Debug().Str("resource_id", id).Str("cluster_id", clusterID).Err(err).
+				Msg("skipping cluster that is not routable in search for existing resource")
+
+			continue
+		}
+
+		wg.Add(1)
+		go func(clusterID string, c *cluster.Cluster) {
+			defer wg.Done()
+			clusterCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			found, err := get(clusterCtx, c)
+			if err != nil {
+				if !errors.Is(err, models.ErrNotFound) {
+					log.Warn().Str("resource_id", id).Str("cluster_id", clusterID).Err(err).
+						Msg("failed to check cluster for existing resource")
+				}
+
+				return
+			}
+			results <- result{clusterID: clusterID, found: found}
+		}(clusterID, c)
+	}
+	wg.Wait()
+	close(results)
+
+	var r *result
+	for res := range results {
+		if res.found != zero && r == nil {
+			r = &res
+		}
+	}
+	if r == nil {
+		return zero, "", nil
+	}
+
+	err := s.resourceManager.Map(&resource.Resource{ID: id, ClusterID: r.clusterID, ResourceType: resourceType})
+	if err != nil {
+		log.Warn().Str("resource_id", id).Interface("err", err).Msg("failed to map resource")
+	}
+	log.Info().Str("resource_id", id).Str("cluster_id", r.clusterID).Msg("discovered existing resource")
+
+	return r.found, r.clusterID, nil
+}
+
+// Reports whether an existing volume is what the create request would have produced.
+func (s *Service) volumeMatchesRequest(ctx context.Context, v *storms.Volume, req *storms.CreateVolumeRequest,
+) (bool, error) {
+	switch source := req.GetSource().(type) {
+	case *storms.CreateVolumeRequest_FromNew:
+		return v.GetSourceSnapshotUuid() == "" &&
+			v.GetSize() == source.FromNew.GetSize() &&
+			v.GetSectorSize() == source.FromNew.GetSectorSize(), nil
+	case *storms.CreateVolumeRequest_FromSnapshot:
+		snapshotID := source.FromSnapshot.GetSnapshotUuid()
+		// Not every vendor records the source of a volume; fall back to comparing against the snapshot.
+		if v.GetSourceSnapshotUuid() != "" {
+			return v.GetSourceSnapshotUuid() == snapshotID, nil
+		}
+		snapshot, err := s.GetSnapshot(ctx, &storms.GetSnapshotRequest{Uuid: snapshotID})
+		if err != nil {
+			return false, fmt.Errorf("failed to get source snapshot: %w", err)
+		}
+
+		return v.GetSize() == snapshot.GetSnapshot().GetSize() &&
+			v.GetSectorSize() == snapshot.GetSnapshot().GetSectorSize(), nil
+	}
+
+	return false, nil
+}
+
+// Reports whether an existing volume is what the clone request would have produced. Clones do not record their source,
+// so the existing volume must be on the cluster of the source volume and match its size and sector size.
+func (s *Service) volumeMatchesClone(ctx context.Context, c *cluster.Cluster, v *storms.Volume, sameCluster bool,
+	req *storms.CloneVolumeRequest,
+) (bool, error) {
+	if !sameCluster {
+		return false, nil
+	}
+	src, err := s.clientTranslator.GetVolume(ctx, c.Client, &storms.GetVolumeRequest{Uuid: req.GetSrcVolumeUuid()})
+	if err != nil {
+		return false, clientError(c, fmt.Errorf("failed to get source volume: %w", err))
+	}
+
+	return v.GetSize() == src.GetVolume().GetSize() && v.GetSectorSize() == src.GetVolume().GetSectorSize(), nil
+}
+
+func snapshotMatchesRequest(snapshot *storms.Snapshot, req *storms.CreateSnapshotRequest) bool {
+	return snapshot.GetSourceVolumeUuid() == req.GetSrcVolumeUuid()
+}
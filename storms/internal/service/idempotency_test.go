@@ -0,0 +1,467 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	alloc "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
+	allocatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+)
+
+// Backs the resource manager mock with a map, recording whether the create under test reached the translator.
+type idempotencyFixture struct {
+	mappings map[string]string
+	// Volumes and snapshots present on each cluster, keyed by cluster ID and then UUID.
+	volumes   map[string]map[string]*storms.Volume
+	snapshots map[string]map[string]*storms.Snapshot
+	getErr    error
+	// A managed cluster whose client failed to be created, if set.
+	notReady string
+	// A managed cluster that never answers, if set.
+	hung    string
+	created bool
+}
+
+func (f *idempotencyFixture) service() *Service {
+	clusters := map[string]*cluster.Cluster{clusterID1: mockCluster1, clusterID2: mockCluster2}
+
+	return &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockGet: func(clusterID string) (*cluster.Cluster, error) {
+				if clusterID == f.notReady {
+					return &cluster.Cluster{Config: clusters[clusterID].Config, State: cluster.StateFailed}, nil
+				}
+
+				return clusters[clusterID], nil
+			},
+			MockAllIDs: func() []string { return []string{clusterID1, clusterID2} },
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockGetResourceCluster: func(resourceID string) (string, error) {
+				clusterID, ok := f.mappings[resourceID]
+				if !ok {
+					return "", errUnmapped
+				}
+
+				return clusterID, nil
+			},
+			MockMap: func(r *resource.Resource) error {
+				f.mappings[r.ID] = r.ClusterID
+
+				return nil
+			},
+			MockUnmap: func(resourceID string) error {
+				delete(f.mappings, resourceID)
+
+				return nil
+			},
+		},
+		allocator: &allocatormocks.MockAllocator{
+			MockAllocateCluster: func(_ context.Context, _ *alloc.Request) (string, error) {
+				return clusterID1, nil
+			},
+		},
+		listTimeout: 10 * time.Millisecond,
+		clientTranslator: &translatormocks.MockClientTranslator{
+			MockGetVolume: func(ctx context.Context, c client.Client, req *storms.GetVolumeRequest,
+			) (*storms.GetVolumeResponse, error) {
+				if clusterIDOfClient(c) == f.hung {
+					<-ctx.Done()
+
+					return nil, ctx.Err()
+				}
+				if f.getErr != nil {
+					return nil, f.getErr
+				}
+				v, ok := f.volumes[clusterIDOfClient(c)][req.GetUuid()]
+				if !ok {
+					return nil, models.ErrNotFound
+				}
+
+				return &storms.GetVolumeResponse{Volume: v}, nil
+			},
+			MockGetSnapshot: func(_ context.Context, c client.Client, req *storms.GetSnapshotRequest,
+			) (*storms.GetSnapshotResponse, error) {
+				snapshot, ok := f.snapshots[clusterIDOfClient(c)][req.GetUuid()]
+				if !ok {
+					return nil, models.ErrNotFound
+				}
+
+				return &storms.GetSnapshotResponse{Snapshot: snapshot}, nil
+			},
+			MockCreateVolume: func(_ context.Context, _ client.Client, req *storms.CreateVolumeRequest,
+			) (*storms.CreateVolumeResponse, error) {
+				f.created = true
+
+				return &storms.CreateVolumeResponse{Volume: &storms.Volume{Uuid: req.GetUuid()}}, nil
+			},
+			MockCloneVolume: func(_ context.Context, _ client.Client, req *storms.CloneVolumeRequest,
+			) (*storms.CloneVolumeResponse, error) {
+				f.created = true
+
+				return &storms.CloneVolumeResponse{Volume: &storms.Volume{Uuid: req.GetUuid()}}, nil
+			},
+			MockCreateSnapshot: func(_ context.Context, _ client.Client, req *storms.CreateSnapshotRequest,
+			) (*storms.CreateSnapshotResponse, error) {
+				f.created = true
+
+				return &storms.CreateSnapshotResponse{Snapshot: &storms.Snapshot{Uuid: req.GetUuid()}}, nil
+			},
+		},
+	}
+}
+
+func clusterIDOfClient(c client.Client) string {
+	if c == mockClient2 {
+		return clusterID2
+	}
+
+	return clusterID1
+}
+
+func Test_CreateVolume_Idempotent(t *testing.T) {
+	volID := uuid.NewString()
+	snapshotID := uuid.NewString()
+	newVolume := &storms.Volume{
+		Uuid:       volID,
+		Size:       defaultOSDiskSizeBytes,
+		SectorSize: storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512,
+	}
+	fromNew := &storms.CreateVolumeRequest{
+		Uuid: volID,
+		Source: &storms.CreateVolumeRequest_FromNew{FromNew: &storms.NewVolumeSpec{
+			Size:       defaultOSDiskSizeBytes,
+			SectorSize: storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512,
+		}},
+	}
+	fromSnapshot := &storms.CreateVolumeRequest{
+		Uuid: volID,
+		Source: &storms.CreateVolumeRequest_FromSnapshot{
+			FromSnapshot: &storms.SnapshotSourceVolumeSpec{SnapshotUuid: snapshotID},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		fixture       *idempotencyFixture
+		req           *storms.CreateVolumeRequest
+		expectCode    codes.Code
+		expectCreated bool
+		expectMapping string
+	}{
+		{
+			name: "new volume",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{},
+			},
+			req:           fromNew,
+			expectCode:    codes.OK,
+			expectCreated: true,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "identical retry of mapped volume",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{volID: clusterID1},
+				volumes:  map[string]map[string]*storms.Volume{clusterID1: {volID: newVolume}},
+			},
+			req:           fromNew,
+			expectCode:    codes.OK,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "conflicting retry of mapped volume",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{volID: clusterID1},
+				volumes: map[string]map[string]*storms.Volume{clusterID1: {volID: {
+					Uuid:       volID,
+					Size:       2 * defaultOSDiskSizeBytes,
+					SectorSize: storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512,
+				}}},
+			},
+			req:           fromNew,
+			expectCode:    codes.AlreadyExists,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "identical retry of unmapped volume is discovered",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{},
+				volumes:  map[string]map[string]*storms.Volume{clusterID2: {volID: newVolume}},
+			},
+			req:           fromNew,
+			expectCode:    codes.OK,
+			expectMapping: clusterID2,
+		},
+		{
+			name: "stale mapping is replaced",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{volID: clusterID2},
+			},
+			req:           fromNew,
+			expectCode:    codes.OK,
+			expectCreated: true,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "mapped cluster cannot be queried",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{volID: clusterID1},
+				getErr:   models.ErrUnavailable,
+			},
+			req:           fromNew,
+			expectCode:    codes.Unavailable,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "new volume with clusters that cannot be queried",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{},
+				getErr:   models.ErrUnavailable,
+			},
+			req:           fromNew,
+			expectCode:    codes.OK,
+			expectCreated: true,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "new volume with a cluster that hangs",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{},
+				hung:     clusterID2,
+			},
+			req:           fromNew,
+			expectCode:    codes.OK,
+			expectCreated: true,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "new volume with a cluster that is down",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{},
+				notReady: clusterID2,
+			},
+			req:           fromNew,
+			expectCode:    codes.OK,
+			expectCreated: true,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "identical retry from snapshot",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{volID: clusterID1, snapshotID: clusterID1},
+				volumes: map[string]map[string]*storms.Volume{clusterID1: {volID: {
+					Uuid:               volID,
+					Size:               defaultOSDiskSizeBytes,
+					SourceSnapshotUuid: snapshotID,
+				}}},
+			},
+			req:           fromSnapshot,
+			expectCode:    codes.OK,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "identical retry from snapshot on vendor without volume sources",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{volID: clusterID1, snapshotID: clusterID1},
+				volumes:  map[string]map[string]*storms.Volume{clusterID1: {volID: newVolume}},
+				snapshots: map[string]map[string]*storms.Snapshot{clusterID1: {snapshotID: {
+					Uuid:       snapshotID,
+					Size:       defaultOSDiskSizeBytes,
+					SectorSize: storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512,
+				}}},
+			},
+			req:           fromSnapshot,
+			expectCode:    codes.OK,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "retry from snapshot conflicts with volume from another snapshot",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{volID: clusterID1, snapshotID: clusterID1},
+				volumes: map[string]map[string]*storms.Volume{clusterID1: {volID: {
+					Uuid:               volID,
+					Size:               defaultOSDiskSizeBytes,
+					SourceSnapshotUuid: uuid.NewString(),
+				}}},
+			},
+			req:           fromSnapshot,
+			expectCode:    codes.AlreadyExists,
+			expectMapping: clusterID1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.fixture.service().CreateVolume(context.Background(), tt.req)
+			require.Equal(t, tt.expectCode, status.Code(err))
+			require.Equal(t, tt.expectCreated, tt.fixture.created)
+			require.Equal(t, tt.expectMapping, tt.fixture.mappings[volID])
+			if err == nil {
+				require.Equal(t, volID, resp.GetVolume().GetUuid())
+				require.Equal(t, tt.expectMapping, resp.GetClusterId())
+			}
+		})
+	}
+}
+
+func Test_CloneVolume_Idempotent(t *testing.T) {
+	volID := uuid.NewString()
+	srcVolID := uuid.NewString()
+	srcVolume := &storms.Volume{
+		Uuid:       srcVolID,
+		Size:       defaultOSDiskSizeBytes,
+		SectorSize: storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512,
+	}
+	clonedVolume := &storms.Volume{
+		Uuid:       volID,
+		Size:       defaultOSDiskSizeBytes,
+		SectorSize: storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512,
+	}
+	req := &storms.CloneVolumeRequest{Uuid: volID, SrcVolumeUuid: srcVolID}
+
+	tests := []struct {
+		name          string
+		fixture       *idempotencyFixture
+		expectCode    codes.Code
+		expectCreated bool
+		expectMapping string
+	}{
+		{
+			name: "new clone is created on the source cluster",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{srcVolID: clusterID2},
+				volumes:  map[string]map[string]*storms.Volume{clusterID2: {srcVolID: srcVolume}},
+			},
+			expectCode:    codes.OK,
+			expectCreated: true,
+			expectMapping: clusterID2,
+		},
+		{
+			name: "identical retry",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{srcVolID: clusterID1, volID: clusterID1},
+				volumes: map[string]map[string]*storms.Volume{clusterID1: {
+					srcVolID: srcVolume,
+					volID:    clonedVolume,
+				}},
+			},
+			expectCode:    codes.OK,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "existing volume of a different size",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{srcVolID: clusterID1, volID: clusterID1},
+				volumes: map[string]map[string]*storms.Volume{clusterID1: {
+					srcVolID: srcVolume,
+					volID: {
+						Uuid:       volID,
+						Size:       2 * defaultOSDiskSizeBytes,
+						SectorSize: storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512,
+					},
+				}},
+			},
+			expectCode:    codes.AlreadyExists,
+			expectMapping: clusterID1,
+		},
+		{
+			name: "existing volume on another cluster",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{srcVolID: clusterID1, volID: clusterID2},
+				volumes: map[string]map[string]*storms.Volume{
+					clusterID1: {srcVolID: srcVolume},
+					clusterID2: {volID: clonedVolume},
+				},
+			},
+			expectCode:    codes.AlreadyExists,
+			expectMapping: clusterID2,
+		},
+		{
+			name: "unmapped source volume",
+			fixture: &idempotencyFixture{
+				mappings: map[string]string{},
+			},
+			expectCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.fixture.service().CloneVolume(context.Background(), req)
+			require.Equal(t, tt.expectCode, status.Code(err))
+			require.Equal(t, tt.expectCreated, tt.fixture.created)
+			require.Equal(t, tt.expectMapping, tt.fixture.mappings[volID])
+			if err == nil {
+				require.Equal(t, volID, resp.GetVolume().GetUuid())
+				require.Equal(t, tt.expectMapping, resp.GetClusterId())
+			}
+		})
+	}
+}
+
+func Test_CreateSnapshot_Idempotent(t *testing.T) {
+	snapshotID := uuid.NewString()
+	volID := uuid.NewString()
+	req := &storms.CreateSnapshotRequest{Uuid: snapshotID, SrcVolumeUuid: volID}
+
+	tests := []struct {
+		name          string
+		snapshots     map[string]map[string]*storms.Snapshot
+		expectCode    codes.Code
+		expectCreated bool
+	}{
+		{
+			name:          "new snapshot",
+			expectCode:    codes.OK,
+			expectCreated: true,
+		},
+		{
+			name: "identical retry",
+			snapshots: map[string]map[string]*storms.Snapshot{clusterID1: {snapshotID: {
+				Uuid:             snapshotID,
+				SourceVolumeUuid: volID,
+			}}},
+			expectCode: codes.OK,
+		},
+		{
+			name: "conflicting retry",
+			snapshots: map[string]map[string]*storms.Snapshot{clusterID2: {snapshotID: {
+				Uuid:             snapshotID,
+				SourceVolumeUuid: uuid.NewString(),
+			}}},
+			expectCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &idempotencyFixture{
+				mappings:  map[string]string{volID: clusterID1},
+				snapshots: tt.snapshots,
+			}
+
+			resp, err := f.service().CreateSnapshot(context.Background(), req)
+			require.Equal(t, tt.expectCode, status.Code(err))
+			require.Equal(t, tt.expectCreated, f.created)
+			if err == nil {
+				require.Equal(t, snapshotID, resp.GetSnapshot().GetUuid())
+				require.Equal(t, clusterID1, resp.GetClusterId())
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	translator "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator"
+)
+
+var errInvalidLabelSelector = status.Error(codes.InvalidArgument, "invalid label selector")
+
+type labelOperator int
+
+const (
+	labelEquals labelOperator = iota
+	labelNotEquals
+	labelExists
+	labelNotExists
+)
+
+// A single requirement of a label selector.
+type labelRequirement struct {
+	key      string
+	value    string
+	operator labelOperator
+}
+
+// A label selector matches labels meeting all of its requirements. An empty selector matches all labels.
+type labelSelector []labelRequirement
+
+// Parses a comma-separated list of requirements, each of the form "key=value" (or "key==value"), "key!=value", "key"
+// or "!key".
+func parseLabelSelector(selector string) (labelSelector, error) {
+	out := labelSelector{}
+	if strings.TrimSpace(selector) == "" {
+		return out, nil
+	}
+
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+
+		var r labelRequirement
+		if key, value, ok := strings.Cut(part, "!="); ok {
+			r = labelRequirement{key: key, value: value, operator: labelNotEquals}
+		} else if key, value, ok := strings.Cut(part, "="); ok {
+			r = labelRequirement{key: key, value: strings.TrimPrefix(value, "="), operator: labelEquals}
+		} else if key, ok := strings.CutPrefix(part, "!"); ok {
+			r = labelRequirement{key: key, operator: labelNotExists}
+		} else {
+			r = labelRequirement{key: part, operator: labelExists}
+		}
+		r.key, r.value = strings.TrimSpace(r.key), strings.TrimSpace(r.value)
+
+		if r.key == "" || strings.ContainsAny(r.key, "!= ") || strings.ContainsAny(r.value, "!=") {
+			return nil, fmt.Errorf("%w: %q", errInvalidLabelSelector, part)
+		}
+		out = append(out, r)
+	}
+
+	return out, nil
+}
+
+// Reports whether labels meet every requirement of the selector. As in Kubernetes, "key!=value" also matches labels
+// without the key.
+func (sel labelSelector) matches(labels map[string]string) bool {
+	for _, r := range sel {
+		value, ok := labels[r.key]
+		switch r.operator {
+		case labelEquals:
+			if !ok || value != r.value {
+				return false
+			}
+		case labelNotEquals:
+			if ok && value == r.value {
+				return false
+			}
+		case labelExists:
+			if !ok {
+				return false
+			}
+		case labelNotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Returns labels with set applied and the keys in remove deleted.
+func updatedLabels(labels, set map[string]string, remove []string) map[string]string {
+	out := maps.Clone(labels)
+	if out == nil {
+		out = map[string]string{}
+	}
+	for _, key := range remove {
+		delete(out, key)
+	}
+	maps.Copy(out, set)
+
+	return out
+}
+
+// Labels are stored on the vendor object by clients that support it, and by the resource manager otherwise.
+
+// Replaces the labels of a resource on c.
+func (s *Service) setLabels(ctx context.Context, c *cluster.Cluster, resourceType storms.ResourceType, id string,
+	labels map[string]string,
+) error {
+	err := s.clientTranslator.SetLabels(ctx, c.Client, resourceType, id, labels)
+	if errors.Is(err, translator.ErrLabelsUnsupported) {
+		if err := s.resourceManager.SetLabels(id, labels); err != nil {
+			return fmt.Errorf("failed to store labels: %w", err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return clientError(c, fmt.Errorf("failed to set labels in translation layer: %w", err))
+	}
+
+	return nil
+}
+
+// Returns the labels of the resources with the given IDs on c, by ID. Set all when ids are every resource of the type
+// on c, so that clients storing labels return them in a single listing.
+func (s *Service) resourceLabels(ctx context.Context, c *cluster.Cluster, resourceType storms.ResourceType,
+	ids []string, all bool,
+) (map[string]map[string]string, error) {
+	lookupIDs := ids
+	if all {
+		lookupIDs = nil
+	}
+
+	labels, err := s.clientTranslator.GetLabels(ctx, c.Client, resourceType, lookupIDs)
+	if errors.Is(err, translator.ErrLabelsUnsupported) {
+		out := map[string]map[string]string{}
+		for _, id := range ids {
+			if l := s.resourceManager.GetLabels(id); len(l) > 0 {
+				out[id] = l
+			}
+		}
+
+		return out, nil
+	}
+	if err != nil {
+		return nil, clientError(c, fmt.Errorf("failed to get labels in translation layer: %w", err))
+	}
+
+	return labels, nil
+}
+
+// Fills in the labels of volumes on c.
+func (s *Service) withVolumeLabels(ctx context.Context, c *cluster.Cluster, volumes []*storms.Volume, all bool,
+) error {
+	ids := lo.Map(volumes, func(v *storms.Volume, _ int) string { return v.GetUuid() })
+	labels, err := s.resourceLabels(ctx, c, storms.ResourceType_RESOURCE_TYPE_VOLUME, ids, all)
+	if err != nil {
+		return err
+	}
+	for _, v := range volumes {
+		v.Labels = labels[v.GetUuid()]
+	}
+
+	return nil
+}
+
+// Fills in the labels of snapshots on c.
+func (s *Service) withSnapshotLabels(ctx context.Context, c *cluster.Cluster, snapshots []*storms.Snapshot, all bool,
+) error {
+	ids := lo.Map(snapshots, func(snapshot *storms.Snapshot, _ int) string { return snapshot.GetUuid() })
+	labels, err := s.resourceLabels(ctx, c, storms.ResourceType_RESOURCE_TYPE_SNAPSHOT, ids, all)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		snapshot.Labels = labels[snapshot.GetUuid()]
+	}
+
+	return nil
+}
+
+// Sets the labels requested by the create of a volume, once it is created and mapped.
+func (s *Service) labelCreatedVolume(ctx context.Context, c *cluster.Cluster, volume *storms.Volume, id string,
+	labels map[string]string,
+) error {
+	if len(labels) == 0 {
+		return nil
+	}
+	if err := s.setLabels(ctx, c, storms.ResourceType_RESOURCE_TYPE_VOLUME, id, labels); err != nil {
+		return fmt.Errorf("failed to set labels of created volume: %w", err)
+	}
+	if volume != nil {
+		volume.Labels = labels
+	}
+
+	return nil
+}
+
+// Sets the labels requested by a retried create on the volume it already created, or fills in the labels the volume
+// has if none were requested.
+func (s *Service) labelExistingVolume(ctx context.Context, c *cluster.Cluster, volume *storms.Volume,
+	labels map[string]string,
+) error {
+	if len(labels) == 0 {
+		return s.withVolumeLabels(ctx, c, []*storms.Volume{volume}, false)
+	}
+
+	return s.labelCreatedVolume(ctx, c, volume, volume.GetUuid(), labels)
+}
+
+// Sets the labels requested by the create of a snapshot, once it is created and mapped.
+func (s *Service) labelCreatedSnapshot(ctx context.Context, c *cluster.Cluster, snapshot *storms.Snapshot, id string,
+	labels map[string]string,
+) error {
+	if len(labels) == 0 {
+		return nil
+	}
+	if err := s.setLabels(ctx, c, storms.ResourceType_RESOURCE_TYPE_SNAPSHOT, id, labels); err != nil {
+		return fmt.Errorf("failed to set labels of created snapshot: %w", err)
+	}
+	if snapshot != nil {
+		snapshot.Labels = labels
+	}
+
+	return nil
+}
+
+// Sets the labels requested by a retried create on the snapshot it already created, or fills in the labels the
+// snapshot has if none were requested.
+func (s *Service) labelExistingSnapshot(ctx context.Context, c *cluster.Cluster, snapshot *storms.Snapshot,
+	labels map[string]string,
+) error {
+	if len(labels) == 0 {
+		return s.withSnapshotLabels(ctx, c, []*storms.Snapshot{snapshot}, false)
+	}
+
+	return s.labelCreatedSnapshot(ctx, c, snapshot, snapshot.GetUuid(), labels)
+}
+
+func (s *Service) UpdateVolumeLabels(ctx context.Context, req *storms.UpdateVolumeLabelsRequest,
+) (*storms.UpdateVolumeLabelsResponse, error) {
+	release := s.labelLocks.Lock(req.GetUuid())
+	defer release()
+
+	clusterID, c, err := s.getClientForResource(req.GetUuid())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client for resource: %w", err)
+	}
+
+	resp, err := s.GetVolume(ctx, &storms.GetVolumeRequest{Uuid: req.GetUuid()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume: %w", err)
+	}
+	volume := resp.GetVolume()
+
+	labels := updatedLabels(volume.GetLabels(), req.GetLabels(), req.GetRemoveLabels())
+	if err := s.setLabels(ctx, c, storms.ResourceType_RESOURCE_TYPE_VOLUME, req.GetUuid(), labels); err != nil {
+		return nil, err
+	}
+	volume.Labels = labels
+
+	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("updated volume labels")
+
+	return &storms.UpdateVolumeLabelsResponse{Volume: volume}, nil
+}
+
+func (s *Service) UpdateSnapshotLabels(ctx context.Context, req *storms.UpdateSnapshotLabelsRequest,
+) (*storms.UpdateSnapshotLabelsResponse, error) {
+	release := s.labelLocks.Lock(req.GetUuid())
+	defer release()
+
+	clusterID, c, err := s.getClientForResource(req.GetUuid())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client for resource: %w", err)
+	}
+
+	resp, err := s.GetSnapshot(ctx, &storms.GetSnapshotRequest{Uuid: req.GetUuid()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	snapshot := resp.GetSnapshot()
+
+	labels := updatedLabels(snapshot.GetLabels(), req.GetLabels(), req.GetRemoveLabels())
+	if err := s.setLabels(ctx, c, storms.ResourceType_RESOURCE_TYPE_SNAPSHOT, req.GetUuid(), labels); err != nil {
+		return nil, err
+	}
+	snapshot.Labels = labels
+
+	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("updated snapshot labels")
+
+	return &storms.UpdateSnapshotLabelsResponse{Snapshot: snapshot}, nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+)
+
+func Test_parseLabelSelector(t *testing.T) {
+	labels := map[string]string{"tenant": "a", "vm": "b"}
+
+	tests := []struct {
+		name      string
+		selector  string
+		expected  bool
+		expectErr bool
+	}{
+		{name: "empty", selector: "", expected: true},
+		{name: "equals", selector: "tenant=a", expected: true},
+		{name: "double equals", selector: "tenant==a", expected: true},
+		{name: "equals mismatch", selector: "tenant=b", expected: false},
+		{name: "not equals", selector: "tenant!=b", expected: true},
+		{name: "not equals missing key", selector: "zone!=b", expected: true},
+		{name: "not equals mismatch", selector: "tenant!=a", expected: false},
+		{name: "exists", selector: "vm", expected: true},
+		{name: "exists missing key", selector: "zone", expected: false},
+		{name: "not exists", selector: "!zone", expected: true},
+		{name: "not exists mismatch", selector: "!vm", expected: false},
+		{name: "all requirements", selector: "tenant=a, vm, !zone", expected: true},
+		{name: "one requirement fails", selector: "tenant=a,vm=c", expected: false},
+		{name: "missing key", selector: "=a", expectErr: true},
+		{name: "empty requirement", selector: "tenant=a,", expectErr: true},
+		{name: "malformed", selector: "tenant=a=b", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := parseLabelSelector(tt.selector)
+			if tt.expectErr {
+				require.ErrorIs(t, err, errInvalidLabelSelector)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, selector.matches(labels))
+		})
+	}
+}
+
+func Test_UpdateVolumeLabels(t *testing.T) {
+	tests := []struct {
+		name string
+		// Whether the client of the cluster stores labels itself.
+		clientLabels bool
+	}{
+		{name: "labels stored by StorMS"},
+		{name: "labels stored by client", clientLabels: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := map[string]string{"tenant": "a", "vm": "b"}
+			translator := &translatormocks.MockClientTranslator{
+				MockGetVolume: func(_ context.Context, _ client.Client, req *storms.GetVolumeRequest,
+				) (*storms.GetVolumeResponse, error) {
+					return &storms.GetVolumeResponse{Volume: &storms.Volume{Uuid: req.GetUuid()}}, nil
+				},
+			}
+			resources := &resourcemocks.MockResourceManager{
+				MockGetResourceCluster: func(string) (string, error) { return clusterID1, nil },
+			}
+			if tt.clientLabels {
+				translator.MockGetLabels = func(_ context.Context, _ client.Client, _ storms.ResourceType, uuids []string,
+				) (map[string]map[string]string, error) {
+					return map[string]map[string]string{uuids[0]: stored}, nil
+				}
+				translator.MockSetLabels = func(_ context.Context, _ client.Client, _ storms.ResourceType, _ string,
+					labels map[string]string,
+				) error {
+					stored = labels
+
+					return nil
+				}
+			} else {
+				resources.MockGetLabels = func(string) map[string]string { return stored }
+				resources.MockSetLabels = func(_ string, labels map[string]string) error {
+					stored = labels
+
+					return nil
+				}
+			}
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet: func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+				},
+				resourceManager:  resources,
+				clientTranslator: translator,
+			}
+
+			resp, err := s.UpdateVolumeLabels(context.Background(), &storms.UpdateVolumeLabelsRequest{
+				Uuid:         resourceID1,
+				Labels:       map[string]string{"tenant": "c", "zone": "d"},
+				RemoveLabels: []string{"vm", "missing"},
+			})
+			require.NoError(t, err)
+
+			expected := map[string]string{"tenant": "c", "zone": "d"}
+			require.Equal(t, expected, resp.GetVolume().GetLabels())
+			require.Equal(t, expected, stored)
+		})
+	}
+}
+
+func Test_GetSnapshots_LabelSelector(t *testing.T) {
+	s := &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockAllIDs: func() []string { return []string{clusterID1} },
+			MockGet:    func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockGetLabels: func(resourceID string) map[string]string {
+				if resourceID == resourceID1 {
+					return map[string]string{"tenant": "a"}
+				}
+
+				return nil
+			},
+		},
+		clientTranslator: &translatormocks.MockClientTranslator{
+			MockGetSnapshots: func(_ context.Context, _ client.Client, _ *storms.GetSnapshotsRequest,
+			) (*storms.GetSnapshotsResponse, error) {
+				return &storms.GetSnapshotsResponse{
+					Snapshots: []*storms.Snapshot{{Uuid: resourceID1}, {Uuid: resourceID2}},
+				}, nil
+			},
+		},
+	}
+
+	resp, err := s.GetSnapshots(context.Background(), &storms.GetSnapshotsRequest{
+		Filter: &storms.SnapshotFilter{LabelSelector: "tenant=a"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetSnapshots(), 1)
+	require.Equal(t, resourceID1, resp.GetSnapshots()[0].GetUuid())
+	require.Equal(t, map[string]string{"tenant": "a"}, resp.GetSnapshots()[0].GetLabels())
+
+	_, err = s.GetSnapshots(context.Background(), &storms.GetSnapshotsRequest{
+		Filter: &storms.SnapshotFilter{LabelSelector: "=a"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+)
+
+const defaultListTimeout = 30 * time.Second
+
+var (
+	errInvalidPageToken  = status.Error(codes.InvalidArgument, "invalid page token")
+	errAllClustersFailed = status.Error(codes.Unavailable, "all clusters failed")
+)
+
+// Returns errAllClustersFailed with an ErrorInfo detail for each failure, naming its cluster, vendor and error.
+func allClustersFailedError(failures []*storms.ClusterFailure) error {
+	st := status.Convert(errAllClustersFailed)
+	for _, f := range failures {
+		info := &errdetails.ErrorInfo{
+			Reason: "CLUSTER_FAILED",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"cluster_id": f.GetClusterId(),
+				"vendor":     f.GetVendor(),
+				"error":      f.GetError(),
+			},
+		}
+		if withDetails, err := st.WithDetails(info); err == nil {
+			st = withDetails
+		}
+	}
+
+	return &statusError{status: st, err: errAllClustersFailed}
+}
+
+// List RPCs return resources across all clusters ordered by UUID. A page token holds the UUID of the last
+// resource returned, so a listing stays consistent when resources are created or deleted between pages.
+func encodePageToken(lastID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastID))
+}
+
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) == 0 {
+		return "", errInvalidPageToken
+	}
+
+	return string(b), nil
+}
+
+// Sorts items by ID and returns the page following the resource with ID after. A page size of 0 returns every
+// remaining item. The returned token is empty when there are no more items.
+func paginate[T any](items []T, id func(T) string, after string, pageSize uint32) ([]T, string) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return strings.Compare(id(a), id(b))
+	})
+
+	if after != "" {
+		start, _ := slices.BinarySearchFunc(items, after, func(item T, target string) int {
+			return strings.Compare(id(item), target)
+		})
+		for start < len(items) && id(items[start]) == after {
+			start++
+		}
+		items = items[start:]
+	}
+
+	if pageSize == 0 || uint32(len(items)) <= pageSize { //nolint:gosec // length of a slice is non-negative
+		return items, ""
+	}
+	page := items[:pageSize]
+
+	return page, encodePageToken(id(page[len(page)-1]))
+}
+
+// Reports whether a cluster may hold resources matching the cluster ID and vendor filters.
+func clusterMatches(c *cluster.Cluster, clusterID, vendor string) bool {
+	if clusterID != "" && c.Config.ClusterID != clusterID {
+		return false
+	}
+	if vendor != "" && !strings.EqualFold(c.Config.Vendor, vendor) {
+		return false
+	}
+
+	return true
+}
+
+func volumeMatches(v *storms.Volume, f *storms.VolumeFilter, selector labelSelector) bool {
+	if !selector.matches(v.GetLabels()) {
+		return false
+	}
+	if f == nil {
+		return true
+	}
+	if f.IsAvailable != nil && v.GetIsAvailable() != f.GetIsAvailable() {
+		return false
+	}
+	if f.GetAclContains() != "" && !lo.Contains(v.GetAcl(), f.GetAclContains()) {
+		return false
+	}
+	if f.GetSourceSnapshotUuid() != "" && v.GetSourceSnapshotUuid() != f.GetSourceSnapshotUuid() {
+		return false
+	}
+	if f.GetCreatedAfter() != nil {
+		if v.GetCreatedAt() == nil || !v.GetCreatedAt().AsTime().After(f.GetCreatedAfter().AsTime()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func snapshotMatches(s *storms.Snapshot, f *storms.SnapshotFilter, selector labelSelector) bool {
+	if !selector.matches(s.GetLabels()) {
+		return false
+	}
+	if f == nil {
+		return true
+	}
+	if f.IsAvailable != nil && s.GetIsAvailable() != f.GetIsAvailable() {
+		return false
+	}
+	if f.GetSourceVolumeUuid() != "" && s.GetSourceVolumeUuid() != f.GetSourceVolumeUuid() {
+		return false
+	}
+	if f.GetCreatedAfter() != nil {
+		if s.GetCreatedAt() == nil || !s.GetCreatedAt().AsTime().After(f.GetCreatedAfter().AsTime()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Lists resources from every cluster matching the cluster ID and vendor filters concurrently, each under its own
+// timeout. Results from clusters that succeed are returned alongside the failures of those that did not.
+func fanOutList[T any](
+	ctx context.Context,
+	s *Service,
+	clusterID, vendor string,
+	list func(ctx context.Context, c *cluster.Cluster) ([]T, error),
+) ([]T, []*storms.ClusterFailure, error) {
+	timeout := s.listTimeout
+	if timeout == 0 {
+		timeout = defaultListTimeout
+	}
+
+	clusterIDs := s.clusterManager.AllIDs()
+	slices.Sort(clusterIDs)
+
+	results := make([][]T, len(clusterIDs))
+	failures := make([]*storms.ClusterFailure, len(clusterIDs))
+	targeted := 0
+	wg := sync.WaitGroup{}
+	for i, id := range clusterIDs {
+		c, err := s.clusterManager.Get(id)
+		if err != nil {
+			failures[i] = &storms.ClusterFailure{ClusterId: id, Error: err.Error()}
+			targeted++
+
+			continue
+		}
+		if !clusterMatches(c, clusterID, vendor) {
+			continue
+		}
+		targeted++
+		if !c.Ready() {
+			msg := fmt.Sprintf("cluster is %s", c.CurrentState())
+			if c.LastError != "" {
+				msg += ": " + c.LastError
+			}
+			failures[i] = &storms.ClusterFailure{ClusterId: id, Vendor: c.Config.Vendor, Error: msg}
+
+			continue
+		}
+		if st := s.clusterHealth(id); !st.Routable() {
+			failures[i] = &storms.ClusterFailure{
+				ClusterId: id,
+				Vendor:    c.Config.Vendor,
+				Error:     fmt.Sprintf("cluster is %s: %s", st.State, st.LastError),
+			}
+
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, c *cluster.Cluster) {
+			defer wg.Done()
+			clusterCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			items, err := list(clusterCtx, c)
+			if err != nil {
+				log.Err(err).Str("cluster_id", c.Config.ClusterID).Msg("failed to list resources of cluster")
+				failures[i] = &storms.ClusterFailure{
+					ClusterId: c.Config.ClusterID,
+					Vendor:    c.Config.Vendor,
+					Error:     err.Error(),
+				}
+
+				return
+			}
+			results[i] = items
+		}(i, c)
+	}
+	wg.Wait()
+
+	failures = lo.Compact(failures)
+	if targeted > 0 && len(failures) == targeted {
+		return nil, failures, allClustersFailedError(failures)
+	}
+
+	return lo.Flatten(results), failures, nil
+}
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+)
+
+func Test_paginate(t *testing.T) {
+	ids := func(n ...string) []string { return append([]string{}, n...) }
+	identity := func(s string) string { return s }
+
+	tests := []struct {
+		name         string
+		items        []string
+		after        string
+		pageSize     uint32
+		expected     []string
+		expectNextID string
+	}{
+		{
+			name:     "all; unsorted input is sorted",
+			items:    ids("c", "a", "b"),
+			expected: ids("a", "b", "c"),
+		},
+		{
+			name:         "first page",
+			items:        ids("d", "c", "a", "b"),
+			pageSize:     2,
+			expected:     ids("a", "b"),
+			expectNextID: "b",
+		},
+		{
+			name:     "last page",
+			items:    ids("d", "c", "a", "b"),
+			after:    "b",
+			pageSize: 2,
+			expected: ids("c", "d"),
+		},
+		{
+			name:     "after a deleted item",
+			items:    ids("a", "c", "d"),
+			after:    "b",
+			pageSize: 5,
+			expected: ids("c", "d"),
+		},
+		{
+			name:     "after the last item",
+			items:    ids("a", "b"),
+			after:    "b",
+			pageSize: 1,
+			expected: ids(),
+		},
+		{
+			name:     "empty",
+			items:    ids(),
+			pageSize: 1,
+			expected: ids(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, token := paginate(tt.items, identity, tt.after, tt.pageSize)
+			require.Equal(t, tt.expected, page)
+			if tt.expectNextID == "" {
+				require.Empty(t, token)
+
+				return
+			}
+			nextID, err := decodePageToken(token)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectNextID, nextID)
+		})
+	}
+}
+
+func Test_decodePageToken(t *testing.T) {
+	id, err := decodePageToken("")
+	require.NoError(t, err)
+	require.Empty(t, id)
+
+	id, err = decodePageToken(encodePageToken(resourceID1))
+	require.NoError(t, err)
+	require.Equal(t, resourceID1, id)
+
+	_, err = decodePageToken("not a token!")
+	require.ErrorIs(t, err, errInvalidPageToken)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_volumeMatches(t *testing.T) {
+	createdAt := time.Date(2025, 11, 15, 10, 30, 0, 0, time.UTC)
+	vol := &storms.Volume{
+		Uuid:               resourceID1,
+		Acl:                []string{"host-a", "host-b"},
+		IsAvailable:        true,
+		SourceSnapshotUuid: resourceID2,
+		CreatedAt:          timestamppb.New(createdAt),
+	}
+
+	tests := []struct {
+		name     string
+		filter   *storms.VolumeFilter
+		expected bool
+	}{
+		{name: "nil filter", filter: nil, expected: true},
+		{name: "empty filter", filter: &storms.VolumeFilter{}, expected: true},
+		{name: "availability match", filter: &storms.VolumeFilter{IsAvailable: proto.Bool(true)}, expected: true},
+		{name: "availability mismatch", filter: &storms.VolumeFilter{IsAvailable: proto.Bool(false)}, expected: false},
+		{name: "acl contains", filter: &storms.VolumeFilter{AclContains: "host-b"}, expected: true},
+		{name: "acl does not contain", filter: &storms.VolumeFilter{AclContains: "host-c"}, expected: false},
+		{name: "source match", filter: &storms.VolumeFilter{SourceSnapshotUuid: resourceID2}, expected: true},
+		{name: "source mismatch", filter: &storms.VolumeFilter{SourceSnapshotUuid: resourceID1}, expected: false},
+		{
+			name:     "created after",
+			filter:   &storms.VolumeFilter{CreatedAfter: timestamppb.New(createdAt.Add(-time.Second))},
+			expected: true,
+		},
+		{
+			name:     "created at boundary",
+			filter:   &storms.VolumeFilter{CreatedAfter: timestamppb.New(createdAt)},
+			expected: false,
+		},
+		{
+			name: "all match but one",
+			filter: &storms.VolumeFilter{
+				IsAvailable:        proto.Bool(true),
+				AclContains:        "host-a",
+				SourceSnapshotUuid: resourceID2,
+				CreatedAfter:       timestamppb.New(createdAt.Add(time.Second)),
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, volumeMatches(vol, tt.filter, nil))
+		})
+	}
+}
+
+func Test_snapshotMatches(t *testing.T) {
+	snapshot := &storms.Snapshot{
+		Uuid:             resourceID2,
+		IsAvailable:      false,
+		SourceVolumeUuid: resourceID1,
+	}
+
+	tests := []struct {
+		name     string
+		filter   *storms.SnapshotFilter
+		expected bool
+	}{
+		{name: "nil filter", filter: nil, expected: true},
+		{name: "availability match", filter: &storms.SnapshotFilter{IsAvailable: proto.Bool(false)}, expected: true},
+		{name: "availability mismatch", filter: &storms.SnapshotFilter{IsAvailable: proto.Bool(true)}, expected: false},
+		{name: "source match", filter: &storms.SnapshotFilter{SourceVolumeUuid: resourceID1}, expected: true},
+		{name: "source mismatch", filter: &storms.SnapshotFilter{SourceVolumeUuid: resourceID2}, expected: false},
+		{
+			name:     "no creation time",
+			filter:   &storms.SnapshotFilter{CreatedAfter: timestamppb.New(time.Unix(0, 0))},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, snapshotMatches(snapshot, tt.filter, nil))
+		})
+	}
+}
+
+func Test_fanOutList(t *testing.T) {
+	errList := errors.New("list failed")
+
+	tests := []struct {
+		name           string
+		clusterID      string
+		vendor         string
+		list           func(ctx context.Context, c *cluster.Cluster) ([]string, error)
+		expected       []string
+		expectFailures []string
+		expectErr      error
+	}{
+		{
+			name: "all succeed",
+			list: func(_ context.Context, c *cluster.Cluster) ([]string, error) {
+				return []string{c.Config.ClusterID}, nil
+			},
+			expected: []string{clusterID1, clusterID2},
+		},
+		{
+			name:   "filtered by vendor",
+			vendor: vendor2,
+			list: func(_ context.Context, c *cluster.Cluster) ([]string, error) {
+				return []string{c.Config.ClusterID}, nil
+			},
+			expected: []string{clusterID2},
+		},
+		{
+			name: "one fails",
+			list: func(_ context.Context, c *cluster.Cluster) ([]string, error) {
+				if c.Config.ClusterID == clusterID1 {
+					return nil, errList
+				}
+
+				return []string{c.Config.ClusterID}, nil
+			},
+			expected:       []string{clusterID2},
+			expectFailures: []string{clusterID1},
+		},
+		{
+			name: "one times out",
+			list: func(ctx context.Context, c *cluster.Cluster) ([]string, error) {
+				if c.Config.ClusterID == clusterID2 {
+					<-ctx.Done()
+
+					return nil, fmt.Errorf("slow cluster: %w", ctx.Err())
+				}
+
+				return []string{c.Config.ClusterID}, nil
+			},
+			expected:       []string{clusterID1},
+			expectFailures: []string{clusterID2},
+		},
+		{
+			name: "all fail",
+			list: func(_ context.Context, _ *cluster.Cluster) ([]string, error) {
+				return nil, errList
+			},
+			expectFailures: []string{clusterID1, clusterID2},
+			expectErr:      errAllClustersFailed,
+		},
+		{
+			name:      "no cluster matches",
+			clusterID: resourceID1,
+			list: func(_ context.Context, _ *cluster.Cluster) ([]string, error) {
+				return nil, errList
+			},
+		},
+	}
+
+	s := &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockAllIDs: func() []string {
+				return []string{clusterID1, clusterID2}
+			},
+			MockGet: func(clusterID string) (*cluster.Cluster, error) {
+				switch clusterID {
+				case clusterID1:
+					return mockCluster1, nil
+				case clusterID2:
+					return mockCluster2, nil
+				}
+
+				return nil, fmt.Errorf("error")
+			},
+		},
+		listTimeout: 10 * time.Millisecond,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, failures, err := fanOutList(context.Background(), s, tt.clusterID, tt.vendor, tt.list)
+			failedIDs := make([]string, 0, len(failures))
+			for _, f := range failures {
+				require.NotEmpty(t, f.Vendor)
+				require.NotEmpty(t, f.Error)
+				failedIDs = append(failedIDs, f.ClusterId)
+			}
+			require.ElementsMatch(t, tt.expectFailures, failedIDs)
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				require.Equal(t, codes.Unavailable, status.Code(err))
+				// Each failure is attached to the error, so that callers see why every cluster failed.
+				detailIDs := []string{}
+				for _, detail := range status.Convert(err).Details() {
+					info, ok := detail.(*errdetails.ErrorInfo)
+					require.True(t, ok)
+					require.Equal(t, "CLUSTER_FAILED", info.GetReason())
+					require.NotEmpty(t, info.GetMetadata()["error"])
+					detailIDs = append(detailIDs, info.GetMetadata()["cluster_id"])
+				}
+				require.ElementsMatch(t, tt.expectFailures, detailIDs)
+
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expected, out)
+		})
+	}
+}
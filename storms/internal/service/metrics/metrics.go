@@ -0,0 +1,164 @@
+// Package metrics collects the Prometheus metrics of StorMS.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+const namespace = "storms"
+
+// ResourceLister lists the resources mapped to each cluster, which are counted on every scrape.
+type ResourceLister interface {
+	GetResourcesOfAllClusters() map[string][]*resource.Resource
+}
+
+// Metrics holds the collectors of StorMS. Methods of a nil *Metrics do nothing, so that components need not check
+// whether metrics are collected.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests              *prometheus.CounterVec
+	requestDuration       *prometheus.HistogramVec
+	clientRequestDuration *prometheus.HistogramVec
+	clientErrors          *prometheus.CounterVec
+	syncDuration          *prometheus.HistogramVec
+	syncLastSuccess       *prometheus.GaugeVec
+}
+
+// New creates the collectors of StorMS in a registry of their own.
+func New(resources ResourceLister) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_total",
+			Help:      "gRPC requests handled, by method and status code.",
+		}, []string{"method", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Time taken to handle gRPC requests, by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		clientRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "client_request_duration_seconds",
+			Help:      "Time taken by calls to storage clusters, by cluster, vendor and client method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"cluster_id", "vendor", "method"}),
+		clientErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "client_errors_total",
+			Help:      "Calls to storage clusters that failed, by cluster, vendor and client method.",
+		}, []string{"cluster_id", "vendor", "method"}),
+		syncDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "sync_duration_seconds",
+			Help:      "Time taken to sync the resources of a cluster.",
+			// Syncs list every resource of a cluster, and take far longer than requests.
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 14),
+		}, []string{"cluster_id"}),
+		syncLastSuccess: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "sync_last_success_timestamp_seconds",
+			Help:      "Unix time of the last successful sync of the resources of a cluster.",
+		}, []string{"cluster_id"}),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.requestDuration,
+		m.clientRequestDuration,
+		m.clientErrors,
+		m.syncDuration,
+		m.syncLastSuccess,
+		&resourceCollector{resources: resources},
+	)
+
+	return m
+}
+
+// Handler serves the metrics of StorMS, along with those registered in the default registry: the Go runtime, the
+// process and the Lightbits load balancers.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(prometheus.Gatherers{m.registry, prometheus.DefaultGatherer}, promhttp.HandlerOpts{})
+}
+
+// UnaryServerInterceptor counts and times gRPC requests.
+func (m *Metrics) UnaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	if m != nil {
+		code := status.Code(err).String()
+		m.requests.WithLabelValues(info.FullMethod, code).Inc()
+		m.requestDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+	}
+
+	return resp, err
+}
+
+// ClientObserver returns an observer of the client of a cluster, for client.Observe.
+func (m *Metrics) ClientObserver(clusterID, vendor string) client.Observer {
+	return func(method string, duration time.Duration, err error) {
+		if m == nil {
+			return
+		}
+		m.clientRequestDuration.WithLabelValues(clusterID, vendor, method).Observe(duration.Seconds())
+		if err != nil {
+			m.clientErrors.WithLabelValues(clusterID, vendor, method).Inc()
+		}
+	}
+}
+
+// ObserveSync records a sync of the resources of a cluster, which succeeded if err is nil.
+func (m *Metrics) ObserveSync(clusterID string, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.syncDuration.WithLabelValues(clusterID).Observe(duration.Seconds())
+	if err == nil {
+		m.syncLastSuccess.WithLabelValues(clusterID).SetToCurrentTime()
+	}
+}
+
+var resourcesDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "resources"),
+	"Resources mapped to a cluster, by type.",
+	[]string{"cluster_id", "type"}, nil,
+)
+
+// Counts mapped resources when scraped, so that the counts never drift from the resource manager.
+type resourceCollector struct {
+	resources ResourceLister
+}
+
+func (c *resourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- resourcesDesc
+}
+
+func (c *resourceCollector) Collect(ch chan<- prometheus.Metric) {
+	for clusterID, resources := range c.resources.GetResourcesOfAllClusters() {
+		counts := map[resource.Type]int{resource.TypeVolume: 0, resource.TypeSnapshot: 0}
+		for _, r := range resources {
+			counts[r.ResourceType]++
+		}
+		for resourceType, count := range counts {
+			ch <- prometheus.MustNewConstMetric(resourcesDesc, prometheus.GaugeValue, float64(count),
+				clusterID, string(resourceType))
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+const (
+	clusterID1 = "3fb7b2e3-4bb6-4b3e-a8a9-1b8f5e3c6d01"
+	clusterID2 = "9c1d2e3f-4a5b-4c6d-8e7f-0a1b2c3d4e5f"
+)
+
+type mockResourceLister map[string][]*resource.Resource
+
+func (m mockResourceLister) GetResourcesOfAllClusters() map[string][]*resource.Resource {
+	return m
+}
+
+func Test_UnaryServerInterceptor(t *testing.T) {
+	m := New(mockResourceLister{})
+	info := &grpc.UnaryServerInfo{FullMethod: "/storms.v1.StorageManagementService/DeleteVolume"}
+	ok := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	notFound := func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "volume not found")
+	}
+
+	for _, handler := range []grpc.UnaryHandler{ok, ok, notFound} {
+		_, _ = m.UnaryServerInterceptor(context.Background(), nil, info, handler)
+	}
+
+	require.InDelta(t, 2, testutil.ToFloat64(m.requests.WithLabelValues(info.FullMethod, "OK")), 0)
+	require.InDelta(t, 1, testutil.ToFloat64(m.requests.WithLabelValues(info.FullMethod, "NotFound")), 0)
+	require.Equal(t, 2, testutil.CollectAndCount(m.requestDuration))
+}
+
+func Test_ClientObserver(t *testing.T) {
+	m := New(mockResourceLister{})
+	observe := m.ClientObserver(clusterID1, "lightbits")
+
+	observe("GetVolume", time.Millisecond, nil)
+	observe("GetVolume", time.Second, errors.New("timeout"))
+	observe("DeleteVolume", time.Millisecond, nil)
+
+	require.InDelta(t, 1, testutil.ToFloat64(m.clientErrors.WithLabelValues(clusterID1, "lightbits", "GetVolume")), 0)
+	require.Equal(t, 1, testutil.CollectAndCount(m.clientErrors))
+	require.Equal(t, 2, testutil.CollectAndCount(m.clientRequestDuration))
+}
+
+func Test_ObserveSync(t *testing.T) {
+	m := New(mockResourceLister{})
+
+	m.ObserveSync(clusterID1, time.Second, nil)
+	m.ObserveSync(clusterID2, time.Second, errors.New("cluster is down"))
+
+	require.InDelta(t, float64(time.Now().Unix()),
+		testutil.ToFloat64(m.syncLastSuccess.WithLabelValues(clusterID1)), 5)
+	// Failed syncs are timed, but leave the time of the last success unset.
+	require.Equal(t, 1, testutil.CollectAndCount(m.syncLastSuccess))
+	require.Equal(t, 2, testutil.CollectAndCount(m.syncDuration))
+}
+
+func Test_Handler(t *testing.T) {
+	m := New(mockResourceLister{
+		clusterID1: {
+			{ID: "vol-1", ClusterID: clusterID1, ResourceType: resource.TypeVolume},
+			{ID: "vol-2", ClusterID: clusterID1, ResourceType: resource.TypeVolume},
+			{ID: "snap-1", ClusterID: clusterID1, ResourceType: resource.TypeSnapshot},
+		},
+	})
+	m.ObserveSync(clusterID1, time.Second, nil)
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	for _, line := range []string{
+		`storms_resources{cluster_id="` + clusterID1 + `",type="volume"} 2`,
+		`storms_resources{cluster_id="` + clusterID1 + `",type="snapshot"} 1`,
+		`storms_sync_duration_seconds_count{cluster_id="` + clusterID1 + `"} 1`,
+		// Metrics of the default registry are served too.
+		`go_goroutines`,
+	} {
+		require.Contains(t, string(body), line)
+	}
+}
+
+func Test_NilMetrics(t *testing.T) {
+	var m *Metrics
+
+	resp, err := m.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{},
+		func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+	m.ClientObserver(clusterID1, "lightbits")("GetVolume", time.Second, nil)
+	m.ObserveSync(clusterID1, time.Second, nil)
+}
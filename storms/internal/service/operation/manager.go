@@ -0,0 +1,231 @@
+package operation
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+)
+
+const DefaultRetention = time.Hour
+
+var (
+	errNotFound     = status.Error(codes.NotFound, "operation not found")
+	errNotCancelled = status.Error(codes.FailedPrecondition, "operation already completed")
+)
+
+// Func performs an operation and returns the resulting *storms.Volume or *storms.Snapshot, or nil if there is none.
+type Func func(ctx context.Context) (proto.Message, error)
+
+type idKey struct{}
+
+// IDFromContext returns the ID of the operation whose Func is running with ctx.
+func IDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(idKey{}).(string)
+
+	return id
+}
+
+type entry struct {
+	op        *storms.Operation
+	cancel    context.CancelFunc
+	cancelled bool
+	done      chan struct{}
+}
+
+// Manager runs operations in the background and remembers them for the retention period after they complete.
+// Operations are kept in memory, so they are lost on restart.
+type Manager struct {
+	retention time.Duration
+	now       func() time.Time
+
+	// Parent of every operation's context, cancelled by Stop.
+	ctx    context.Context //nolint:containedctx // operations outlive the requests that start them
+	cancel context.CancelFunc
+
+	mu  sync.RWMutex
+	ops map[string]*entry
+}
+
+func NewManager(retention time.Duration) *Manager {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Manager{
+		retention: retention,
+		now:       time.Now,
+		ctx:       ctx,
+		cancel:    cancel,
+		ops:       make(map[string]*entry),
+	}
+}
+
+// Start runs fn in the background and returns the operation tracking it.
+func (m *Manager) Start(method string, resourceType storms.ResourceType, resourceID string, fn Func,
+) *storms.Operation {
+	now := timestamppb.New(m.now())
+	ctx, cancel := context.WithCancel(m.ctx)
+	e := &entry{
+		op: &storms.Operation{
+			Id:           uuid.NewString(),
+			Method:       method,
+			ResourceType: resourceType,
+			ResourceUuid: resourceID,
+			State:        storms.OperationState_OPERATION_STATE_IN_PROGRESS,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	m.pruneLocked()
+	m.ops[e.op.GetId()] = e
+	op := proto.Clone(e.op).(*storms.Operation) //nolint:forcetypeassert // clone of the same type
+	m.mu.Unlock()
+
+	log.Info().Str("operation_id", op.GetId()).Str("method", method).Str("resource_id", resourceID).
+		Msg("started operation")
+
+	go func() {
+		defer cancel()
+		result, err := fn(context.WithValue(ctx, idKey{}, op.GetId()))
+		m.complete(e, result, err)
+	}()
+
+	return op
+}
+
+func (m *Manager) complete(e *entry, result proto.Message, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	op := e.op
+	op.UpdatedAt = timestamppb.New(m.now())
+	switch {
+	case err != nil && e.cancelled:
+		op.State = storms.OperationState_OPERATION_STATE_CANCELLED
+		op.ErrorCode = uint32(codes.Canceled)
+		op.Error = err.Error()
+	case err != nil:
+		op.State = storms.OperationState_OPERATION_STATE_FAILED
+		op.ErrorCode = uint32(status.Code(err)) //nolint:gosec // codes are small non-negative integers
+		op.Error = err.Error()
+	default:
+		op.State = storms.OperationState_OPERATION_STATE_SUCCEEDED
+		switch r := result.(type) {
+		case *storms.Volume:
+			op.Result = &storms.Operation_Volume{Volume: r}
+		case *storms.Snapshot:
+			op.Result = &storms.Operation_Snapshot{Snapshot: r}
+		}
+	}
+	close(e.done)
+
+	log.Info().Str("operation_id", op.GetId()).Str("state", op.GetState().String()).Str("error", op.GetError()).
+		Msg("completed operation")
+}
+
+// Get returns the operation with the given ID.
+func (m *Manager) Get(id string) (*storms.Operation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	e, ok := m.ops[id]
+	if !ok {
+		return nil, errNotFound
+	}
+
+	return proto.Clone(e.op).(*storms.Operation), nil //nolint:forcetypeassert // clone of the same type
+}
+
+// List returns every remembered operation, ordered by ID.
+func (m *Manager) List() []*storms.Operation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pruneLocked()
+	out := make([]*storms.Operation, 0, len(m.ops))
+	for _, e := range m.ops {
+		out = append(out, proto.Clone(e.op).(*storms.Operation)) //nolint:forcetypeassert // clone of the same type
+	}
+	slices.SortFunc(out, func(a, b *storms.Operation) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+
+	return out
+}
+
+// Wait blocks until the operation completes or ctx is done, and returns the operation as of then.
+func (m *Manager) Wait(ctx context.Context, id string) (*storms.Operation, error) {
+	m.mu.RLock()
+	e, ok := m.ops[id]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, errNotFound
+	}
+
+	select {
+	case <-e.done:
+	case <-ctx.Done():
+	}
+
+	return m.Get(id)
+}
+
+// Cancel cancels the context of an operation in progress and waits for it to return or ctx to be done. Cancellation
+// is best effort: an operation that completes regardless is reported as succeeded.
+func (m *Manager) Cancel(ctx context.Context, id string) (*storms.Operation, error) {
+	m.mu.Lock()
+	e, ok := m.ops[id]
+	if !ok {
+		m.mu.Unlock()
+
+		return nil, errNotFound
+	}
+	if e.op.GetState() != storms.OperationState_OPERATION_STATE_IN_PROGRESS {
+		m.mu.Unlock()
+
+		return nil, errNotCancelled
+	}
+	e.cancelled = true
+	e.cancel()
+	m.mu.Unlock()
+
+	log.Info().Str("operation_id", id).Msg("cancelling operation")
+
+	return m.Wait(ctx, id)
+}
+
+// Stop cancels every operation in progress.
+func (m *Manager) Stop() {
+	m.cancel()
+}
+
+// Forgets operations that completed longer than the retention period ago.
+func (m *Manager) pruneLocked() {
+	cutoff := m.now().Add(-m.retention)
+	for id, e := range m.ops {
+		select {
+		case <-e.done:
+		default:
+			continue
+		}
+		if e.op.GetUpdatedAt().AsTime().Before(cutoff) {
+			delete(m.ops, id)
+		}
+	}
+}
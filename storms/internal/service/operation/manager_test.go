@@ -0,0 +1,153 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+)
+
+func Test_Manager(t *testing.T) {
+	snapshot := &storms.Snapshot{Uuid: uuid.NewString()}
+
+	tests := []struct {
+		name            string
+		fn              Func
+		cancel          bool
+		expectState     storms.OperationState
+		expectErrorCode codes.Code
+	}{
+		{
+			name: "succeeded",
+			fn: func(context.Context) (proto.Message, error) {
+				return snapshot, nil
+			},
+			expectState: storms.OperationState_OPERATION_STATE_SUCCEEDED,
+		},
+		{
+			name: "failed",
+			fn: func(context.Context) (proto.Message, error) {
+				return nil, status.Error(codes.ResourceExhausted, "cluster is full")
+			},
+			expectState:     storms.OperationState_OPERATION_STATE_FAILED,
+			expectErrorCode: codes.ResourceExhausted,
+		},
+		{
+			name: "failed without status",
+			fn: func(context.Context) (proto.Message, error) {
+				return nil, errors.New("boom") //nolint:err113 // test error
+			},
+			expectState:     storms.OperationState_OPERATION_STATE_FAILED,
+			expectErrorCode: codes.Unknown,
+		},
+		{
+			name: "cancelled",
+			fn: func(ctx context.Context) (proto.Message, error) {
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			},
+			cancel:          true,
+			expectState:     storms.OperationState_OPERATION_STATE_CANCELLED,
+			expectErrorCode: codes.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(0)
+			defer m.Stop()
+
+			started := m.Start("CreateSnapshot", storms.ResourceType_RESOURCE_TYPE_SNAPSHOT, snapshot.GetUuid(), tt.fn)
+			require.NotEmpty(t, started.GetId())
+
+			var (
+				op  *storms.Operation
+				err error
+			)
+			if tt.cancel {
+				op, err = m.Cancel(context.Background(), started.GetId())
+			} else {
+				op, err = m.Wait(context.Background(), started.GetId())
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectState, op.GetState())
+			require.Equal(t, uint32(tt.expectErrorCode), op.GetErrorCode())
+			if tt.expectState == storms.OperationState_OPERATION_STATE_SUCCEEDED {
+				require.True(t, proto.Equal(snapshot, op.GetSnapshot()))
+			}
+
+			_, err = m.Cancel(context.Background(), started.GetId())
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	}
+}
+
+func Test_Manager_Wait(t *testing.T) {
+	m := NewManager(0)
+	defer m.Stop()
+
+	_, err := m.Wait(context.Background(), uuid.NewString())
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	block := make(chan struct{})
+	defer close(block)
+	started := m.Start("DeleteVolume", storms.ResourceType_RESOURCE_TYPE_VOLUME, uuid.NewString(),
+		func(context.Context) (proto.Message, error) {
+			<-block
+
+			return nil, nil //nolint:nilnil // deletions have no resulting resource
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	op, err := m.Wait(ctx, started.GetId())
+	require.NoError(t, err)
+	require.Equal(t, storms.OperationState_OPERATION_STATE_IN_PROGRESS, op.GetState())
+}
+
+func Test_Manager_Retention(t *testing.T) {
+	now := time.Date(2025, 11, 20, 14, 45, 0, 0, time.UTC)
+	m := NewManager(time.Minute)
+	m.now = func() time.Time { return now }
+	defer m.Stop()
+
+	started := m.Start("DeleteVolume", storms.ResourceType_RESOURCE_TYPE_VOLUME, uuid.NewString(),
+		func(context.Context) (proto.Message, error) {
+			return nil, nil //nolint:nilnil // deletions have no resulting resource
+		})
+	_, err := m.Wait(context.Background(), started.GetId())
+	require.NoError(t, err)
+	require.Len(t, m.List(), 1)
+
+	now = now.Add(2 * time.Minute)
+	require.Empty(t, m.List())
+	_, err = m.Get(started.GetId())
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func Test_IDFromContext(t *testing.T) {
+	m := NewManager(0)
+	defer m.Stop()
+
+	var id string
+	started := m.Start("DeleteVolume", storms.ResourceType_RESOURCE_TYPE_VOLUME, uuid.NewString(),
+		func(ctx context.Context) (proto.Message, error) {
+			id = IDFromContext(ctx)
+
+			return nil, nil //nolint:nilnil // deletions have no resulting resource
+		})
+
+	_, err := m.Wait(context.Background(), started.GetId())
+	require.NoError(t, err)
+	require.Equal(t, started.GetId(), id)
+	require.Empty(t, IDFromContext(context.Background()))
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/audit"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/operation"
+)
+
+const (
+	// Longest time an operation waits for its resource to become available once the vendor accepted the change.
+	defaultAvailabilityTimeout = 10 * time.Minute
+	// Longest time between polls of a resource that is not yet available.
+	maxAvailabilityBackoff = 30 * time.Second
+)
+
+var (
+	errOperationsDisabled  = status.Error(codes.FailedPrecondition, "async operations are not enabled")
+	errResourceFailed      = status.Error(codes.Internal, "storage vendor reports the resource as failed")
+	errResourceUnavailable = status.Error(codes.DeadlineExceeded, "resource did not become available")
+)
+
+// A volume or snapshot, as reported by its vendor.
+type availabilityReporter interface {
+	proto.Message
+	GetIsAvailable() bool
+	GetIsFailed() bool
+}
+
+// A mutating request, run either inline or in the background as an operation.
+type mutation struct {
+	async        bool
+	method       string
+	resourceType storms.ResourceType
+	resourceID   string
+	// Clusters run uses. Those removed meanwhile are kept as removing until run returns.
+	clusterIDs []string
+	run        func(ctx context.Context) error
+	// Fetches the resource resulting from a synchronous run. Nil for deletions, which have no resulting resource and
+	// whose operations do not wait for one.
+	result func(ctx context.Context) proto.Message
+	// Called once run has returned, or immediately if run is never started. May be nil.
+	release func()
+}
+
+// Runs a mutation inline, or in the background if it is async, in which case the ID of its operation is returned.
+// A synchronous request responds once run returns, when the vendor has accepted the change. An operation with a
+// resulting resource succeeds only once the vendor also reports that resource as available, and records it as
+// available.
+func (s *Service) runMutation(ctx context.Context, m mutation) (string, error) {
+	releaseClusters := s.clusterUsage.Acquire(m.clusterIDs...)
+	release := func() {
+		releaseClusters()
+		if m.release != nil {
+			m.release()
+		}
+	}
+
+	if !m.async {
+		defer release()
+
+		return "", m.run(ctx)
+	}
+	if s.operations == nil {
+		release()
+
+		return "", errOperationsDisabled
+	}
+
+	var auditEntry *audit.Entry
+	if s.audit != nil {
+		auditEntry = s.newAuditEntry(ctx, storageMethod(m.method), m.resourceID, nil)
+	}
+	op := s.operations.Start(m.method, m.resourceType, m.resourceID, func(ctx context.Context) (proto.Message, error) {
+		defer release()
+		err := m.run(ctx)
+		var result proto.Message
+		if err == nil && m.result != nil {
+			result, err = s.awaitAvailable(ctx, m.resourceType, m.resourceID)
+		}
+		if auditEntry != nil {
+			auditEntry.OperationID = operation.IDFromContext(ctx)
+			s.recordAudit(auditEntry, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if m.result == nil {
+			return nil, nil //nolint:nilnil // deletions have no resulting resource
+		}
+
+		return result, nil
+	})
+
+	return op.GetId(), nil
+}
+
+// Polls a volume or snapshot until its vendor reports it as available, and returns it. Polls start straight away,
+// then back off from the workflow poll interval up to maxAvailabilityBackoff. Failures to poll are retried until the
+// availability timeout, after which the resource is reported unavailable. Fails straight away if the vendor reports
+// the resource as failed.
+func (s *Service) awaitAvailable(ctx context.Context, resourceType storms.ResourceType, resourceID string,
+) (proto.Message, error) {
+	timeout := s.availabilityTimeout
+	if timeout == 0 {
+		timeout = defaultAvailabilityTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := s.pollInterval()
+	for {
+		r, err := s.getAvailabilityReporter(ctx, resourceType, resourceID)
+		switch {
+		case err != nil:
+			log.Warn().Str("resource_id", resourceID).Err(err).Msg("failed to poll resource availability, retrying")
+		case r.GetIsFailed():
+			return nil, fmt.Errorf("%w: %s", errResourceFailed, resourceID)
+		case r.GetIsAvailable():
+			return r, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%w after %s: %s", errResourceUnavailable, timeout, resourceID)
+			}
+
+			return nil, fmt.Errorf("failed to wait for resource to become available: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxAvailabilityBackoff)
+	}
+}
+
+func (s *Service) getAvailabilityReporter(ctx context.Context, resourceType storms.ResourceType, resourceID string,
+) (availabilityReporter, error) {
+	switch resourceType {
+	case storms.ResourceType_RESOURCE_TYPE_VOLUME:
+		resp, err := s.GetVolume(ctx, &storms.GetVolumeRequest{Uuid: resourceID})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.GetVolume(), nil
+	case storms.ResourceType_RESOURCE_TYPE_SNAPSHOT:
+		resp, err := s.GetSnapshot(ctx, &storms.GetSnapshotRequest{Uuid: resourceID})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.GetSnapshot(), nil
+	}
+
+	return nil, errUnexpected
+}
+
+// Returns a function fetching the volume, for use as a mutation result. Failures leave the result unset.
+func (s *Service) volumeResult(volID string) func(ctx context.Context) proto.Message {
+	return func(ctx context.Context) proto.Message {
+		resp, err := s.GetVolume(ctx, &storms.GetVolumeRequest{Uuid: volID})
+		if err != nil {
+			log.Warn().Str("resource_id", volID).Err(err).Msg("failed to fetch volume for operation result")
+
+			return nil
+		}
+
+		return resp.GetVolume()
+	}
+}
+
+// Returns a function fetching the snapshot, for use as a mutation result. Failures leave the result unset.
+func (s *Service) snapshotResult(snapshotID string) func(ctx context.Context) proto.Message {
+	return func(ctx context.Context) proto.Message {
+		resp, err := s.GetSnapshot(ctx, &storms.GetSnapshotRequest{Uuid: snapshotID})
+		if err != nil {
+			log.Warn().Str("resource_id", snapshotID).Err(err).Msg("failed to fetch snapshot for operation result")
+
+			return nil
+		}
+
+		return resp.GetSnapshot()
+	}
+}
+
+func (s *Service) GetOperation(_ context.Context, req *storms.GetOperationRequest,
+) (*storms.GetOperationResponse, error) {
+	if s.operations == nil {
+		return nil, errOperationsDisabled
+	}
+
+	op, err := s.operations.Get(req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation: %w", err)
+	}
+
+	return &storms.GetOperationResponse{Operation: op}, nil
+}
+
+func (s *Service) ListOperations(_ context.Context, req *storms.ListOperationsRequest,
+) (*storms.ListOperationsResponse, error) {
+	if s.operations == nil {
+		return nil, errOperationsDisabled
+	}
+
+	after, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page token: %w", err)
+	}
+
+	ops := []*storms.Operation{}
+	for _, op := range s.operations.List() {
+		if req.GetResourceUuid() != "" && op.GetResourceUuid() != req.GetResourceUuid() {
+			continue
+		}
+		if req.GetState() != storms.OperationState_OPERATION_STATE_UNSPECIFIED && op.GetState() != req.GetState() {
+			continue
+		}
+		ops = append(ops, op)
+	}
+
+	page, nextPageToken := paginate(ops, (*storms.Operation).GetId, after, req.GetPageSize())
+
+	return &storms.ListOperationsResponse{
+		Operations:    page,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) WaitOperation(ctx context.Context, req *storms.WaitOperationRequest,
+) (*storms.WaitOperationResponse, error) {
+	if s.operations == nil {
+		return nil, errOperationsDisabled
+	}
+
+	if req.GetTimeout() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, max(req.GetTimeout().AsDuration(), time.Duration(0)))
+		defer cancel()
+	}
+
+	op, err := s.operations.Wait(ctx, req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for operation: %w", err)
+	}
+
+	return &storms.WaitOperationResponse{Operation: op}, nil
+}
+
+func (s *Service) CancelOperation(ctx context.Context, req *storms.CancelOperationRequest,
+) (*storms.CancelOperationResponse, error) {
+	if s.operations == nil {
+		return nil, errOperationsDisabled
+	}
+
+	op, err := s.operations.Cancel(ctx, req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel operation: %w", err)
+	}
+
+	return &storms.CancelOperationResponse{Operation: op}, nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/operation"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+)
+
+func Test_runMutation(t *testing.T) {
+	volID := uuid.NewString()
+	available := &storms.Volume{Uuid: volID, IsAvailable: true}
+	unavailable := &storms.Volume{Uuid: volID}
+
+	tests := []struct {
+		name            string
+		disabled        bool
+		async           bool
+		runErr          error
+		polls           []*storms.Volume
+		expectCode      codes.Code
+		expectOperation bool
+		expectState     storms.OperationState
+		expectErrorCode codes.Code
+	}{
+		{
+			name:       "sync",
+			expectCode: codes.OK,
+		},
+		{
+			name:       "sync failure",
+			runErr:     status.Error(codes.NotFound, "volume not found"),
+			expectCode: codes.NotFound,
+		},
+		{
+			name:            "async",
+			async:           true,
+			polls:           []*storms.Volume{available},
+			expectCode:      codes.OK,
+			expectOperation: true,
+			expectState:     storms.OperationState_OPERATION_STATE_SUCCEEDED,
+		},
+		{
+			name:            "async available late",
+			async:           true,
+			polls:           []*storms.Volume{unavailable, nil, unavailable, available},
+			expectCode:      codes.OK,
+			expectOperation: true,
+			expectState:     storms.OperationState_OPERATION_STATE_SUCCEEDED,
+		},
+		{
+			name:            "async failed by the vendor",
+			async:           true,
+			polls:           []*storms.Volume{unavailable, {Uuid: volID, IsFailed: true}},
+			expectCode:      codes.OK,
+			expectOperation: true,
+			expectState:     storms.OperationState_OPERATION_STATE_FAILED,
+			expectErrorCode: codes.Internal,
+		},
+		{
+			name:            "async never available",
+			async:           true,
+			polls:           []*storms.Volume{unavailable},
+			expectCode:      codes.OK,
+			expectOperation: true,
+			expectState:     storms.OperationState_OPERATION_STATE_FAILED,
+			expectErrorCode: codes.DeadlineExceeded,
+		},
+		{
+			name:            "async failure",
+			async:           true,
+			runErr:          status.Error(codes.FailedPrecondition, "volume is attached"),
+			expectCode:      codes.OK,
+			expectOperation: true,
+			expectState:     storms.OperationState_OPERATION_STATE_FAILED,
+			expectErrorCode: codes.FailedPrecondition,
+		},
+		{
+			name:       "async with operations disabled",
+			disabled:   true,
+			async:      true,
+			expectCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The vendor reports the volumes polled in turn, repeating the last one. A nil volume fails the poll.
+			polls := tt.polls
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet: func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+				},
+				resourceManager: &resourcemocks.MockResourceManager{
+					MockGetResourceCluster: func(string) (string, error) { return clusterID1, nil },
+				},
+				clientTranslator: &translatormocks.MockClientTranslator{
+					MockGetVolume: func(context.Context, client.Client, *storms.GetVolumeRequest,
+					) (*storms.GetVolumeResponse, error) {
+						volume := polls[0]
+						if len(polls) > 1 {
+							polls = polls[1:]
+						}
+						if volume == nil {
+							return nil, status.Error(codes.Unavailable, "cluster is down")
+						}
+
+						return &storms.GetVolumeResponse{Volume: volume}, nil
+					},
+				},
+				workflowPollInterval: time.Millisecond,
+				availabilityTimeout:  50 * time.Millisecond,
+			}
+			if !tt.disabled {
+				s.operations = operation.NewManager(0)
+				defer s.operations.Stop()
+			}
+			released := false
+
+			opID, err := s.runMutation(context.Background(), mutation{
+				async:        tt.async,
+				method:       "CreateVolume",
+				resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+				resourceID:   volID,
+				run:          func(context.Context) error { return tt.runErr },
+				result:       func(context.Context) proto.Message { return unavailable },
+				release:      func() { released = true },
+			})
+			require.Equal(t, tt.expectCode, status.Code(err))
+			if !tt.expectOperation {
+				require.Empty(t, opID)
+				require.True(t, released)
+
+				return
+			}
+
+			resp, err := s.WaitOperation(context.Background(), &storms.WaitOperationRequest{Id: opID})
+			require.NoError(t, err)
+			require.True(t, released)
+			op := resp.GetOperation()
+			require.Equal(t, tt.expectState, op.GetState())
+			require.Equal(t, volID, op.GetResourceUuid())
+			require.Equal(t, uint32(tt.expectErrorCode), op.GetErrorCode())
+			if tt.expectState == storms.OperationState_OPERATION_STATE_SUCCEEDED {
+				// The operation records the volume as last polled, once available.
+				require.True(t, proto.Equal(available, op.GetVolume()))
+			}
+		})
+	}
+}
+
+func Test_ListOperations(t *testing.T) {
+	s := &Service{operations: operation.NewManager(0)}
+	defer s.operations.Stop()
+
+	volID := uuid.NewString()
+	block := make(chan struct{})
+	defer close(block)
+	pending := s.operations.Start("DeleteVolume", storms.ResourceType_RESOURCE_TYPE_VOLUME, volID,
+		func(ctx context.Context) (proto.Message, error) {
+			<-block
+
+			return nil, nil //nolint:nilnil // deletions have no resulting resource
+		})
+	for range 2 {
+		op := s.operations.Start("CreateSnapshot", storms.ResourceType_RESOURCE_TYPE_SNAPSHOT, uuid.NewString(),
+			func(context.Context) (proto.Message, error) {
+				return nil, nil //nolint:nilnil // no result needed
+			})
+		_, err := s.operations.Wait(context.Background(), op.GetId())
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name      string
+		req       *storms.ListOperationsRequest
+		expectIDs int
+	}{
+		{
+			name:      "all",
+			req:       &storms.ListOperationsRequest{},
+			expectIDs: 3,
+		},
+		{
+			name:      "by resource",
+			req:       &storms.ListOperationsRequest{ResourceUuid: volID},
+			expectIDs: 1,
+		},
+		{
+			name:      "by state",
+			req:       &storms.ListOperationsRequest{State: storms.OperationState_OPERATION_STATE_SUCCEEDED},
+			expectIDs: 2,
+		},
+		{
+			name:      "paginated",
+			req:       &storms.ListOperationsRequest{PageSize: 1},
+			expectIDs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.ListOperations(context.Background(), tt.req)
+			require.NoError(t, err)
+			require.Len(t, resp.GetOperations(), tt.expectIDs)
+			if tt.req.GetResourceUuid() != "" {
+				require.Equal(t, pending.GetId(), resp.GetOperations()[0].GetId())
+			}
+		})
+	}
+}
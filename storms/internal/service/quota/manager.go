@@ -0,0 +1,124 @@
+package quota
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+var ErrQuotaExceeded = status.Error(codes.ResourceExhausted, "quota exceeded")
+
+// Limits on the resources a tenant may own. A limit of 0 is unlimited.
+//
+//nolint:tagliatelle // using snake case for YAML
+type Limits struct {
+	MaxVolumes          int    `yaml:"max_volumes"`
+	MaxSnapshots        int    `yaml:"max_snapshots"`
+	MaxProvisionedBytes uint64 `yaml:"max_provisioned_bytes"`
+}
+
+// Sums up the resources recorded as owned by each tenant.
+type usageSource interface {
+	GetTenantUsage(tenantID string) resource.Usage
+}
+
+// Manager enforces the limits of each tenant. Usage is read from the resources recorded as owned by the tenant, plus
+// reservations held by creates and resizes that have passed the check but whose resources are not yet recorded.
+type Manager struct {
+	mu sync.Mutex
+
+	usage usageSource
+	// Limits of tenants without limits of their own.
+	defaults Limits
+	tenants  map[string]Limits
+	reserved map[string]resource.Usage
+}
+
+func NewManager(usage usageSource) *Manager {
+	return &Manager{
+		usage:    usage,
+		tenants:  make(map[string]Limits),
+		reserved: make(map[string]resource.Usage),
+	}
+}
+
+// SetLimits replaces the limits of all tenants. Tenants missing from tenants get defaults.
+func (m *Manager) SetLimits(defaults Limits, tenants map[string]Limits) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.defaults = defaults
+	m.tenants = make(map[string]Limits, len(tenants))
+	for tenantID, limits := range tenants {
+		m.tenants[tenantID] = limits
+	}
+}
+
+// Limits returns the limits of a tenant.
+func (m *Manager) Limits(tenantID string) Limits {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.limits(tenantID)
+}
+
+func (m *Manager) limits(tenantID string) Limits {
+	if limits, ok := m.tenants[tenantID]; ok {
+		return limits
+	}
+
+	return m.defaults
+}
+
+// Reserve checks that the tenant stays within its limits once its usage grows by delta, and holds delta against its
+// limits until the returned function is called. Callers record the ownership of the created or resized resource
+// before releasing the reservation. Only the limits delta grows are checked, so a tenant over a lowered limit can
+// still create resources of other kinds.
+func (m *Manager) Reserve(tenantID string, delta resource.Usage) (func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	limits := m.limits(tenantID)
+	usage := m.usage.GetTenantUsage(tenantID).Add(m.reserved[tenantID]).Add(delta)
+	if delta.Volumes > 0 && limits.MaxVolumes > 0 && usage.Volumes > limits.MaxVolumes {
+		return nil, fmt.Errorf("%w: tenant %s would own %d volumes, over its limit of %d", ErrQuotaExceeded, tenantID,
+			usage.Volumes, limits.MaxVolumes)
+	}
+	if delta.Snapshots > 0 && limits.MaxSnapshots > 0 && usage.Snapshots > limits.MaxSnapshots {
+		return nil, fmt.Errorf("%w: tenant %s would own %d snapshots, over its limit of %d", ErrQuotaExceeded,
+			tenantID, usage.Snapshots, limits.MaxSnapshots)
+	}
+	if delta.ProvisionedBytes > 0 && limits.MaxProvisionedBytes > 0 &&
+		usage.ProvisionedBytes > limits.MaxProvisionedBytes {
+		return nil, fmt.Errorf("%w: tenant %s would provision %d bytes, over its limit of %d", ErrQuotaExceeded,
+			tenantID, usage.ProvisionedBytes, limits.MaxProvisionedBytes)
+	}
+
+	m.reserved[tenantID] = m.reserved[tenantID].Add(delta)
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() { m.release(tenantID, delta) })
+	}, nil
+}
+
+func (m *Manager) release(tenantID string, delta resource.Usage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reserved := m.reserved[tenantID]
+	reserved.Volumes -= delta.Volumes
+	reserved.Snapshots -= delta.Snapshots
+	reserved.ProvisionedBytes -= delta.ProvisionedBytes
+	if reserved == (resource.Usage{}) {
+		delete(m.reserved, tenantID)
+
+		return
+	}
+	m.reserved[tenantID] = reserved
+}
@@ -0,0 +1,106 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+type mockUsageSource map[string]resource.Usage
+
+func (m mockUsageSource) GetTenantUsage(tenantID string) resource.Usage {
+	return m[tenantID]
+}
+
+func Test_Reserve(t *testing.T) {
+	tests := []struct {
+		name      string
+		usage     resource.Usage
+		delta     resource.Usage
+		expectErr bool
+	}{
+		{
+			name:  "within limits",
+			usage: resource.Usage{Volumes: 1, ProvisionedBytes: 1024},
+			delta: resource.Usage{Volumes: 1, ProvisionedBytes: 1024},
+		},
+		{
+			name:      "volumes exceeded",
+			usage:     resource.Usage{Volumes: 2},
+			delta:     resource.Usage{Volumes: 1},
+			expectErr: true,
+		},
+		{
+			name:      "provisioned bytes exceeded",
+			usage:     resource.Usage{ProvisionedBytes: 3072},
+			delta:     resource.Usage{ProvisionedBytes: 2048},
+			expectErr: true,
+		},
+		{
+			name:      "snapshots exceeded",
+			usage:     resource.Usage{Snapshots: 1},
+			delta:     resource.Usage{Snapshots: 1},
+			expectErr: true,
+		},
+		{
+			name:  "limits not grown by delta are not checked",
+			usage: resource.Usage{Volumes: 5},
+			delta: resource.Usage{ProvisionedBytes: 1024},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(mockUsageSource{"a": tt.usage})
+			m.SetLimits(Limits{MaxSnapshots: 1}, map[string]Limits{
+				"a": {MaxVolumes: 2, MaxSnapshots: 1, MaxProvisionedBytes: 4096},
+			})
+
+			release, err := m.Reserve("a", tt.delta)
+			if tt.expectErr {
+				require.ErrorIs(t, err, ErrQuotaExceeded)
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+				return
+			}
+			require.NoError(t, err)
+			release()
+		})
+	}
+}
+
+func Test_Reserve_HeldUntilReleased(t *testing.T) {
+	m := NewManager(mockUsageSource{})
+	m.SetLimits(Limits{MaxVolumes: 1}, nil)
+
+	release, err := m.Reserve("a", resource.Usage{Volumes: 1})
+	require.NoError(t, err)
+
+	// A concurrent create is rejected while the first one holds its reservation, but other tenants are not.
+	_, err = m.Reserve("a", resource.Usage{Volumes: 1})
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+	releaseB, err := m.Reserve("b", resource.Usage{Volumes: 1})
+	require.NoError(t, err)
+	releaseB()
+
+	// Releasing more than once has no further effect.
+	release()
+	release()
+	require.Empty(t, m.reserved)
+
+	release, err = m.Reserve("a", resource.Usage{Volumes: 1})
+	require.NoError(t, err)
+	release()
+}
+
+func Test_Limits(t *testing.T) {
+	m := NewManager(mockUsageSource{})
+	m.SetLimits(Limits{MaxVolumes: 10}, map[string]Limits{"a": {MaxVolumes: 2}})
+
+	require.Equal(t, Limits{MaxVolumes: 2}, m.Limits("a"))
+	require.Equal(t, Limits{MaxVolumes: 10}, m.Limits("b"))
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	appconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/app/configs"
+	serviceconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/configs"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/quota"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+)
+
+// Tenants own the resources created for them, and copies and clones of those resources. Ownership is recorded by
+// the resource manager and dropped when the resource is deleted. Creates and resizes reserve the usage they add
+// against the tenant's quota before calling the client, and release the reservation once ownership is recorded.
+// Once quotas are configured, every new resource must have a tenant, so that none escapes its limits.
+var (
+	errTenantRequired      = status.Error(codes.InvalidArgument, "tenant_id is required when quotas are configured")
+	errSourceWithoutTenant = status.Error(codes.FailedPrecondition,
+		"source has no tenant, which is required when quotas are configured")
+)
+
+// Load tenant limits from the quota file, if one is configured.
+func (s *Service) loadQuotaConfig() error {
+	filename := appconfigs.Get().QuotaFile
+	if filename == "" || s.quotas == nil {
+		return nil
+	}
+
+	quotasConfig, err := serviceconfigs.LoadQuotaConfig(filename)
+	if err != nil {
+		return fmt.Errorf("failed to load quota config: %w", err)
+	}
+
+	s.quotas.SetLimits(quotasConfig.Default, quotasConfig.Tenants)
+	s.requireTenants = true
+	log.Info().Msgf("Loaded quota config from file: %s", filename)
+
+	return nil
+}
+
+// Reserves delta against the quota of a tenant until the returned function is called. Resources without a tenant
+// are not limited.
+func (s *Service) reserveQuota(tenantID string, delta resource.Usage) (func(), error) {
+	if tenantID == "" || s.quotas == nil {
+		return func() {}, nil
+	}
+
+	release, err := s.quotas.Reserve(tenantID, delta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve quota: %w", err)
+	}
+
+	return release, nil
+}
+
+// Fails with err if a new resource would have no tenant while quotas are configured.
+func (s *Service) requireTenant(tenantID string, err error) error {
+	if tenantID == "" && s.requireTenants {
+		return err
+	}
+
+	return nil
+}
+
+// Records the tenant owning a created or resized resource. Failures are logged, leaving the resource out of the
+// tenant's usage.
+func (s *Service) recordOwnership(resourceID, tenantID string, sizeBytes uint64) {
+	if tenantID == "" {
+		return
+	}
+
+	err := s.resourceManager.SetOwnership(resourceID, resource.Ownership{TenantID: tenantID, SizeBytes: sizeBytes})
+	if err != nil {
+		log.Warn().Str("resource_id", resourceID).Interface("err", err).Msg("failed to record ownership")
+	}
+}
+
+// Returns the provisioned size of the volume a create request asks for.
+func (s *Service) requestedVolumeSize(ctx context.Context, req *storms.CreateVolumeRequest) (uint64, error) {
+	switch source := req.GetSource().(type) {
+	case *storms.CreateVolumeRequest_FromNew:
+		return source.FromNew.GetSize(), nil
+	case *storms.CreateVolumeRequest_FromSnapshot:
+		resp, err := s.GetSnapshot(ctx, &storms.GetSnapshotRequest{Uuid: source.FromSnapshot.GetSnapshotUuid()})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get source snapshot: %w", err)
+		}
+
+		return resp.GetSnapshot().GetSize(), nil
+	}
+
+	return 0, errUnexpected
+}
+
+// Returns a function calling both release functions.
+func releaseBoth(first, second func()) func() {
+	return func() {
+		first()
+		second()
+	}
+}
+
+func (s *Service) GetTenantUsage(_ context.Context, req *storms.GetTenantUsageRequest,
+) (*storms.GetTenantUsageResponse, error) {
+	usage := s.resourceManager.GetTenantUsage(req.GetTenantId())
+	limits := quota.Limits{}
+	if s.quotas != nil {
+		limits = s.quotas.Limits(req.GetTenantId())
+	}
+
+	//nolint:gosec // counts and limits are non-negative and far below the uint32 range
+	return &storms.GetTenantUsageResponse{
+		Usage: &storms.TenantUsage{
+			Volumes:          uint32(usage.Volumes),
+			Snapshots:        uint32(usage.Snapshots),
+			ProvisionedBytes: usage.ProvisionedBytes,
+		},
+		Quota: &storms.TenantQuota{
+			MaxVolumes:          uint32(limits.MaxVolumes),
+			MaxSnapshots:        uint32(limits.MaxSnapshots),
+			MaxProvisionedBytes: limits.MaxProvisionedBytes,
+		},
+	}, nil
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	alloc "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
+	allocatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/quota"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+)
+
+func Test_CreateVolume_Quota(t *testing.T) {
+	tests := []struct {
+		name      string
+		usage     resource.Usage
+		expectErr bool
+	}{
+		{
+			name:  "within quota",
+			usage: resource.Usage{Volumes: 1, ProvisionedBytes: defaultOSDiskSizeBytes},
+		},
+		{
+			name:      "volumes exhausted",
+			usage:     resource.Usage{Volumes: 2},
+			expectErr: true,
+		},
+		{
+			name:      "provisioned bytes exhausted",
+			usage:     resource.Usage{Volumes: 1, ProvisionedBytes: 2 * defaultOSDiskSizeBytes},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			volID := uuid.NewString()
+			created := false
+			var owner resource.Ownership
+			resources := &resourcemocks.MockResourceManager{
+				MockGetResourceCluster: func(string) (string, error) { return "", errUnmapped },
+				MockMap:                func(*resource.Resource) error { return nil },
+				MockGetTenantUsage:     func(string) resource.Usage { return tt.usage },
+				MockSetOwnership: func(_ string, o resource.Ownership) error {
+					owner = o
+
+					return nil
+				},
+			}
+			quotas := quota.NewManager(resources)
+			quotas.SetLimits(quota.Limits{}, map[string]quota.Limits{
+				"a": {MaxVolumes: 2, MaxProvisionedBytes: 2 * defaultOSDiskSizeBytes},
+			})
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet:    func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+					MockAllIDs: func() []string { return []string{clusterID1} },
+				},
+				resourceManager: resources,
+				allocator: &allocatormocks.MockAllocator{
+					MockAllocateCluster: func(context.Context, *alloc.Request) (string, error) { return clusterID1, nil },
+				},
+				clientTranslator: &translatormocks.MockClientTranslator{
+					MockGetVolume: func(context.Context, client.Client, *storms.GetVolumeRequest,
+					) (*storms.GetVolumeResponse, error) {
+						return nil, models.ErrNotFound
+					},
+					MockCreateVolume: func(context.Context, client.Client, *storms.CreateVolumeRequest,
+					) (*storms.CreateVolumeResponse, error) {
+						created = true
+
+						return &storms.CreateVolumeResponse{}, nil
+					},
+				},
+				quotas: quotas,
+			}
+
+			_, err := s.CreateVolume(context.Background(), &storms.CreateVolumeRequest{
+				Uuid:     volID,
+				TenantId: "a",
+				Source: &storms.CreateVolumeRequest_FromNew{
+					FromNew: &storms.NewVolumeSpec{Size: defaultOSDiskSizeBytes, SectorSize: sectorSize512},
+				},
+			})
+			if tt.expectErr {
+				require.ErrorIs(t, err, quota.ErrQuotaExceeded)
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+				require.False(t, created)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, created)
+			require.Equal(t, resource.Ownership{TenantID: "a", SizeBytes: defaultOSDiskSizeBytes}, owner)
+
+			// The reservation is released once the volume is recorded.
+			release, err := quotas.Reserve("a", resource.Usage{ProvisionedBytes: defaultOSDiskSizeBytes})
+			require.NoError(t, err)
+			release()
+		})
+	}
+}
+
+func Test_ResizeVolume_Quota(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      uint64
+		expectErr bool
+	}{
+		{name: "within quota", size: 2048},
+		{name: "provisioned bytes exhausted", size: 4096, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := resource.Ownership{TenantID: "a", SizeBytes: 1024}
+			resources := &resourcemocks.MockResourceManager{
+				MockGetResourceCluster: func(string) (string, error) { return clusterID1, nil },
+				MockGetOwnership:       func(string) (resource.Ownership, bool) { return owner, true },
+				MockGetTenantUsage: func(string) resource.Usage {
+					return resource.Usage{Volumes: 1, ProvisionedBytes: owner.SizeBytes}
+				},
+				MockSetOwnership: func(_ string, o resource.Ownership) error {
+					owner = o
+
+					return nil
+				},
+			}
+			quotas := quota.NewManager(resources)
+			quotas.SetLimits(quota.Limits{MaxProvisionedBytes: 3072}, nil)
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet: func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+				},
+				resourceManager: resources,
+				clientTranslator: &translatormocks.MockClientTranslator{
+					MockResizeVolume: func(context.Context, client.Client, *storms.ResizeVolumeRequest,
+					) (*storms.ResizeVolumeResponse, error) {
+						return &storms.ResizeVolumeResponse{}, nil
+					},
+				},
+				quotas: quotas,
+			}
+
+			_, err := s.ResizeVolume(context.Background(), &storms.ResizeVolumeRequest{
+				Uuid: resourceID1,
+				Size: tt.size,
+			})
+			if tt.expectErr {
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+				require.Equal(t, uint64(1024), owner.SizeBytes)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, resource.Ownership{TenantID: "a", SizeBytes: tt.size}, owner)
+		})
+	}
+}
+
+func Test_TenantRequired(t *testing.T) {
+	srcID := uuid.NewString()
+	f := &copyFixture{mappings: map[string]string{srcID: clusterID1}}
+	s := f.service()
+	s.allocator = &allocatormocks.MockAllocator{
+		MockAllocateCluster: func(context.Context, *alloc.Request) (string, error) { return clusterID1, nil },
+	}
+	s.clusterManager = &clustermocks.MockClusterManager{
+		MockGet:    func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+		MockAllIDs: func() []string { return []string{clusterID1} },
+	}
+	s.requireTenants = true
+
+	_, err := s.CreateVolume(context.Background(), &storms.CreateVolumeRequest{
+		Uuid: uuid.NewString(),
+		Source: &storms.CreateVolumeRequest_FromNew{
+			FromNew: &storms.NewVolumeSpec{Size: defaultOSDiskSizeBytes, SectorSize: sectorSize512},
+		},
+	})
+	require.ErrorIs(t, err, errTenantRequired)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Copies and clones take the tenant of their source, which has none.
+	_, err = s.CloneVolume(context.Background(), &storms.CloneVolumeRequest{
+		Uuid:          uuid.NewString(),
+		SrcVolumeUuid: srcID,
+	})
+	require.ErrorIs(t, err, errSourceWithoutTenant)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.CreateSnapshot(context.Background(), &storms.CreateSnapshotRequest{
+		Uuid:          uuid.NewString(),
+		SrcVolumeUuid: srcID,
+	})
+	require.ErrorIs(t, err, errTenantRequired)
+	require.Empty(t, f.created)
+}
+
+func Test_CopyVolume_TenantRequired(t *testing.T) {
+	srcID := uuid.NewString()
+	f := &copyFixture{mappings: map[string]string{srcID: clusterID1}}
+	s := f.service()
+	s.requireTenants = true
+
+	_, err := s.CopyVolume(context.Background(), &storms.CopyVolumeRequest{
+		Uuid:          uuid.NewString(),
+		SrcVolumeUuid: srcID,
+		DstClusterId:  clusterID2,
+	})
+	require.ErrorIs(t, err, errSourceWithoutTenant)
+	require.Empty(t, f.created)
+}
+
+func Test_RevertVolumeToSnapshot_Ownership(t *testing.T) {
+	snapshotID := uuid.NewString()
+	owner := resource.Ownership{TenantID: "a", SizeBytes: 2048}
+	s := &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockGet: func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockGetResourceCluster: func(string) (string, error) { return clusterID1, nil },
+			MockGetOwnership:       func(string) (resource.Ownership, bool) { return owner, true },
+			MockSetOwnership: func(_ string, o resource.Ownership) error {
+				owner = o
+
+				return nil
+			},
+		},
+		clientTranslator: &translatormocks.MockClientTranslator{
+			MockRevertVolumeToSnapshot: func(context.Context, client.Client, *storms.RevertVolumeToSnapshotRequest,
+			) (*storms.RevertVolumeToSnapshotResponse, error) {
+				return &storms.RevertVolumeToSnapshotResponse{}, nil
+			},
+			// The volume takes the size of the snapshot.
+			MockGetVolume: func(_ context.Context, _ client.Client, req *storms.GetVolumeRequest,
+			) (*storms.GetVolumeResponse, error) {
+				return &storms.GetVolumeResponse{Volume: &storms.Volume{Uuid: req.GetUuid(), Size: 1024}}, nil
+			},
+		},
+	}
+
+	_, err := s.RevertVolumeToSnapshot(context.Background(), &storms.RevertVolumeToSnapshotRequest{
+		Uuid:         resourceID1,
+		SnapshotUuid: snapshotID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, resource.Ownership{TenantID: "a", SizeBytes: 1024}, owner)
+}
+
+func Test_GetTenantUsage(t *testing.T) {
+	resources := &resourcemocks.MockResourceManager{
+		MockGetTenantUsage: func(tenantID string) resource.Usage {
+			if tenantID == "a" {
+				return resource.Usage{Volumes: 2, Snapshots: 1, ProvisionedBytes: 2048}
+			}
+
+			return resource.Usage{}
+		},
+	}
+	quotas := quota.NewManager(resources)
+	quotas.SetLimits(quota.Limits{MaxVolumes: 10}, map[string]quota.Limits{"a": {MaxVolumes: 5, MaxSnapshots: 5}})
+	s := &Service{resourceManager: resources, quotas: quotas}
+
+	resp, err := s.GetTenantUsage(context.Background(), &storms.GetTenantUsageRequest{TenantId: "a"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), resp.GetUsage().GetVolumes())
+	require.Equal(t, uint32(1), resp.GetUsage().GetSnapshots())
+	require.Equal(t, uint64(2048), resp.GetUsage().GetProvisionedBytes())
+	require.Equal(t, uint32(5), resp.GetQuota().GetMaxVolumes())
+	require.Equal(t, uint32(5), resp.GetQuota().GetMaxSnapshots())
+
+	resp, err = s.GetTenantUsage(context.Background(), &storms.GetTenantUsageRequest{TenantId: "b"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), resp.GetUsage().GetVolumes())
+	require.Equal(t, uint32(10), resp.GetQuota().GetMaxVolumes())
+}
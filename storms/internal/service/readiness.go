@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+)
+
+// Serving status of the server as a whole, in the gRPC health service.
+const overallHealthService = ""
+
+type healthServer interface {
+	healthpb.HealthServer
+	SetServingStatus(service string, servingStatus healthpb.HealthCheckResponse_ServingStatus)
+	Shutdown()
+}
+
+// Returns true if a method is of the gRPC health service.
+func isHealthCheck(fullMethod string) bool {
+	switch fullMethod {
+	case healthpb.Health_Check_FullMethodName, healthpb.Health_Watch_FullMethodName:
+		return true
+	}
+
+	return false
+}
+
+// Reports the storage service, and the server as a whole, as serving once the configured clusters are managed, the
+// resource mappings are known and at least one cluster is usable. Mappings persisted in a file are known once loaded,
+// which happens before serving starts, while mappings kept in memory are known only once the first resource sync
+// has completed. Until then requests for existing resources would fail as unmapped, or could not be sent anywhere.
+// The admin service serves regardless, so that clusters can be inspected and configs reloaded.
+func (s *Service) updateServingStatus() {
+	if s.healthServer == nil {
+		return
+	}
+
+	servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+	switch {
+	case !s.clustersSynced.Load():
+		log.Debug().Msg("not ready, clusters have not been synced")
+	case !s.persistentResources && !s.synced.Load():
+		log.Debug().Msg("not ready, resources have not been synced")
+	case !s.hasUsableCluster():
+		log.Debug().Msg("not ready, no cluster is usable")
+	default:
+		servingStatus = healthpb.HealthCheckResponse_SERVING
+	}
+	s.healthServer.SetServingStatus(overallHealthService, servingStatus)
+	s.healthServer.SetServingStatus(storms.StorageManagementService_ServiceDesc.ServiceName, servingStatus)
+	s.healthServer.SetServingStatus(admin.AdminService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+}
+
+// Returns true if requests can be routed to at least one managed cluster.
+func (s *Service) hasUsableCluster() bool {
+	for _, clusterID := range s.clusterManager.AllIDs() {
+		if _, err := s.getRoutableCluster(clusterID); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Updates the serving status every interval until ctx is done, so that it follows the health of clusters.
+func (s *Service) watchServingStatus(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.updateServingStatus()
+		}
+	}
+}
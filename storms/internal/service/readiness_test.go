@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
+	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	serviceconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/configs"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health"
+	healthmocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
+)
+
+func Test_updateServingStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		persistent     bool
+		clustersSynced bool
+		synced         bool
+		states         map[string]health.State
+		expectServing  bool
+	}{
+		{
+			name:           "not synced",
+			clustersSynced: true,
+			states:         map[string]health.State{clusterID1: health.StateHealthy},
+		},
+		{
+			name:   "clusters not synced",
+			synced: true,
+			states: map[string]health.State{clusterID1: health.StateHealthy},
+		},
+		{
+			name:           "synced with a healthy cluster",
+			clustersSynced: true,
+			synced:         true,
+			states:         map[string]health.State{clusterID1: health.StateUnreachable, clusterID2: health.StateHealthy},
+			expectServing:  true,
+		},
+		{
+			name:           "synced with every cluster unreachable",
+			clustersSynced: true,
+			synced:         true,
+			states:         map[string]health.State{clusterID1: health.StateUnreachable, clusterID2: health.StateUnreachable},
+		},
+		{
+			name:           "synced without clusters",
+			clustersSynced: true,
+			synced:         true,
+			states:         map[string]health.State{},
+		},
+		{
+			name:           "persisted before resources are synced",
+			persistent:     true,
+			clustersSynced: true,
+			states:         map[string]health.State{clusterID1: health.StateHealthy},
+			expectServing:  true,
+		},
+		{
+			name:       "persisted before clusters are synced",
+			persistent: true,
+			states:     map[string]health.State{clusterID1: health.StateHealthy},
+		},
+		{
+			name:           "persisted with every cluster unreachable",
+			persistent:     true,
+			clustersSynced: true,
+			states:         map[string]health.State{clusterID1: health.StateUnreachable},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockAllIDs: func() []string {
+						ids := []string{}
+						for id := range tt.states {
+							ids = append(ids, id)
+						}
+
+						return ids
+					},
+					MockGet: func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+				},
+				health: &healthmocks.MockHealthChecker{
+					MockStatus: func(clusterID string) health.Status {
+						return health.Status{State: tt.states[clusterID]}
+					},
+				},
+				healthServer:        grpchealth.NewServer(),
+				persistentResources: tt.persistent,
+			}
+			s.clustersSynced.Store(tt.clustersSynced)
+			s.synced.Store(tt.synced)
+			s.updateServingStatus()
+
+			expected := healthpb.HealthCheckResponse_NOT_SERVING
+			if tt.expectServing {
+				expected = healthpb.HealthCheckResponse_SERVING
+			}
+			for _, service := range []string{overallHealthService, storms.StorageManagementService_ServiceDesc.ServiceName} {
+				resp, err := s.healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+				require.NoError(t, err)
+				require.Equal(t, expected, resp.GetStatus(), service)
+			}
+			resp, err := s.healthServer.Check(context.Background(),
+				&healthpb.HealthCheckRequest{Service: admin.AdminService_ServiceDesc.ServiceName})
+			require.NoError(t, err)
+			require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+		})
+	}
+}
+
+func Test_syncResourceManager_Ready(t *testing.T) {
+	s := &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockAllIDs: func() []string { return []string{} },
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockGetResourcesOfAllClusters: func() map[string][]*resource.Resource { return nil },
+		},
+		healthServer: grpchealth.NewServer(),
+	}
+	s.updateServingStatus()
+	require.False(t, s.synced.Load())
+
+	s.syncResourceManager()
+	require.True(t, s.synced.Load())
+}
+
+func Test_syncClusterManager_Ready(t *testing.T) {
+	tests := []struct {
+		name          string
+		persistent    bool
+		expectServing bool
+	}{
+		// Mappings kept in memory are unknown until the first resource sync.
+		{name: "memory store"},
+		// Persisted mappings are loaded when the service is created, so only clusters are waited on.
+		{name: "file store", persistent: true, expectServing: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterManager := cluster.NewInMemoryManager()
+			s := &Service{
+				clusterManager: clusterManager,
+				clusterLifecycle: cluster.NewLifecycle(clusterManager, cluster.LifecycleConfig{
+					NewCluster: func(*cluster.Config) (*cluster.Cluster, error) { return mockCluster1, nil },
+				}),
+				clusterConfigs:      &serviceconfigs.ClustersConfig{Clusters: []*cluster.Config{mockCluster1.Config}},
+				healthServer:        grpchealth.NewServer(),
+				persistentResources: tt.persistent,
+			}
+			s.updateServingStatus()
+			resp, err := s.healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{})
+			require.NoError(t, err)
+			require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+
+			s.syncClusterManager()
+			expected := healthpb.HealthCheckResponse_NOT_SERVING
+			if tt.expectServing {
+				expected = healthpb.HealthCheckResponse_SERVING
+			}
+			resp, err = s.healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{})
+			require.NoError(t, err)
+			require.Equal(t, expected, resp.GetStatus())
+		})
+	}
+}
+
+func Test_onClusterReady(t *testing.T) {
+	// The cluster was recorded as unreachable while it had no client.
+	state := health.StateUnreachable
+	s := &Service{
+		clusterManager: &clustermocks.MockClusterManager{
+			MockAllIDs: func() []string { return []string{clusterID1} },
+			MockGet:    func(string) (*cluster.Cluster, error) { return mockCluster1, nil },
+		},
+		resourceManager: &resourcemocks.MockResourceManager{
+			MockGetResourcesOfCluster: func(string) []*resource.Resource { return nil },
+			MockMap:                   func(*resource.Resource) error { return nil },
+		},
+		health: &healthmocks.MockHealthChecker{
+			MockStatus: func(string) health.Status { return health.Status{State: state} },
+			MockReset:  func(context.Context, string) { state = health.StateHealthy },
+		},
+		healthServer: grpchealth.NewServer(),
+	}
+	s.clustersSynced.Store(true)
+	s.synced.Store(true)
+	s.updateServingStatus()
+
+	s.onClusterReady(clusterID1)
+	_, err := s.getRoutableCluster(clusterID1)
+	require.NoError(t, err)
+	resp, err := s.healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+}
+
+func Test_Stop_HealthWatch(t *testing.T) {
+	s := &Service{
+		healthServer: grpchealth.NewServer(),
+		stopTimeout:  10 * time.Millisecond,
+	}
+	s.Server = grpc.NewServer()
+	healthpb.RegisterHealthServer(s, s.healthServer)
+	listener, err := net.Listen(tcpProtocol, "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = s.Serve(listener) }()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	watch, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = watch.Recv()
+	require.NoError(t, err)
+
+	// The open watch would keep a graceful stop waiting forever.
+	stopped := make(chan error, 1)
+	go func() { stopped <- s.Stop() }()
+	select {
+	case stopErr := <-stopped:
+		require.NoError(t, stopErr)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop did not return")
+	}
+}
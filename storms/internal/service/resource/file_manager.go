@@ -0,0 +1,313 @@
+package resource
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	opMap    = "map"
+	opUnmap  = "unmap"
+	opLabels = "labels"
+	opOwner  = "owner"
+
+	// The log is compacted once it holds this many times more entries than there are live mappings.
+	compactionRatio = 2
+	// Logs smaller than this are never compacted, regardless of ratio.
+	compactionMinEntries = 1024
+
+	storeFileMode = 0o600
+	storeDirMode  = 0o750
+)
+
+var errManagerClosed = errors.New("resource manager is closed")
+
+// logEntry is a single line of the file store. Lines are replayed in order on open.
+type logEntry struct {
+	Op           string            `json:"op"`
+	ID           string            `json:"id"`
+	ClusterID    string            `json:"clusterId,omitempty"`
+	ResourceType Type              `json:"type,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	TenantID     string            `json:"tenantId,omitempty"`
+	SizeBytes    uint64            `json:"sizeBytes,omitempty"`
+}
+
+// FileManager is a resource manager backed by an append-only log on local disk, so that resource-to-cluster
+// mappings survive restarts. Reads are served from memory; every mutation is appended to the log before it is
+// applied. The log is rewritten as a snapshot of live mappings on open and whenever it grows too large.
+//
+// Writes are not fsync'd individually. A mapping lost to a power failure is recovered by the next sync with the
+// clusters, which remain the source of truth.
+type FileManager struct {
+	*InMemoryManager
+
+	// Serializes writes to the log file.
+	logMu      sync.Mutex
+	path       string
+	file       *os.File
+	logEntries int
+}
+
+// NewFileManager opens (or creates) the store at path and loads any mappings persisted in it.
+func NewFileManager(path string) (*FileManager, error) {
+	if err := os.MkdirAll(filepath.Dir(path), storeDirMode); err != nil {
+		return nil, fmt.Errorf("failed to create directory for resource store: %w", err)
+	}
+
+	m := &FileManager{
+		InMemoryManager: NewInMemoryManager(),
+		path:            path,
+	}
+
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+
+	if err := m.compact(); err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("path", path).Msgf("loaded %d resources from resource store", m.GetResourceCount())
+
+	return m, nil
+}
+
+func (m *FileManager) Map(r *Resource) error {
+	m.logMu.Lock()
+	defer m.logMu.Unlock()
+
+	// Re-mapping a resource to the cluster it is already mapped to is common during syncs; skip the write.
+	if existing, ok := m.get(r.ID); ok && *existing == *r {
+		return nil
+	}
+
+	err := m.append(&logEntry{
+		Op:           opMap,
+		ID:           r.ID,
+		ClusterID:    r.ClusterID,
+		ResourceType: r.ResourceType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.InMemoryManager.Map(r)
+}
+
+func (m *FileManager) Unmap(resourceID string) error {
+	m.logMu.Lock()
+	defer m.logMu.Unlock()
+
+	if _, ok := m.get(resourceID); !ok {
+		return nil
+	}
+
+	if err := m.append(&logEntry{Op: opUnmap, ID: resourceID}); err != nil {
+		return err
+	}
+
+	return m.InMemoryManager.Unmap(resourceID)
+}
+
+func (m *FileManager) SetLabels(resourceID string, labels map[string]string) error {
+	m.logMu.Lock()
+	defer m.logMu.Unlock()
+
+	if _, ok := m.get(resourceID); !ok {
+		return fmt.Errorf("couldn't set labels of resource %s: %w", resourceID, errUnmappedResource)
+	}
+
+	if err := m.append(&logEntry{Op: opLabels, ID: resourceID, Labels: labels}); err != nil {
+		return err
+	}
+
+	return m.InMemoryManager.SetLabels(resourceID, labels)
+}
+
+func (m *FileManager) SetOwnership(resourceID string, o Ownership) error {
+	m.logMu.Lock()
+	defer m.logMu.Unlock()
+
+	if _, ok := m.get(resourceID); !ok {
+		return fmt.Errorf("couldn't set ownership of resource %s: %w", resourceID, errUnmappedResource)
+	}
+
+	if err := m.append(&logEntry{Op: opOwner, ID: resourceID, TenantID: o.TenantID, SizeBytes: o.SizeBytes}); err != nil {
+		return err
+	}
+
+	return m.InMemoryManager.SetOwnership(resourceID, o)
+}
+
+// Close flushes and closes the underlying store. The manager must not be used afterwards.
+func (m *FileManager) Close() error {
+	m.logMu.Lock()
+	defer m.logMu.Unlock()
+
+	if m.file == nil {
+		return nil
+	}
+
+	err := m.file.Sync()
+	if closeErr := m.file.Close(); err == nil {
+		err = closeErr
+	}
+	m.file = nil
+	if err != nil {
+		return fmt.Errorf("failed to close resource store: %w", err)
+	}
+
+	return nil
+}
+
+// Replays the log into memory. Lines that cannot be parsed (e.g. a write interrupted by a crash) are skipped.
+func (m *FileManager) load() error {
+	f, err := os.Open(m.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open resource store: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, bufio.MaxScanTokenSize)
+	for line := 1; scanner.Scan(); line++ {
+		entry := &logEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			log.Warn().Str("path", m.path).Int("line", line).Err(err).Msg("skipping malformed resource store entry")
+
+			continue
+		}
+
+		switch entry.Op {
+		case opMap:
+			_ = m.InMemoryManager.Map(&Resource{
+				ID:           entry.ID,
+				ClusterID:    entry.ClusterID,
+				ResourceType: entry.ResourceType,
+			})
+		case opUnmap:
+			_ = m.InMemoryManager.Unmap(entry.ID)
+		case opLabels:
+			_ = m.InMemoryManager.SetLabels(entry.ID, entry.Labels)
+		case opOwner:
+			_ = m.InMemoryManager.SetOwnership(entry.ID, Ownership{TenantID: entry.TenantID, SizeBytes: entry.SizeBytes})
+		default:
+			log.Warn().Str("path", m.path).Int("line", line).Msgf("skipping unknown resource store op %q", entry.Op)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read resource store: %w", err)
+	}
+
+	return nil
+}
+
+// Appends an entry to the log, compacting first if the log has grown too large. Callers must hold m.logMu.
+func (m *FileManager) append(entry *logEntry) error {
+	if m.file == nil {
+		return errManagerClosed
+	}
+
+	live := m.GetResourceCount()
+	if m.logEntries >= compactionMinEntries && m.logEntries > compactionRatio*live {
+		if err := m.compact(); err != nil {
+			return err
+		}
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode resource store entry: %w", err)
+	}
+	if _, err := m.file.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write resource store entry: %w", err)
+	}
+	m.logEntries++
+
+	return nil
+}
+
+// Rewrites the log so it only contains the live mappings, then reopens it for appending.
+func (m *FileManager) compact() error {
+	tmpPath := m.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, storeFileMode)
+	if err != nil {
+		return fmt.Errorf("failed to create resource store snapshot: %w", err)
+	}
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	count := 0
+	for _, resources := range m.GetResourcesOfAllClusters() {
+		for _, r := range resources {
+			err = enc.Encode(&logEntry{Op: opMap, ID: r.ID, ClusterID: r.ClusterID, ResourceType: r.ResourceType})
+			if err != nil {
+				_ = tmp.Close()
+
+				return fmt.Errorf("failed to write resource store snapshot: %w", err)
+			}
+			count++
+
+			if labels := m.GetLabels(r.ID); len(labels) > 0 {
+				err = enc.Encode(&logEntry{Op: opLabels, ID: r.ID, Labels: labels})
+				if err != nil {
+					_ = tmp.Close()
+
+					return fmt.Errorf("failed to write resource store snapshot: %w", err)
+				}
+				count++
+			}
+
+			if o, ok := m.GetOwnership(r.ID); ok {
+				err = enc.Encode(&logEntry{Op: opOwner, ID: r.ID, TenantID: o.TenantID, SizeBytes: o.SizeBytes})
+				if err != nil {
+					_ = tmp.Close()
+
+					return fmt.Errorf("failed to write resource store snapshot: %w", err)
+				}
+				count++
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("failed to write resource store snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("failed to sync resource store snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close resource store snapshot: %w", err)
+	}
+
+	if m.file != nil {
+		_ = m.file.Close()
+		m.file = nil
+	}
+	if err := os.Rename(tmpPath, m.path); err != nil {
+		return fmt.Errorf("failed to replace resource store: %w", err)
+	}
+
+	f, err := os.OpenFile(m.path, os.O_APPEND|os.O_WRONLY, storeFileMode)
+	if err != nil {
+		return fmt.Errorf("failed to open resource store: %w", err)
+	}
+	m.file = f
+	m.logEntries = count
+
+	return nil
+}
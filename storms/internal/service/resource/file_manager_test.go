@@ -0,0 +1,197 @@
+package resource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FileManager_Persistence(t *testing.T) {
+	type op struct {
+		unmap    bool
+		resource *Resource
+	}
+	tests := []struct {
+		name     string
+		ops      []op
+		expected map[string]string // resource ID -> cluster ID
+	}{
+		{
+			name:     "empty",
+			ops:      []op{},
+			expected: map[string]string{},
+		},
+		{
+			name: "map multiple",
+			ops: []op{
+				{resource: &Resource{ID: resourceID1, ClusterID: clusterID1, ResourceType: TypeVolume}},
+				{resource: &Resource{ID: resourceID2, ClusterID: clusterID2, ResourceType: TypeVolume}},
+				{resource: &Resource{ID: resourceID3, ClusterID: clusterID2, ResourceType: TypeSnapshot}},
+			},
+			expected: map[string]string{
+				resourceID1: clusterID1,
+				resourceID2: clusterID2,
+				resourceID3: clusterID2,
+			},
+		},
+		{
+			name: "remap to other cluster",
+			ops: []op{
+				{resource: &Resource{ID: resourceID1, ClusterID: clusterID1, ResourceType: TypeVolume}},
+				{resource: &Resource{ID: resourceID1, ClusterID: clusterID2, ResourceType: TypeVolume}},
+			},
+			expected: map[string]string{
+				resourceID1: clusterID2,
+			},
+		},
+		{
+			name: "map then unmap",
+			ops: []op{
+				{resource: &Resource{ID: resourceID1, ClusterID: clusterID1, ResourceType: TypeVolume}},
+				{resource: &Resource{ID: resourceID2, ClusterID: clusterID2, ResourceType: TypeVolume}},
+				{unmap: true, resource: &Resource{ID: resourceID1}},
+				{unmap: true, resource: &Resource{ID: uuid.NewString()}},
+			},
+			expected: map[string]string{
+				resourceID2: clusterID2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "store", "resources.log")
+			manager, err := NewFileManager(path)
+			require.NoError(t, err)
+			for _, o := range tt.ops {
+				if o.unmap {
+					require.NoError(t, manager.Unmap(o.resource.ID))
+				} else {
+					require.NoError(t, manager.Map(o.resource))
+				}
+			}
+			require.NoError(t, manager.Close())
+
+			reopened, err := NewFileManager(path)
+			require.NoError(t, err)
+			defer reopened.Close()
+
+			require.Equal(t, len(tt.expected), reopened.GetResourceCount())
+			for resourceID, clusterID := range tt.expected {
+				actual, err := reopened.GetResourceCluster(resourceID)
+				require.NoError(t, err)
+				require.Equal(t, clusterID, actual)
+			}
+			// Counts of resources on each cluster are rebuilt from the log.
+			for _, clusterID := range []string{clusterID1, clusterID2} {
+				total := 0
+				for _, count := range reopened.CountResourcesOfCluster(clusterID) {
+					total += count
+				}
+				require.Len(t, reopened.GetResourcesOfCluster(clusterID), total, clusterID)
+			}
+		})
+	}
+}
+
+func Test_FileManager_Labels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resources.log")
+	manager, err := NewFileManager(path)
+	require.NoError(t, err)
+	require.NoError(t, manager.Map(&Resource{ID: resourceID1, ClusterID: clusterID1, ResourceType: TypeVolume}))
+	require.NoError(t, manager.Map(&Resource{ID: resourceID2, ClusterID: clusterID1, ResourceType: TypeVolume}))
+	require.NoError(t, manager.SetLabels(resourceID1, map[string]string{"tenant": "a"}))
+	require.NoError(t, manager.SetLabels(resourceID2, map[string]string{"tenant": "b"}))
+	require.NoError(t, manager.Unmap(resourceID2))
+	require.Error(t, manager.SetLabels(resourceID3, map[string]string{"tenant": "c"}))
+	require.NoError(t, manager.Close())
+
+	// Reopening replays the log, then compacts it; the second reopen reads the compacted log.
+	for range 2 {
+		reopened, err := NewFileManager(path)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"tenant": "a"}, reopened.GetLabels(resourceID1))
+		require.Nil(t, reopened.GetLabels(resourceID2))
+		require.NoError(t, reopened.Close())
+	}
+}
+
+func Test_FileManager_Ownership(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resources.log")
+	manager, err := NewFileManager(path)
+	require.NoError(t, err)
+	require.NoError(t, manager.Map(&Resource{ID: resourceID1, ClusterID: clusterID1, ResourceType: TypeVolume}))
+	require.NoError(t, manager.Map(&Resource{ID: resourceID2, ClusterID: clusterID1, ResourceType: TypeSnapshot}))
+	require.NoError(t, manager.SetOwnership(resourceID1, Ownership{TenantID: "a", SizeBytes: 1024}))
+	require.NoError(t, manager.SetOwnership(resourceID2, Ownership{TenantID: "a"}))
+	require.NoError(t, manager.Unmap(resourceID2))
+	require.Error(t, manager.SetOwnership(resourceID3, Ownership{TenantID: "a"}))
+	require.NoError(t, manager.Close())
+
+	// Reopening replays the log, then compacts it; the second reopen reads the compacted log.
+	for range 2 {
+		reopened, err := NewFileManager(path)
+		require.NoError(t, err)
+		o, ok := reopened.GetOwnership(resourceID1)
+		require.True(t, ok)
+		require.Equal(t, Ownership{TenantID: "a", SizeBytes: 1024}, o)
+		require.Equal(t, Usage{Volumes: 1, ProvisionedBytes: 1024}, reopened.GetTenantUsage("a"))
+		require.NoError(t, reopened.Close())
+	}
+}
+
+func Test_FileManager_SkipsMalformedEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resources.log")
+	contents := `{"op":"map","id":"` + resourceID1 + `","clusterId":"` + clusterID1 + `","type":"volume"}
+not json
+{"op":"bogus","id":"` + resourceID2 + `"}
+{"op":"map","id":"` + resourceID3 + `","clusterId":"` + clusterID2 + `","type":"snap`
+	require.NoError(t, os.WriteFile(path, []byte(contents), storeFileMode))
+
+	manager, err := NewFileManager(path)
+	require.NoError(t, err)
+	defer manager.Close()
+
+	require.Equal(t, 1, manager.GetResourceCount())
+	actual, err := manager.GetResourceCluster(resourceID1)
+	require.NoError(t, err)
+	require.Equal(t, clusterID1, actual)
+}
+
+func Test_FileManager_Compaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resources.log")
+	manager, err := NewFileManager(path)
+	require.NoError(t, err)
+
+	// Repeatedly moving one resource between clusters grows the log without growing the live set.
+	for i := range compactionMinEntries * 2 {
+		clusterID := clusterID1
+		if i%2 == 1 {
+			clusterID = clusterID2
+		}
+		require.NoError(t, manager.Map(&Resource{ID: resourceID1, ClusterID: clusterID, ResourceType: TypeVolume}))
+	}
+	require.LessOrEqual(t, manager.logEntries, compactionMinEntries+1)
+	require.NoError(t, manager.Close())
+
+	reopened, err := NewFileManager(path)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	require.Equal(t, 1, reopened.logEntries)
+	actual, err := reopened.GetResourceCluster(resourceID1)
+	require.NoError(t, err)
+	require.Equal(t, clusterID2, actual)
+}
+
+func Test_FileManager_Closed(t *testing.T) {
+	manager, err := NewFileManager(filepath.Join(t.TempDir(), "resources.log"))
+	require.NoError(t, err)
+	require.NoError(t, manager.Close())
+
+	err = manager.Map(&Resource{ID: resourceID1, ClusterID: clusterID1, ResourceType: TypeVolume})
+	require.ErrorIs(t, err, errManagerClosed)
+}
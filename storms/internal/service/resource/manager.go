@@ -1,26 +1,37 @@
 package resource
 
 import (
-	"errors"
 	"fmt"
+	"maps"
 	"sync"
 
 	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
-	errUnmappedResource = errors.New("unmapped resource")
+	errUnmappedResource = status.Error(codes.NotFound, "unmapped resource")
 )
 
 type InMemoryManager struct {
 	mu sync.RWMutex
 
 	resourceIDToResourceMetadata map[string]*Resource
+	// Labels of resources on clusters whose clients cannot store them. Dropped when the resource is unmapped.
+	resourceIDToLabels map[string]map[string]string
+	// Tenants owning resources. Dropped when the resource is unmapped.
+	resourceIDToOwnership map[string]Ownership
+	// Number of resources of each type mapped to each cluster, kept up to date by Map and Unmap.
+	clusterIDToCounts map[string]map[Type]int
 }
 
 func NewInMemoryManager() *InMemoryManager {
 	return &InMemoryManager{
 		resourceIDToResourceMetadata: make(map[string]*Resource),
+		resourceIDToLabels:           make(map[string]map[string]string),
+		resourceIDToOwnership:        make(map[string]Ownership),
+		clusterIDToCounts:            make(map[string]map[Type]int),
 	}
 }
 
@@ -28,7 +39,11 @@ func (m *InMemoryManager) Map(r *Resource) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if existing, ok := m.resourceIDToResourceMetadata[r.ID]; ok {
+		m.addCount(existing, -1)
+	}
 	m.resourceIDToResourceMetadata[r.ID] = r
+	m.addCount(r, 1)
 
 	return nil
 }
@@ -37,11 +52,94 @@ func (m *InMemoryManager) Unmap(resourceID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if r, ok := m.resourceIDToResourceMetadata[resourceID]; ok {
+		m.addCount(r, -1)
+	}
 	delete(m.resourceIDToResourceMetadata, resourceID)
+	delete(m.resourceIDToLabels, resourceID)
+	delete(m.resourceIDToOwnership, resourceID)
+
+	return nil
+}
+
+// SetLabels replaces the labels of a mapped resource. Empty labels remove them.
+func (m *InMemoryManager) SetLabels(resourceID string, labels map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.resourceIDToResourceMetadata[resourceID]; !ok {
+		return fmt.Errorf("couldn't set labels of resource %s: %w", resourceID, errUnmappedResource)
+	}
+
+	if len(labels) == 0 {
+		delete(m.resourceIDToLabels, resourceID)
+
+		return nil
+	}
+	m.resourceIDToLabels[resourceID] = maps.Clone(labels)
+
+	return nil
+}
+
+// GetLabels returns the labels of a resource, or nil if it has none.
+func (m *InMemoryManager) GetLabels(resourceID string) map[string]string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return maps.Clone(m.resourceIDToLabels[resourceID])
+}
+
+// SetOwnership records the tenant owning a mapped resource. An empty tenant removes the record.
+func (m *InMemoryManager) SetOwnership(resourceID string, o Ownership) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.resourceIDToResourceMetadata[resourceID]; !ok {
+		return fmt.Errorf("couldn't set ownership of resource %s: %w", resourceID, errUnmappedResource)
+	}
+
+	if o.TenantID == "" {
+		delete(m.resourceIDToOwnership, resourceID)
+
+		return nil
+	}
+	m.resourceIDToOwnership[resourceID] = o
 
 	return nil
 }
 
+// GetOwnership returns the ownership of a resource, and whether it is owned by a tenant.
+func (m *InMemoryManager) GetOwnership(resourceID string) (Ownership, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	o, ok := m.resourceIDToOwnership[resourceID]
+
+	return o, ok
+}
+
+// GetTenantUsage sums up the mapped resources owned by a tenant.
+func (m *InMemoryManager) GetTenantUsage(tenantID string) Usage {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	usage := Usage{}
+	for id, o := range m.resourceIDToOwnership {
+		if o.TenantID != tenantID {
+			continue
+		}
+		switch m.resourceIDToResourceMetadata[id].ResourceType {
+		case TypeVolume:
+			usage.Volumes++
+			usage.ProvisionedBytes += o.SizeBytes
+		case TypeSnapshot:
+			usage.Snapshots++
+		}
+	}
+
+	return usage
+}
+
 func (m *InMemoryManager) GetResourceCluster(resourceID string) (string, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -54,6 +152,15 @@ func (m *InMemoryManager) GetResourceCluster(resourceID string) (string, error)
 	return r.ClusterID, nil
 }
 
+func (m *InMemoryManager) get(resourceID string) (*Resource, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	r, ok := m.resourceIDToResourceMetadata[resourceID]
+
+	return r, ok
+}
+
 func (m *InMemoryManager) GetResourceCount() int {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -61,6 +168,30 @@ func (m *InMemoryManager) GetResourceCount() int {
 	return len(m.resourceIDToResourceMetadata)
 }
 
+// CountResourcesOfCluster returns the number of resources of each type mapped to a cluster, without listing them.
+func (m *InMemoryManager) CountResourcesOfCluster(clusterID string) map[Type]int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return maps.Clone(m.clusterIDToCounts[clusterID])
+}
+
+// Adds delta to the count of resources of the type of r on its cluster. Callers must hold m.mu.
+func (m *InMemoryManager) addCount(r *Resource, delta int) {
+	counts, ok := m.clusterIDToCounts[r.ClusterID]
+	if !ok {
+		counts = make(map[Type]int)
+		m.clusterIDToCounts[r.ClusterID] = counts
+	}
+	counts[r.ResourceType] += delta
+	if counts[r.ResourceType] == 0 {
+		delete(counts, r.ResourceType)
+	}
+	if len(counts) == 0 {
+		delete(m.clusterIDToCounts, r.ClusterID)
+	}
+}
+
 func (m *InMemoryManager) GetResourcesOfCluster(clusterID string) []*Resource {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
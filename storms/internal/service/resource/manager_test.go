@@ -15,24 +15,25 @@ var (
 	resourceID3 = "f0477318-65e2-4391-9048-6b81682e76a1"
 
 	setupManager = func() *InMemoryManager {
-		manager := &InMemoryManager{
-			resourceIDToResourceMetadata: map[string]*Resource{
-				resourceID1: {
-					ID:           resourceID1,
-					ClusterID:    clusterID1,
-					ResourceType: TypeVolume,
-				},
-				resourceID2: {
-					ID:           resourceID2,
-					ClusterID:    clusterID2,
-					ResourceType: TypeVolume,
-				},
-				resourceID3: {
-					ID:           resourceID3,
-					ClusterID:    clusterID2,
-					ResourceType: TypeSnapshot,
-				},
+		manager := NewInMemoryManager()
+		for _, r := range []*Resource{
+			{
+				ID:           resourceID1,
+				ClusterID:    clusterID1,
+				ResourceType: TypeVolume,
+			},
+			{
+				ID:           resourceID2,
+				ClusterID:    clusterID2,
+				ResourceType: TypeVolume,
+			},
+			{
+				ID:           resourceID3,
+				ClusterID:    clusterID2,
+				ResourceType: TypeSnapshot,
 			},
+		} {
+			_ = manager.Map(r)
 		}
 
 		return manager
@@ -146,6 +147,24 @@ func Test_Unmap(t *testing.T) {
 	}
 }
 
+func Test_CountResourcesOfCluster(t *testing.T) {
+	manager := setupManager()
+	require.Equal(t, map[Type]int{TypeVolume: 1}, manager.CountResourcesOfCluster(clusterID1))
+	require.Equal(t, map[Type]int{TypeVolume: 1, TypeSnapshot: 1}, manager.CountResourcesOfCluster(clusterID2))
+
+	// Remapping a resource to another cluster moves its count, and remapping it in place leaves the count as is.
+	require.NoError(t, manager.Map(&Resource{ID: resourceID1, ClusterID: clusterID2, ResourceType: TypeVolume}))
+	require.NoError(t, manager.Map(&Resource{ID: resourceID1, ClusterID: clusterID2, ResourceType: TypeVolume}))
+	require.Empty(t, manager.CountResourcesOfCluster(clusterID1))
+	require.Equal(t, map[Type]int{TypeVolume: 2, TypeSnapshot: 1}, manager.CountResourcesOfCluster(clusterID2))
+
+	// Unmapping a resource twice only counts it once.
+	require.NoError(t, manager.Unmap(resourceID3))
+	require.NoError(t, manager.Unmap(resourceID3))
+	require.Equal(t, map[Type]int{TypeVolume: 2}, manager.CountResourcesOfCluster(clusterID2))
+	require.Len(t, manager.GetResourcesOfCluster(clusterID2), 2)
+}
+
 func Test_OwnerCluster(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -186,3 +205,72 @@ func Test_OwnerCluster(t *testing.T) {
 		})
 	}
 }
+
+func Test_Labels(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		labels     map[string]string
+		expect     map[string]string
+		expectErr  bool
+	}{
+		{
+			name:       "set labels",
+			resourceID: resourceID1,
+			labels:     map[string]string{"tenant": "a"},
+			expect:     map[string]string{"tenant": "a"},
+		},
+		{
+			name:       "clear labels",
+			resourceID: resourceID1,
+			labels:     map[string]string{},
+			expect:     nil,
+		},
+		{
+			name:       "unmapped resource",
+			resourceID: uuid.NewString(),
+			labels:     map[string]string{"tenant": "a"},
+			expectErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := setupManager()
+			require.NoError(t, mapper.SetLabels(resourceID1, map[string]string{"vm": "b"}))
+
+			err := mapper.SetLabels(tt.resourceID, tt.labels)
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, mapper.GetLabels(tt.resourceID))
+
+			require.NoError(t, mapper.Unmap(tt.resourceID))
+			require.Nil(t, mapper.GetLabels(tt.resourceID))
+		})
+	}
+}
+
+func Test_Ownership(t *testing.T) {
+	mapper := setupManager()
+	require.NoError(t, mapper.SetOwnership(resourceID1, Ownership{TenantID: "a", SizeBytes: 1024}))
+	require.NoError(t, mapper.SetOwnership(resourceID2, Ownership{TenantID: "a", SizeBytes: 2048}))
+	require.NoError(t, mapper.SetOwnership(resourceID3, Ownership{TenantID: "a"}))
+	require.Error(t, mapper.SetOwnership(uuid.NewString(), Ownership{TenantID: "a"}))
+
+	o, ok := mapper.GetOwnership(resourceID1)
+	require.True(t, ok)
+	require.Equal(t, Ownership{TenantID: "a", SizeBytes: 1024}, o)
+	require.Equal(t, Usage{Volumes: 2, Snapshots: 1, ProvisionedBytes: 3072}, mapper.GetTenantUsage("a"))
+	require.Equal(t, Usage{}, mapper.GetTenantUsage("b"))
+
+	// Unmapped and disowned resources no longer count towards usage.
+	require.NoError(t, mapper.Unmap(resourceID2))
+	require.NoError(t, mapper.SetOwnership(resourceID3, Ownership{}))
+	_, ok = mapper.GetOwnership(resourceID3)
+	require.False(t, ok)
+	require.Equal(t, Usage{Volumes: 1, ProvisionedBytes: 1024}, mapper.GetTenantUsage("a"))
+}
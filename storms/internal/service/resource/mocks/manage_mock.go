@@ -8,7 +8,15 @@ type MockResourceManager struct {
 	MockGetResourceCluster        func(resourceID string) (string, error)
 	MockGetResourceCount          func() int
 	MockGetResourcesOfCluster     func(clusterID string) []*resource.Resource
+	MockCountResourcesOfCluster   func(clusterID string) map[resource.Type]int
 	MockGetResourcesOfAllClusters func() map[string][]*resource.Resource
+	// Unset label functions behave as a manager holding no labels.
+	MockSetLabels func(resourceID string, labels map[string]string) error
+	MockGetLabels func(resourceID string) map[string]string
+	// Unset ownership functions behave as a manager holding no ownership records.
+	MockSetOwnership   func(resourceID string, o resource.Ownership) error
+	MockGetOwnership   func(resourceID string) (resource.Ownership, bool)
+	MockGetTenantUsage func(tenantID string) resource.Usage
 }
 
 func (m *MockResourceManager) Map(r *resource.Resource) error {
@@ -31,6 +39,46 @@ func (m *MockResourceManager) GetResourcesOfCluster(clusterID string) []*resourc
 	return m.MockGetResourcesOfCluster(clusterID)
 }
 
+func (m *MockResourceManager) CountResourcesOfCluster(clusterID string) map[resource.Type]int {
+	return m.MockCountResourcesOfCluster(clusterID)
+}
+
 func (m *MockResourceManager) GetResourcesOfAllClusters() map[string][]*resource.Resource {
 	return m.MockGetResourcesOfAllClusters()
 }
+
+func (m *MockResourceManager) SetLabels(resourceID string, labels map[string]string) error {
+	return m.MockSetLabels(resourceID, labels)
+}
+
+func (m *MockResourceManager) GetLabels(resourceID string) map[string]string {
+	if m.MockGetLabels == nil {
+		return nil
+	}
+
+	return m.MockGetLabels(resourceID)
+}
+
+func (m *MockResourceManager) SetOwnership(resourceID string, o resource.Ownership) error {
+	if m.MockSetOwnership == nil {
+		return nil
+	}
+
+	return m.MockSetOwnership(resourceID, o)
+}
+
+func (m *MockResourceManager) GetOwnership(resourceID string) (resource.Ownership, bool) {
+	if m.MockGetOwnership == nil {
+		return resource.Ownership{}, false
+	}
+
+	return m.MockGetOwnership(resourceID)
+}
+
+func (m *MockResourceManager) GetTenantUsage(tenantID string) resource.Usage {
+	if m.MockGetTenantUsage == nil {
+		return resource.Usage{}
+	}
+
+	return m.MockGetTenantUsage(tenantID)
+}
@@ -12,3 +12,26 @@ type Resource struct {
 	ClusterID    string
 	ResourceType Type
 }
+
+// Ownership records the tenant a resource belongs to, for enforcing the tenant's quota.
+type Ownership struct {
+	TenantID string
+	// Provisioned size of a volume. Snapshots do not count towards provisioned bytes.
+	SizeBytes uint64
+}
+
+// Usage sums up the resources owned by a tenant.
+type Usage struct {
+	Volumes          int
+	Snapshots        int
+	ProvisionedBytes uint64
+}
+
+// Add returns the sum of u and other.
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		Volumes:          u.Volumes + other.Volumes,
+		Snapshots:        u.Snapshots + other.Snapshots,
+		ProvisionedBytes: u.ProvisionedBytes + other.ProvisionedBytes,
+	}
+}
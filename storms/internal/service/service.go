@@ -2,14 +2,26 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
@@ -17,17 +29,37 @@ import (
 	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/pkg/redact"
 	appconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/app/configs"
 	alloc "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/audit"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/certs"
 	cluster "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
 	serviceconfigs "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/configs"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/metrics"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/operation"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/quota"
 	resource "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/tracing"
 	translator "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator"
 )
 
 const (
 	requestTimeoutMin = 1
 	tcpProtocol       = "tcp"
+
+	metricsPath              = "/metrics"
+	metricsReadHeaderTimeout = 10 * time.Second
+
+	// Time Stop waits for requests and streams to finish before closing the connections left.
+	defaultStopTimeout = 30 * time.Second
+)
+
+var (
+	errUnsupportedResourceStore = errors.New("unsupported resource store")
+	errUnsupportedStrategy      = errors.New("unsupported allocation strategy")
+	errClusterUnavailable       = status.Error(codes.Unavailable, "cluster is unavailable")
 )
 
 type clientTranslator interface {
@@ -37,6 +69,8 @@ type clientTranslator interface {
 	) (*storms.CreateSnapshotResponse, error)
 	CreateVolume(ctx context.Context, c client.Client, req *storms.CreateVolumeRequest,
 	) (*storms.CreateVolumeResponse, error)
+	CloneVolume(ctx context.Context, c client.Client, req *storms.CloneVolumeRequest,
+	) (*storms.CloneVolumeResponse, error)
 	DeleteSnapshot(ctx context.Context, c client.Client, req *storms.DeleteSnapshotRequest,
 	) (*storms.DeleteSnapshotResponse, error)
 	DeleteVolume(ctx context.Context, c client.Client, req *storms.DeleteVolumeRequest,
@@ -53,6 +87,15 @@ type clientTranslator interface {
 	) (*storms.GetVolumesResponse, error)
 	ResizeVolume(ctx context.Context, c client.Client, req *storms.ResizeVolumeRequest,
 	) (*storms.ResizeVolumeResponse, error)
+	RevertVolumeToSnapshot(ctx context.Context, c client.Client, req *storms.RevertVolumeToSnapshotRequest,
+	) (*storms.RevertVolumeToSnapshotResponse, error)
+	CopySnapshotToVolume(ctx context.Context, src, dst client.Client, snapshotUUID, dstUUID string) (string, error)
+	CopySnapshotToSnapshot(ctx context.Context, src, dst client.Client, snapshotUUID, dstUUID string) (string, error)
+	GetWorkflow(ctx context.Context, c client.Client, workflowID string) (*models.Workflow, error)
+	GetLabels(ctx context.Context, c client.Client, resourceType storms.ResourceType, uuids []string,
+	) (map[string]map[string]string, error)
+	SetLabels(ctx context.Context, c client.Client, resourceType storms.ResourceType, uuid string,
+		labels map[string]string) error
 }
 
 // ClusterManager manages the lifecycle of clients for storage clusters.
@@ -65,18 +108,61 @@ type clusterManager interface {
 	Count() int
 }
 
+// Creates the clients of clusters, retrying failed ones in the background.
+type clusterLifecycle interface {
+	Add(cfg *cluster.Config) *cluster.Cluster
+	Remove(clusterID string) error
+	Stop()
+}
+
 type resourceManager interface {
 	Map(r *resource.Resource) error
 	Unmap(resourceID string) error
 	GetResourceCluster(resourceID string) (string, error)
 	GetResourceCount() int
 	GetResourcesOfCluster(clusterID string) []*resource.Resource
+	CountResourcesOfCluster(clusterID string) map[resource.Type]int
 	GetResourcesOfAllClusters() map[string][]*resource.Resource
+	SetLabels(resourceID string, labels map[string]string) error
+	GetLabels(resourceID string) map[string]string
+	SetOwnership(resourceID string, o resource.Ownership) error
+	GetOwnership(resourceID string) (resource.Ownership, bool)
+	GetTenantUsage(tenantID string) resource.Usage
+}
+
+// Enforces the limits on the resources each tenant may own.
+type quotaManager interface {
+	SetLimits(defaults quota.Limits, tenants map[string]quota.Limits)
+	Limits(tenantID string) quota.Limits
+	Reserve(tenantID string, delta resource.Usage) (func(), error)
+}
+
+// Tracks the health of managed clusters.
+type healthChecker interface {
+	Run(ctx context.Context)
+	Status(clusterID string) health.Status
+	Reset(ctx context.Context, clusterID string)
+}
+
+// Runs mutating requests sent with async in the background.
+type operationManager interface {
+	Start(method string, resourceType storms.ResourceType, resourceID string, fn operation.Func) *storms.Operation
+	Get(id string) (*storms.Operation, error)
+	List() []*storms.Operation
+	Wait(ctx context.Context, id string) (*storms.Operation, error)
+	Cancel(ctx context.Context, id string) (*storms.Operation, error)
+	Stop()
+}
+
+// Records mutating requests.
+type auditLogger interface {
+	Record(e *audit.Entry) error
+	Query(filter audit.Filter) ([]*audit.Entry, error)
 }
 
 // Allocator decides which cluster a new resource should be placed on.
 type allocatorManager interface {
-	AllocateCluster(affinityTags map[string]string) (string, error)
+	AllocateCluster(ctx context.Context, req *alloc.Request) (string, error)
 }
 
 type Service struct {
@@ -87,10 +173,58 @@ type Service struct {
 	clientTranslator clientTranslator
 
 	// Components for caching resource and cluster metadata. Routes requests.
-	clusterManager  clusterManager
-	resourceManager resourceManager
+	clusterManager   clusterManager
+	clusterLifecycle clusterLifecycle
+	resourceManager  resourceManager
 	// resourceManager resourceManager
 	allocator allocatorManager
+	health    healthChecker
+	// Stops background health checks.
+	stopHealthChecks context.CancelFunc
+	// Stops background checks of the TLS certificate files.
+	stopCertReloads context.CancelFunc
+	// Set when resource mappings are loaded from a durable store, so serving need not wait on a full sync.
+	persistentResources bool
+	// Time each cluster is given to answer a list request, or a search for an existing resource.
+	listTimeout time.Duration
+	// Time each cluster is given to report its capacity to ShowClusters.
+	capacityTimeout time.Duration
+	// Time an operation waits for its resource to become available once the vendor accepted the change.
+	availabilityTimeout time.Duration
+	// Serializes creates of the same resource UUID.
+	createLocks keyedMutex
+	// Serializes label updates of the same resource UUID.
+	labelLocks keyedMutex
+	// Limits the resources owned by each tenant. Tenants are not limited if nil.
+	quotas quotaManager
+	// Set once quotas are loaded, after which new resources must have a tenant.
+	requireTenants bool
+	// Tracks mutating requests running in the background.
+	operations operationManager
+	// Records mutating requests. Requests are not audited if nil.
+	audit auditLogger
+	// Time between polls of a backend workflow, such as a copy between clusters.
+	workflowPollInterval time.Duration
+	// Workflows of copies still running after their request stopped waiting, keyed on the UUID of the copy.
+	runningCopies sync.Map
+	// Mutations and copies running on each cluster, drained before a removed cluster is dropped.
+	clusterUsage clusterUsage
+	// Collects Prometheus metrics. Metrics are not collected if nil.
+	metrics *metrics.Metrics
+	// Serves metrics over HTTP. Metrics are not served if nil.
+	metricsServer *http.Server
+	// Exports traces of requests. Requests are not traced if nil.
+	tracerProvider *sdktrace.TracerProvider
+	// Serves the gRPC health service. Health is not served if nil.
+	healthServer healthServer
+	// Time between updates of the serving status from the health of clusters.
+	healthInterval time.Duration
+	// Set once the configured clusters are managed.
+	clustersSynced atomic.Bool
+	// Set once resources have been synced from clusters.
+	synced atomic.Bool
+	// Time Stop waits for requests and streams, such as health watches that never end on their own, to finish.
+	stopTimeout time.Duration
 
 	// Components for creating gRPC server and service
 	listener net.Listener
@@ -100,28 +234,144 @@ type Service struct {
 	admin.UnimplementedAdminServiceServer
 }
 
-func NewService(endpoint string) *Service {
+func NewService(endpoint string) (*Service, error) {
+	resourceManager, persistent, err := newResourceManager(appconfigs.Get())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource manager: %w", err)
+	}
+
+	strategy, err := newAllocationStrategy(appconfigs.Get(), resourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create allocation strategy: %w", err)
+	}
+
+	auditor, err := newAuditLogger(appconfigs.Get())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit logger: %w", err)
+	}
+
+	tracerProvider, err := newTracerProvider(appconfigs.Get())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracer provider: %w", err)
+	}
+
 	clusterManger := cluster.NewInMemoryManager()
+	healthInterval := time.Duration(appconfigs.Get().HealthCheckIntervalSecs) * time.Second
+	healthChecker := health.NewChecker(clusterManger, health.Config{
+		Interval:         healthInterval,
+		Timeout:          time.Duration(appconfigs.Get().HealthCheckTimeoutSecs) * time.Second,
+		FailureThreshold: appconfigs.Get().HealthCheckFailureThreshold,
+	})
 	s := &Service{
-		endpoint:         endpoint,
-		clientTranslator: translator.NewClientTranslator(),
-		clusterManager:   clusterManger,
-		resourceManager:  resource.NewInMemoryManager(),
-		allocator:        alloc.NewManager(clusterManger),
+		endpoint:             endpoint,
+		clientTranslator:     translator.NewClientTranslator(),
+		clusterManager:       clusterManger,
+		resourceManager:      resourceManager,
+		allocator:            alloc.NewManager(clusterManger, healthChecker, strategy),
+		health:               healthChecker,
+		persistentResources:  persistent,
+		listTimeout:          time.Duration(appconfigs.Get().ListTimeoutSecs) * time.Second,
+		capacityTimeout:      alloc.DefaultCapacityTimeout,
+		availabilityTimeout:  defaultAvailabilityTimeout,
+		operations:           operation.NewManager(time.Duration(appconfigs.Get().OperationRetentionSecs) * time.Second),
+		workflowPollInterval: defaultWorkflowPollInterval,
+		quotas:               quota.NewManager(resourceManager),
+		audit:                auditor,
+		metrics:              metrics.New(resourceManager),
+		tracerProvider:       tracerProvider,
+		healthServer:         grpchealth.NewServer(),
+		healthInterval:       healthInterval,
+		stopTimeout:          defaultStopTimeout,
+	}
+	s.clusterLifecycle = cluster.NewLifecycle(clusterManger, cluster.LifecycleConfig{
+		MinBackoff: time.Duration(appconfigs.Get().ClusterRetryMinSecs) * time.Second,
+		MaxBackoff: time.Duration(appconfigs.Get().ClusterRetryMaxSecs) * time.Second,
+		NewCluster: s.newCluster,
+		OnReady:    s.onClusterReady,
+		Drain:      s.drainCluster,
+	})
+	// Not ready until clusters, and resources unless persisted, are synced.
+	s.updateServingStatus()
+	if appconfigs.Get().QuotaFile != "" && !persistent {
+		log.Warn().Msg("Tenant ownership is kept in memory and lost on restart; use a file resource store with quotas")
+	}
+
+	return s, nil
+}
+
+// Creates a tracer provider exporting to the configured collector, or returns nil if tracing is disabled.
+func newTracerProvider(cfg appconfigs.AppConfig) (*sdktrace.TracerProvider, error) {
+	if cfg.TracingEndpoint == "" {
+		return nil, nil //nolint:nilnil // Tracing is disabled.
+	}
+	provider, err := tracing.NewProvider(context.Background(), tracing.Config{
+		Endpoint:    cfg.TracingEndpoint,
+		Insecure:    cfg.TracingInsecure,
+		SampleRatio: cfg.TracingSampleRatio,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up tracing to %s: %w", cfg.TracingEndpoint, err)
+	}
+	log.Info().Str("endpoint", cfg.TracingEndpoint).Msg("Exporting traces")
+
+	return provider, nil
+}
+
+// Creates the resource manager selected by the app configuration, and reports whether it is durable.
+func newResourceManager(cfg appconfigs.AppConfig) (resourceManager, bool, error) {
+	switch cfg.ResourceStore {
+	case "", appconfigs.ResourceStoreMemory:
+		return resource.NewInMemoryManager(), false, nil
+	case appconfigs.ResourceStoreFile:
+		m, err := resource.NewFileManager(cfg.ResourceStoreFile)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to open resource store %s: %w", cfg.ResourceStoreFile, err)
+		}
+
+		return m, true, nil
+	default:
+		return nil, false, fmt.Errorf("%w: %s", errUnsupportedResourceStore, cfg.ResourceStore)
 	}
+}
 
-	return s
+// Creates the allocation strategy selected by the app configuration.
+func newAllocationStrategy(cfg appconfigs.AppConfig, rm resourceManager) (alloc.Strategy, error) {
+	switch cfg.AllocationStrategy {
+	case "", appconfigs.AllocationStrategyRandom:
+		return alloc.NewRandomStrategy(), nil
+	case appconfigs.AllocationStrategyRoundRobin:
+		return alloc.NewRoundRobinStrategy(), nil
+	case appconfigs.AllocationStrategyWeighted:
+		return alloc.NewWeightedStrategy(), nil
+	case appconfigs.AllocationStrategyConsistentHash:
+		return alloc.NewConsistentHashStrategy(), nil
+	case appconfigs.AllocationStrategyFewestResources:
+		return alloc.NewFewestResourcesStrategy(rm), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedStrategy, cfg.AllocationStrategy)
+	}
 }
 
 // Loads cluster configuration, fetches resource metadata from each cluster, then serves.
+// With a durable resource store, serving starts from the persisted mappings once clusters are managed, and the
+// resource sync runs in the background.
 func (s *Service) Start() error {
 	err := s.loadClusterConfigs()
 	if err != nil {
 		return fmt.Errorf("failed to load cluster configuration: %w", err)
 	}
+	if err := s.loadQuotaConfig(); err != nil {
+		return fmt.Errorf("failed to load quota configuration: %w", err)
+	}
 
 	s.syncClusterManager()
-	s.syncResourceManager()
+	s.startHealthChecks()
+	if s.persistentResources {
+		log.Info().Msgf("Serving %d persisted resources, syncing in background", s.resourceManager.GetResourceCount())
+		go s.syncResourceManager()
+	} else {
+		s.syncResourceManager()
+	}
 
 	err = s.serve()
 	if err != nil {
@@ -148,16 +398,7 @@ func (s *Service) loadClusterConfigs() error {
 // Removes any clients that are not specified in the cluster configuration.
 func (s *Service) syncClusterManager() {
 	for _, c := range s.clusterConfigs.Clusters {
-		newCluster, err := cluster.NewCluster(c)
-		if err != nil {
-			log.Err(err).Msg("failed to create new cluster")
-		}
-
-		clusterID := newCluster.Config.ClusterID
-		err = s.clusterManager.Set(clusterID, newCluster)
-		if err != nil {
-			log.Err(err).Str("cluster_id", clusterID).Msg("failed to add client to cluster manager")
-		}
+		s.clusterLifecycle.Add(c)
 	}
 
 	// Remove cluster-client pairings not specified in configuration.
@@ -167,13 +408,42 @@ func (s *Service) syncClusterManager() {
 	})
 	for _, managedClusterID := range managedClusterIDs {
 		if !lo.Contains(desiredClusterIDs, managedClusterID) {
-			err := s.clusterManager.Remove(managedClusterID)
+			err := s.clusterLifecycle.Remove(managedClusterID)
 			if err != nil {
 				log.Warn().Str("cluster_id", managedClusterID).Interface("err", err).Msg("failed to remove cluster")
 			}
 		}
 	}
 	log.Info().Msg("Synced Cluster Manager.")
+	s.clustersSynced.Store(true)
+	s.updateServingStatus()
+}
+
+// Creates a cluster whose client is observed for metrics and traces, warning if it has labels that StorMS keeps only
+// in memory.
+func (s *Service) newCluster(cfg *cluster.Config) (*cluster.Cluster, error) {
+	c, err := cluster.NewCluster(cfg)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error names the cluster
+	}
+	c.Client = client.Observe(c.Client, s.metrics.ClientObserver(cfg.ClusterID, cfg.Vendor),
+		attribute.String("cluster_id", cfg.ClusterID), attribute.String("vendor", cfg.Vendor))
+	if _, ok := c.Client.(client.Labeler); !ok && !s.persistentResources {
+		log.Warn().Str("cluster_id", cfg.ClusterID).Str("vendor", cfg.Vendor).
+			Msg("Labels of this cluster are kept in memory and lost on restart; use a file resource store")
+	}
+
+	return c, nil
+}
+
+// Probes a cluster that became ready after failing, since its health still reflects the missing client, then maps
+// its resources and updates the serving status.
+func (s *Service) onClusterReady(clusterID string) {
+	if s.health != nil {
+		s.health.Reset(context.Background(), clusterID)
+	}
+	s.syncClusterResources(clusterID)
+	s.updateServingStatus()
 }
 
 // Adds all resources from managed clusters into resource mapper.
@@ -185,13 +455,7 @@ func (s *Service) syncResourceManager() {
 		wg.Add(1)
 		go func(cid string) {
 			defer wg.Done()
-			resources := s.fetchResourcesFromCluster(cid)
-			for _, r := range resources {
-				err := s.resourceManager.Map(r)
-				if err != nil {
-					log.Warn().Str("resource_id", r.ID).Interface("err", err).Msg("failed to map resource")
-				}
-			}
+			s.syncClusterResources(cid)
 		}(clusterID)
 	}
 	wg.Wait()
@@ -210,12 +474,71 @@ func (s *Service) syncResourceManager() {
 	}
 
 	log.Info().Msg("Synced Resource Manager.")
+	s.synced.Store(true)
+	s.updateServingStatus()
+}
+
+// Maps the resources of a cluster, and unmaps those it no longer reports.
+func (s *Service) syncClusterResources(clusterID string) {
+	// Mappings taken before fetching are the only candidates for pruning; anything mapped while the
+	// fetch is in flight (e.g. a newly created volume) must survive.
+	known := s.resourceManager.GetResourcesOfCluster(clusterID)
+	start := time.Now()
+	resources, err := s.fetchResourcesFromCluster(clusterID)
+	s.metrics.ObserveSync(clusterID, time.Since(start), err)
+	for _, r := range resources {
+		err := s.resourceManager.Map(r)
+		if err != nil {
+			log.Warn().Str("resource_id", r.ID).Interface("err", err).Msg("failed to map resource")
+		}
+	}
+	if err != nil {
+		// Listing was incomplete; keep existing mappings rather than drop live resources.
+		return
+	}
+	s.pruneResources(clusterID, known, resources)
 }
 
-func (s *Service) fetchResourcesFromCluster(clusterID string) []*resource.Resource {
+// Unmaps resources that were mapped to a cluster but are no longer reported by it.
+func (s *Service) pruneResources(clusterID string, known, fetched []*resource.Resource) {
+	fetchedIDs := lo.SliceToMap(fetched, func(r *resource.Resource) (string, struct{}) {
+		return r.ID, struct{}{}
+	})
+	pruned := 0
+	for _, r := range known {
+		if _, ok := fetchedIDs[r.ID]; ok {
+			continue
+		}
+		// Only prune if the resource is still mapped to this cluster.
+		if mapped, err := s.resourceManager.GetResourceCluster(r.ID); err != nil || mapped != clusterID {
+			continue
+		}
+		if err := s.resourceManager.Unmap(r.ID); err != nil {
+			log.Warn().Str("resource_id", r.ID).Interface("err", err).Msg("failed to unmap resource")
+
+			continue
+		}
+		pruned++
+	}
+	if pruned > 0 {
+		log.Info().Str("cluster_id", clusterID).Msgf("unmapped %d resources no longer on cluster", pruned)
+	}
+}
+
+// Lists all volumes and snapshots on a cluster. Returns whatever was fetched along with an error if either
+// listing failed.
+func (s *Service) fetchResourcesFromCluster(clusterID string) ([]*resource.Resource, error) {
 	c, err := s.clusterManager.Get(clusterID)
 	if err != nil {
 		log.Err(err).Str("cluster_id", clusterID).Msg("failed to fetch resources from cluster")
+
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	if !c.Ready() {
+		err := errClusterNotReady(c)
+		log.Err(err).Str("cluster_id", clusterID).Msg("failed to fetch resources from cluster")
+
+		return nil, err
 	}
 
 	// Set up timeout.
@@ -223,11 +546,13 @@ func (s *Service) fetchResourcesFromCluster(clusterID string) []*resource.Resour
 	defer cancel()
 
 	resources := make([]*resource.Resource, 0)
+	var fetchErr error
 
 	// Fetch volumes.
 	getVolResp, err := c.Client.GetVolumes(ctx, &models.GetVolumesRequest{})
 	if err != nil {
 		log.Err(err).Str("cluster_id", clusterID).Msg("failed to get volumes")
+		fetchErr = fmt.Errorf("failed to get volumes: %w", err)
 	} else {
 		volumes := lo.Map(getVolResp.Volumes, func(v *models.Volume, _ int) *resource.Resource {
 			return &resource.Resource{
@@ -244,6 +569,7 @@ func (s *Service) fetchResourcesFromCluster(clusterID string) []*resource.Resour
 	getSnapshotResp, err := c.Client.GetSnapshots(ctx, &models.GetSnapshotsRequest{})
 	if err != nil {
 		log.Err(err).Str("cluster_id", clusterID).Msg("failed to get snaphots")
+		fetchErr = fmt.Errorf("failed to get snapshots: %w", err)
 	} else {
 		snapshots := lo.Map(getSnapshotResp.Snapshots, func(s *models.Snapshot, _ int) *resource.Resource {
 			return &resource.Resource{
@@ -260,15 +586,44 @@ func (s *Service) fetchResourcesFromCluster(clusterID string) []*resource.Resour
 		log.Info().Str("cluster_id", clusterID).Msg("fetched 0 resources")
 	}
 
-	return resources
+	return resources, fetchErr
 }
 
 // Registers services and serves.
 func (s *Service) serve() error {
-	s.Server = grpc.NewServer(
-		grpc.UnaryInterceptor(loggingUnaryInterceptor),
-		grpc.StreamInterceptor(loggingStreamInterceptor),
-	)
+	unaryInterceptors := []grpc.UnaryServerInterceptor{s.metrics.UnaryServerInterceptor, loggingUnaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{loggingStreamInterceptor}
+	authn, err := newAuthenticator()
+	if err != nil {
+		return err
+	}
+	if authn != nil {
+		unaryInterceptors = append(unaryInterceptors, authorizationUnaryInterceptor(authn, s.auditDenied))
+		streamInterceptors = append(streamInterceptors, authorizationStreamInterceptor(authn))
+	}
+	if s.audit != nil {
+		unaryInterceptors = append(unaryInterceptors, s.auditUnaryInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, validationUnaryInterceptor)
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if s.tracerProvider != nil {
+		// Starts a span for each RPC, continuing the trace of the caller if its metadata carries one.
+		opts = append(opts, grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(s.tracerProvider))))
+	}
+	creds, err := s.serverCredentials()
+	if err != nil {
+		return err
+	}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	} else if authn != nil {
+		log.Warn().Msg("Authorization enabled without TLS, bearer tokens are sent in plaintext")
+	}
+	s.Server = grpc.NewServer(opts...)
 
 	listenConfig := net.ListenConfig{}
 	listener, err := listenConfig.Listen(context.Background(), tcpProtocol, s.endpoint)
@@ -280,6 +635,9 @@ func (s *Service) serve() error {
 	reflection.Register(s)
 	storms.RegisterStorageManagementServiceServer(s, s)
 	admin.RegisterAdminServiceServer(s, s)
+	if s.healthServer != nil {
+		healthpb.RegisterHealthServer(s, s.healthServer)
+	}
 
 	log.Info().Msg("Starting service")
 	go func() {
@@ -288,28 +646,188 @@ func (s *Service) serve() error {
 		}
 	}()
 
+	return s.serveMetrics()
+}
+
+// Serves metrics over HTTP on the configured port, if any.
+func (s *Service) serveMetrics() error {
+	cfg := appconfigs.Get()
+	if cfg.MetricsPort == 0 || s.metrics == nil {
+		return nil
+	}
+
+	endpoint := net.JoinHostPort(cfg.LocalIP, strconv.Itoa(cfg.MetricsPort))
+	listenConfig := net.ListenConfig{}
+	listener, err := listenConfig.Listen(context.Background(), tcpProtocol, endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %s: %w", endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, s.metrics.Handler())
+	s.metricsServer = &http.Server{Handler: mux, ReadHeaderTimeout: metricsReadHeaderTimeout}
+
+	log.Info().Str("endpoint", endpoint).Msg("Serving metrics")
+	go func() {
+		if err := s.metricsServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Err(err).Msg("failed to serve metrics")
+		}
+	}()
+
 	return nil
 }
 
+// Returns the TLS credentials configured for the server, or nil to serve plaintext. Their files are checked for
+// changes in the background until Stop.
+func (s *Service) serverCredentials() (credentials.TransportCredentials, error) {
+	cfg := appconfigs.Get()
+	certsConfig := certs.Config{
+		CertFile:          cfg.TLSCertFile,
+		KeyFile:           cfg.TLSKeyFile,
+		ClientCAFile:      cfg.TLSClientCAFile,
+		RequireClientCert: cfg.TLSRequireClientCert,
+	}
+	if !certsConfig.Enabled() {
+		log.Warn().Msg("No TLS certificate configured, serving plaintext gRPC")
+
+		return nil, nil //nolint:nilnil // plaintext has no credentials
+	}
+
+	reloader, err := certs.NewReloader(certsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificates: %w", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopCertReloads = cancel
+	go reloader.Run(ctx, certs.DefaultCheckInterval)
+	log.Info().Str("cert_file", cfg.TLSCertFile).Bool("require_client_cert", cfg.TLSRequireClientCert).
+		Msg("Serving gRPC over TLS")
+
+	return credentials.NewTLS(reloader.TLSConfig()), nil
+}
+
 func (s *Service) getClientForResource(resourceID string) (string, *cluster.Cluster, error) {
 	clusterID, err := s.resourceManager.GetResourceCluster(resourceID)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to get cluster for resource: %w", err)
 	}
-	c, err := s.clusterManager.Get(clusterID)
+	c, err := s.getRoutableCluster(clusterID)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to get client for cluster: %w", err)
+		return "", nil, err
 	}
 
 	return clusterID, c, nil
 }
 
+// Returns the cluster with the given ID, failing fast with Unavailable if it has no client or is unreachable.
+func (s *Service) getRoutableCluster(clusterID string) (*cluster.Cluster, error) {
+	c, err := s.clusterManager.Get(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client for cluster: %w", err)
+	}
+	if !c.Ready() {
+		return nil, errClusterNotReady(c)
+	}
+	if st := s.clusterHealth(clusterID); !st.Routable() {
+		return nil, fmt.Errorf("%w: cluster %s is %s: %s", errClusterUnavailable, clusterID, st.State, st.LastError)
+	}
+
+	return c, nil
+}
+
+// Returns the error of requests to a cluster that is not ready, with its state and why it failed.
+func errClusterNotReady(c *cluster.Cluster) error {
+	if c.LastError == "" {
+		return fmt.Errorf("%w: cluster %s is %s", errClusterUnavailable, c.Config.ClusterID, c.CurrentState())
+	}
+
+	return fmt.Errorf("%w: cluster %s is %s: %s", errClusterUnavailable, c.Config.ClusterID, c.CurrentState(),
+		c.LastError)
+}
+
+// Returns the health of a cluster, or StateUnknown if health checks are not running.
+func (s *Service) clusterHealth(clusterID string) health.Status {
+	if s.health == nil {
+		return health.Status{State: health.StateUnknown}
+	}
+
+	return s.health.Status(clusterID)
+}
+
+// Probes managed clusters, and updates the serving status from their health, in the background until Stop.
+func (s *Service) startHealthChecks() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopHealthChecks = cancel
+	if s.healthInterval > 0 {
+		go s.watchServingStatus(ctx, s.healthInterval)
+	}
+	if s.health != nil {
+		go s.health.Run(ctx)
+	}
+}
+
 func (s *Service) Stop() error {
-	s.Server.GracefulStop()
+	if s.healthServer != nil {
+		// Reports every service as not serving, so that load balancers drain the server while it stops.
+		s.healthServer.Shutdown()
+	}
+	s.stopServer()
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(context.Background()); err != nil {
+			return fmt.Errorf("failed to stop metrics server: %w", err)
+		}
+	}
+	if s.stopHealthChecks != nil {
+		s.stopHealthChecks()
+	}
+	if s.stopCertReloads != nil {
+		s.stopCertReloads()
+	}
+	if s.clusterLifecycle != nil {
+		s.clusterLifecycle.Stop()
+	}
+	if s.operations != nil {
+		s.operations.Stop()
+	}
+
+	if closer, ok := s.resourceManager.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			return fmt.Errorf("failed to close resource manager: %w", err)
+		}
+	}
+	if closer, ok := s.audit.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			return fmt.Errorf("failed to close audit logger: %w", err)
+		}
+	}
+	if s.tracerProvider != nil {
+		if err := s.tracerProvider.Shutdown(context.Background()); err != nil {
+			return fmt.Errorf("failed to flush traces: %w", err)
+		}
+	}
 
 	return nil
 }
 
+// Stops the gRPC server gracefully, and closes the connections still open after the stop timeout.
+func (s *Service) stopServer() {
+	stopped := make(chan struct{})
+	go func() {
+		s.Server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(s.stopTimeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+	case <-timer.C:
+		log.Warn().Dur("timeout", s.stopTimeout).Msg("Requests still running after stop timeout, closing connections")
+		s.Server.Stop()
+		<-stopped
+	}
+}
+
 // Begin -- static functions
 
 func loggingUnaryInterceptor(
@@ -318,11 +836,15 @@ func loggingUnaryInterceptor(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (resp interface{}, err error) {
+	if isHealthCheck(info.FullMethod) {
+		// Probes poll every few seconds and would drown out requests.
+		return handler(ctx, req)
+	}
 	start := time.Now()
 	requestLogger := log.Info()
 	requestLogger.
 		Str("grpc_method", info.FullMethod).
-		Interface("request", req).
+		Interface("request", redact.Request(req)).
 		Msg("Unary request received")
 
 	resp, err = handler(ctx, req)
@@ -6,14 +6,21 @@ import (
 	"fmt"
 
 	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	alloc "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
 	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
 )
 
 var (
 	errUnexpected              = errors.New("an unexpected error occurred")
 	errUnspecifiedResourceType = errors.New("unspecified resource type")
+	errNotVolumeSnapshot       = status.Error(codes.FailedPrecondition, "snapshot is not of the volume")
 )
 
 func (s *Service) GetVolume(ctx context.Context, req *storms.GetVolumeRequest,
@@ -27,7 +34,10 @@ func (s *Service) GetVolume(ctx context.Context, req *storms.GetVolumeRequest,
 
 	resp, err := s.clientTranslator.GetVolume(ctx, c.Client, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get volume in translation layer: %w", err)
+		return nil, clientError(c, fmt.Errorf("failed to get volume in translation layer: %w", err))
+	}
+	if err := s.withVolumeLabels(ctx, c, []*storms.Volume{resp.GetVolume()}, false); err != nil {
+		return nil, fmt.Errorf("failed to get volume labels: %w", err)
 	}
 
 	log.Info().Str("resource_id", volID).Str("cluster_id", clusterID).Msgf("fetched volume")
@@ -36,65 +46,289 @@ func (s *Service) GetVolume(ctx context.Context, req *storms.GetVolumeRequest,
 }
 
 func (s *Service) GetVolumes(ctx context.Context, req *storms.GetVolumesRequest) (*storms.GetVolumesResponse, error) {
-	out := []*storms.Volume{}
-	clusterIDs := s.clusterManager.AllIDs()
-	for _, clusterID := range clusterIDs {
-		c, err := s.clusterManager.Get(clusterID)
+	after, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page token: %w", err)
+	}
+	filter := req.GetFilter()
+	selector, err := parseLabelSelector(filter.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	out, failures, err := fanOutList(ctx, s, filter.GetClusterId(), filter.GetVendor(),
+		func(ctx context.Context, c *cluster.Cluster) ([]*storms.Volume, error) {
+			resp, err := s.clientTranslator.GetVolumes(ctx, c.Client, req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get volumes in translation layer: %w", err)
+			}
+			if err := s.withVolumeLabels(ctx, c, resp.Volumes, true); err != nil {
+				return nil, fmt.Errorf("failed to get volume labels: %w", err)
+			}
+			log.Info().Str("cluster_id", c.Config.ClusterID).Msgf("fetched volumes")
+
+			return lo.Filter(resp.Volumes, func(v *storms.Volume, _ int) bool {
+				return volumeMatches(v, filter, selector)
+			}), nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volumes: %w", err)
+	}
+
+	page, nextPageToken := paginate(out, (*storms.Volume).GetUuid, after, req.GetPageSize())
+
+	return &storms.GetVolumesResponse{
+		Volumes:         page,
+		NextPageToken:   nextPageToken,
+		ClusterFailures: failures,
+	}, nil
+}
+
+func (s *Service) CreateVolume(ctx context.Context, req *storms.CreateVolumeRequest,
+) (*storms.CreateVolumeResponse, error) {
+	release := s.createLocks.Lock(req.GetUuid())
+	defer func() {
+		// Once the mutation starts, it releases the lock when it completes.
+		if release != nil {
+			release()
+		}
+	}()
+
+	m := mutation{
+		async:        req.GetAsync(),
+		method:       "CreateVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   req.GetUuid(),
+	}
+	// The created or existing volume, if known, and the cluster holding it.
+	var volume *storms.Volume
+	var volumeClusterID string
+	m.result = func(ctx context.Context) proto.Message {
+		if volume != nil {
+			return volume
+		}
+
+		return s.volumeResult(req.GetUuid())(ctx)
+	}
+
+	existing, existingClusterID, err := s.findExistingVolume(ctx, req.GetUuid())
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up existing volume: %w", err)
+	}
+	if existing != nil {
+		matches, err := s.volumeMatchesRequest(ctx, existing, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare existing volume: %w", err)
+		}
+		if !matches {
+			return nil, fmt.Errorf("%w: volume %s on cluster %s", errResourceConflict, req.GetUuid(), existingClusterID)
+		}
+		log.Info().Str("cluster_id", existingClusterID).Str("resource_id", req.Uuid).Msg("volume already exists")
+		c, err := s.getRoutableCluster(existingClusterID)
+		if err != nil {
+			return nil, err
+		}
+		volume, volumeClusterID = existing, existingClusterID
+		m.run = func(ctx context.Context) error {
+			return s.labelExistingVolume(ctx, c, existing, req.GetLabels())
+		}
+	} else {
+		tenantID := req.GetTenantId()
+		err = s.requireTenant(tenantID, errTenantRequired)
+		if err != nil {
+			return nil, err
+		}
+		var size uint64
+		if tenantID != "" {
+			size, err = s.requestedVolumeSize(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+		}
+		releaseQuota, err := s.reserveQuota(tenantID, resource.Usage{Volumes: 1, ProvisionedBytes: size})
 		if err != nil {
-			return nil, fmt.Errorf("failed to get client: %w", err)
+			return nil, err
 		}
+		release = releaseBoth(release, releaseQuota)
 
-		resp, err := s.clientTranslator.GetVolumes(ctx, c.Client, req)
+		clusterID, err := s.placeVolume(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		c, err := s.getRoutableCluster(clusterID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get volumes in translation layer: %w", err)
+			return nil, err
+		}
+		volumeClusterID = clusterID
+
+		m.run = func(ctx context.Context) error {
+			resp, err := s.clientTranslator.CreateVolume(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to create volume in translation layer: %w", err))
+			}
+			volume = resp.GetVolume()
+			r := &resource.Resource{ID: req.Uuid, ClusterID: clusterID, ResourceType: resource.TypeVolume}
+			err = s.resourceManager.Map(r)
+			if err != nil {
+				log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to map resource")
+			}
+			s.recordOwnership(req.Uuid, tenantID, size)
+
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("created volume")
+
+			return s.labelCreatedVolume(ctx, c, volume, req.GetUuid(), req.GetLabels())
 		}
-		out = append(out, resp.Volumes...)
+	}
 
-		log.Info().Str("cluster_id", clusterID).Msgf("fetched volumes")
+	m.clusterIDs = []string{volumeClusterID}
+	m.release, release = release, nil
+	opID, err := s.runMutation(ctx, m)
+	if err != nil {
+		return nil, err
 	}
 
-	return &storms.GetVolumesResponse{
-		Volumes: out,
+	if m.async {
+		return &storms.CreateVolumeResponse{OperationId: opID}, nil
+	}
+	createdVolume, _ := m.result(ctx).(*storms.Volume)
+
+	return &storms.CreateVolumeResponse{
+		Volume:    createdVolume,
+		ClusterId: volumeClusterID,
 	}, nil
 }
 
-func (s *Service) CreateVolume(ctx context.Context, req *storms.CreateVolumeRequest,
-) (*storms.CreateVolumeResponse, error) {
-	var clusterID string
-	var err error
-
+// Returns the cluster a new volume should be created on: the cluster of its source snapshot, or one picked by the
+// allocator.
+func (s *Service) placeVolume(ctx context.Context, req *storms.CreateVolumeRequest) (string, error) {
 	switch source := req.GetSource().(type) {
 	case *storms.CreateVolumeRequest_FromSnapshot:
-		snapshotID := source.FromSnapshot.SnapshotUuid
-		clusterID, err = s.resourceManager.GetResourceCluster(snapshotID)
+		clusterID, err := s.resourceManager.GetResourceCluster(source.FromSnapshot.GetSnapshotUuid())
 		if err != nil {
-			return nil, fmt.Errorf("failed to get cluster for resource: %w", err)
+			return "", fmt.Errorf("failed to get cluster for resource: %w", err)
 		}
+
+		return clusterID, nil
 	case *storms.CreateVolumeRequest_FromNew:
-		clusterID, err = s.allocator.AllocateCluster(req.AffinityTags)
+		clusterID, err := s.allocator.AllocateCluster(ctx, &alloc.Request{
+			VolumeUUID:   req.GetUuid(),
+			AffinityTags: req.AffinityTags,
+			SizeBytes:    source.FromNew.GetSize(),
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to get allocate cluster for resource: %w", err)
+			return "", fmt.Errorf("failed to get allocate cluster for resource: %w", err)
 		}
+
+		return clusterID, nil
 	}
 
-	c, err := s.clusterManager.Get(clusterID)
+	return "", errUnexpected
+}
+
+// Clones are created on the cluster of the source volume. Like creates, they are keyed on the UUID of the new volume.
+func (s *Service) CloneVolume(ctx context.Context, req *storms.CloneVolumeRequest,
+) (*storms.CloneVolumeResponse, error) {
+	release := s.createLocks.Lock(req.GetUuid())
+	defer func() {
+		// Once the mutation starts, it releases the lock when it completes.
+		if release != nil {
+			release()
+		}
+	}()
+
+	clusterID, c, err := s.getClientForResource(req.GetSrcVolumeUuid())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get client for cluster: %w", err)
+		return nil, fmt.Errorf("failed to get client for resource: %w", err)
+	}
+
+	m := mutation{
+		async:        req.GetAsync(),
+		method:       "CloneVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   req.GetUuid(),
+	}
+	// The cloned or existing volume, if known.
+	var volume *storms.Volume
+	m.result = func(ctx context.Context) proto.Message {
+		if volume != nil {
+			return volume
+		}
+
+		return s.volumeResult(req.GetUuid())(ctx)
 	}
 
-	resp, err := s.clientTranslator.CreateVolume(ctx, c.Client, req)
+	existing, existingClusterID, err := s.findExistingVolume(ctx, req.GetUuid())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create volume in translation layer: %w", err)
+		return nil, fmt.Errorf("failed to look up existing volume: %w", err)
 	}
-	r := &resource.Resource{ID: req.Uuid, ClusterID: clusterID, ResourceType: resource.TypeVolume}
-	err = s.resourceManager.Map(r)
+	if existing != nil {
+		matches, err := s.volumeMatchesClone(ctx, c, existing, existingClusterID == clusterID, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare existing volume: %w", err)
+		}
+		if !matches {
+			return nil, fmt.Errorf("%w: volume %s on cluster %s", errResourceConflict, req.GetUuid(), existingClusterID)
+		}
+		log.Info().Str("cluster_id", existingClusterID).Str("resource_id", req.Uuid).Msg("volume already exists")
+		existingCluster, err := s.getRoutableCluster(existingClusterID)
+		if err != nil {
+			return nil, err
+		}
+		volume = existing
+		m.clusterIDs = []string{existingClusterID}
+		m.run = func(ctx context.Context) error {
+			return s.labelExistingVolume(ctx, existingCluster, existing, req.GetLabels())
+		}
+	} else {
+		// Clones are owned by the tenant of the source volume.
+		owner, _ := s.resourceManager.GetOwnership(req.GetSrcVolumeUuid())
+		err = s.requireTenant(owner.TenantID, errSourceWithoutTenant)
+		if err != nil {
+			return nil, err
+		}
+		releaseQuota, err := s.reserveQuota(owner.TenantID,
+			resource.Usage{Volumes: 1, ProvisionedBytes: owner.SizeBytes})
+		if err != nil {
+			return nil, err
+		}
+		release = releaseBoth(release, releaseQuota)
+
+		m.clusterIDs = []string{clusterID}
+		m.run = func(ctx context.Context) error {
+			resp, err := s.clientTranslator.CloneVolume(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to clone volume in translation layer: %w", err))
+			}
+			volume = resp.GetVolume()
+			r := &resource.Resource{ID: req.Uuid, ClusterID: clusterID, ResourceType: resource.TypeVolume}
+			err = s.resourceManager.Map(r)
+			if err != nil {
+				log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to map resource")
+			}
+			s.recordOwnership(req.Uuid, owner.TenantID, owner.SizeBytes)
+
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).
+				Str("src_resource_id", req.SrcVolumeUuid).Msg("cloned volume")
+
+			return s.labelCreatedVolume(ctx, c, volume, req.GetUuid(), req.GetLabels())
+		}
+	}
+
+	m.release, release = release, nil
+	opID, err := s.runMutation(ctx, m)
 	if err != nil {
-		log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to map resource")
+		return nil, err
 	}
 
-	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("created volume")
+	if m.async {
+		return &storms.CloneVolumeResponse{OperationId: opID}, nil
+	}
+	clonedVolume, _ := m.result(ctx).(*storms.Volume)
 
-	return resp, nil
+	return &storms.CloneVolumeResponse{
+		Volume:    clonedVolume,
+		ClusterId: clusterID,
+	}, nil
 }
 
 func (s *Service) ResizeVolume(ctx context.Context, req *storms.ResizeVolumeRequest,
@@ -104,35 +338,142 @@ func (s *Service) ResizeVolume(ctx context.Context, req *storms.ResizeVolumeRequ
 	if err != nil {
 		return nil, fmt.Errorf("failed to get client for resource: %w", err)
 	}
-	resp, err := s.clientTranslator.ResizeVolume(ctx, c.Client, req)
+
+	owner, owned := s.resourceManager.GetOwnership(volID)
+	var growth uint64
+	if req.GetSize() > owner.SizeBytes {
+		growth = req.GetSize() - owner.SizeBytes
+	}
+	releaseQuota, err := s.reserveQuota(owner.TenantID, resource.Usage{ProvisionedBytes: growth})
 	if err != nil {
-		return nil, fmt.Errorf("failed to resize volume in translation layer: %w", err)
+		return nil, err
 	}
-	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("resized volume")
 
-	return resp, nil
+	opID, err := s.runMutation(ctx, mutation{
+		async:        req.GetAsync(),
+		method:       "ResizeVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   volID,
+		clusterIDs:   []string{clusterID},
+		result:       s.volumeResult(volID),
+		release:      releaseQuota,
+		run: func(ctx context.Context) error {
+			_, err := s.clientTranslator.ResizeVolume(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to resize volume in translation layer: %w", err))
+			}
+			if owned {
+				s.recordOwnership(volID, owner.TenantID, req.GetSize())
+			}
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("resized volume")
+
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &storms.ResizeVolumeResponse{OperationId: opID}, nil
 }
 
-func (s *Service) DeleteVolume(ctx context.Context, req *storms.DeleteVolumeRequest,
-) (*storms.DeleteVolumeResponse, error) {
+// Reverts are validated by the client, which rejects snapshots of other volumes and attached volumes. A snapshot on
+// another cluster is rejected here without calling the client.
+func (s *Service) RevertVolumeToSnapshot(ctx context.Context, req *storms.RevertVolumeToSnapshotRequest,
+) (*storms.RevertVolumeToSnapshotResponse, error) {
 	volID := req.GetUuid()
 	clusterID, c, err := s.getClientForResource(volID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get client for resource: %w", err)
 	}
+	snapshotClusterID, err := s.resourceManager.GetResourceCluster(req.GetSnapshotUuid())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster for resource: %w", err)
+	}
+	if snapshotClusterID != clusterID {
+		return nil, fmt.Errorf("%w: snapshot %s is on cluster %s", errNotVolumeSnapshot, req.GetSnapshotUuid(),
+			snapshotClusterID)
+	}
 
-	resp, err := s.clientTranslator.DeleteVolume(ctx, c.Client, req)
+	owner, owned := s.resourceManager.GetOwnership(volID)
+	opID, err := s.runMutation(ctx, mutation{
+		async:        req.GetAsync(),
+		method:       "RevertVolumeToSnapshot",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   volID,
+		clusterIDs:   []string{clusterID},
+		result:       s.volumeResult(volID),
+		run: func(ctx context.Context) error {
+			_, err := s.clientTranslator.RevertVolumeToSnapshot(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to revert volume in translation layer: %w", err))
+			}
+			if owned {
+				s.recordRevertedSize(ctx, c, volID, owner.TenantID)
+			}
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).
+				Str("snapshot_id", req.SnapshotUuid).Msg("reverted volume to snapshot")
+
+			return nil
+		},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete volume in translation layer: %w", err)
+		return nil, err
+	}
+	if req.GetAsync() {
+		return &storms.RevertVolumeToSnapshotResponse{OperationId: opID}, nil
 	}
-	err = s.resourceManager.Unmap(req.GetUuid())
+	volume, _ := s.volumeResult(volID)(ctx).(*storms.Volume)
+
+	return &storms.RevertVolumeToSnapshotResponse{Volume: volume}, nil
+}
+
+// Records the size of a reverted volume, which takes the size of the snapshot on some vendors. Failures are logged,
+// leaving the previous size recorded.
+func (s *Service) recordRevertedSize(ctx context.Context, c *cluster.Cluster, volID, tenantID string) {
+	resp, err := s.clientTranslator.GetVolume(ctx, c.Client, &storms.GetVolumeRequest{Uuid: volID})
+	if err != nil {
+		log.Warn().Str("resource_id", volID).Err(err).Msg("failed to get size of reverted volume")
+
+		return
+	}
+	s.recordOwnership(volID, tenantID, resp.GetVolume().GetSize())
+}
+
+func (s *Service) DeleteVolume(ctx context.Context, req *storms.DeleteVolumeRequest,
+) (*storms.DeleteVolumeResponse, error) {
+	volID := req.GetUuid()
+	clusterID, c, err := s.getClientForResource(volID)
 	if err != nil {
-		log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to unmap resource")
+		return nil, fmt.Errorf("failed to get client for resource: %w", err)
 	}
 
-	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("deleted volume")
+	opID, err := s.runMutation(ctx, mutation{
+		async:        req.GetAsync(),
+		method:       "DeleteVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   volID,
+		clusterIDs:   []string{clusterID},
+		run: func(ctx context.Context) error {
+			_, err := s.clientTranslator.DeleteVolume(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to delete volume in translation layer: %w", err))
+			}
+			err = s.resourceManager.Unmap(req.GetUuid())
+			if err != nil {
+				log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to unmap resource")
+			}
+
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("deleted volume")
+
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return resp, nil
+	return &storms.DeleteVolumeResponse{OperationId: opID}, nil
 }
 
 func (s *Service) AttachVolume(ctx context.Context, req *storms.AttachVolumeRequest,
@@ -143,14 +484,29 @@ func (s *Service) AttachVolume(ctx context.Context, req *storms.AttachVolumeRequ
 		return nil, fmt.Errorf("failed to get client for resource: %w", err)
 	}
 
-	resp, err := s.clientTranslator.AttachVolume(ctx, c.Client, req)
+	opID, err := s.runMutation(ctx, mutation{
+		async:        req.GetAsync(),
+		method:       "AttachVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   volID,
+		clusterIDs:   []string{clusterID},
+		result:       s.volumeResult(volID),
+		run: func(ctx context.Context) error {
+			_, err := s.clientTranslator.AttachVolume(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to attach volume in translation layer: %w", err))
+			}
+
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("attached volume")
+
+			return nil
+		},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to attach volume in translation layer: %w", err)
+		return nil, err
 	}
 
-	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("attached volume")
-
-	return resp, nil
+	return &storms.AttachVolumeResponse{OperationId: opID}, nil
 }
 
 func (s *Service) DetachVolume(ctx context.Context, req *storms.DetachVolumeRequest,
@@ -161,14 +517,29 @@ func (s *Service) DetachVolume(ctx context.Context, req *storms.DetachVolumeRequ
 		return nil, fmt.Errorf("failed to get client for resource: %w", err)
 	}
 
-	resp, err := s.clientTranslator.DetachVolume(ctx, c.Client, req)
+	opID, err := s.runMutation(ctx, mutation{
+		async:        req.GetAsync(),
+		method:       "DetachVolume",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+		resourceID:   volID,
+		clusterIDs:   []string{clusterID},
+		result:       s.volumeResult(volID),
+		run: func(ctx context.Context) error {
+			_, err := s.clientTranslator.DetachVolume(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to detach volume in translation layer: %w", err))
+			}
+
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("detached volume")
+
+			return nil
+		},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to detach volume in translation layer: %w", err)
+		return nil, err
 	}
 
-	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("detached volume")
-
-	return resp, nil
+	return &storms.DetachVolumeResponse{OperationId: opID}, nil
 }
 
 func (s *Service) GetSnapshot(ctx context.Context, req *storms.GetSnapshotRequest,
@@ -181,7 +552,10 @@ func (s *Service) GetSnapshot(ctx context.Context, req *storms.GetSnapshotReques
 
 	resp, err := s.clientTranslator.GetSnapshot(ctx, c.Client, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get snapshot translation layer: %w", err)
+		return nil, clientError(c, fmt.Errorf("failed to get snapshot translation layer: %w", err))
+	}
+	if err := s.withSnapshotLabels(ctx, c, []*storms.Snapshot{resp.GetSnapshot()}, false); err != nil {
+		return nil, fmt.Errorf("failed to get snapshot labels: %w", err)
 	}
 
 	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("fetched snapshot")
@@ -191,53 +565,149 @@ func (s *Service) GetSnapshot(ctx context.Context, req *storms.GetSnapshotReques
 
 func (s *Service) GetSnapshots(ctx context.Context, req *storms.GetSnapshotsRequest,
 ) (*storms.GetSnapshotsResponse, error) {
-	out := []*storms.Snapshot{}
-
-	clusterIDs := s.clusterManager.AllIDs()
-	for _, clusterID := range clusterIDs {
-		c, err := s.clusterManager.Get(clusterID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get client: %w", err)
-		}
-
-		snapshots, err := s.clientTranslator.GetSnapshots(ctx, c.Client, req)
-		if err != nil {
-			return nil, fmt.Errorf("faild to get snapshots in translation layer: %w", err)
-		}
-		out = append(out, snapshots.Snapshots...)
+	after, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page token: %w", err)
+	}
+	filter := req.GetFilter()
+	selector, err := parseLabelSelector(filter.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
 
-		log.Info().Str("cluster_id", clusterID).Msgf("fetched snapshots")
+	out, failures, err := fanOutList(ctx, s, filter.GetClusterId(), filter.GetVendor(),
+		func(ctx context.Context, c *cluster.Cluster) ([]*storms.Snapshot, error) {
+			resp, err := s.clientTranslator.GetSnapshots(ctx, c.Client, req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get snapshots in translation layer: %w", err)
+			}
+			if err := s.withSnapshotLabels(ctx, c, resp.Snapshots, true); err != nil {
+				return nil, fmt.Errorf("failed to get snapshot labels: %w", err)
+			}
+			log.Info().Str("cluster_id", c.Config.ClusterID).Msgf("fetched snapshots")
+
+			return lo.Filter(resp.Snapshots, func(snapshot *storms.Snapshot, _ int) bool {
+				return snapshotMatches(snapshot, filter, selector)
+			}), nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshots: %w", err)
 	}
 
+	page, nextPageToken := paginate(out, (*storms.Snapshot).GetUuid, after, req.GetPageSize())
+
 	return &storms.GetSnapshotsResponse{
-		Snapshots: out,
+		Snapshots:       page,
+		NextPageToken:   nextPageToken,
+		ClusterFailures: failures,
 	}, nil
 }
 
 func (s *Service) CreateSnapshot(ctx context.Context, req *storms.CreateSnapshotRequest,
 ) (*storms.CreateSnapshotResponse, error) {
-	clusterID, err := s.resourceManager.GetResourceCluster(req.GetSrcVolumeUuid())
-	if err != nil {
-		return nil, fmt.Errorf("failed to get cluster for resource: %w", err)
+	release := s.createLocks.Lock(req.GetUuid())
+	defer func() {
+		// Once the mutation starts, it releases the lock when it completes.
+		if release != nil {
+			release()
+		}
+	}()
+
+	m := mutation{
+		async:        req.GetAsync(),
+		method:       "CreateSnapshot",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_SNAPSHOT,
+		resourceID:   req.GetUuid(),
 	}
-	c, err := s.clusterManager.Get(clusterID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get client for cluster: %w", err)
+	// The created or existing snapshot, if known, and the cluster holding it.
+	var snapshot *storms.Snapshot
+	var snapshotClusterID string
+	m.result = func(ctx context.Context) proto.Message {
+		if snapshot != nil {
+			return snapshot
+		}
+
+		return s.snapshotResult(req.GetUuid())(ctx)
 	}
 
-	resp, err := s.clientTranslator.CreateSnapshot(ctx, c.Client, req)
+	existing, existingClusterID, err := s.findExistingSnapshot(ctx, req.GetUuid())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create snapshot in translation layer: %w", err)
+		return nil, fmt.Errorf("failed to look up existing snapshot: %w", err)
+	}
+	if existing != nil {
+		if !snapshotMatchesRequest(existing, req) {
+			return nil, fmt.Errorf("%w: snapshot %s on cluster %s", errResourceConflict, req.GetUuid(), existingClusterID)
+		}
+		log.Info().Str("cluster_id", existingClusterID).Str("resource_id", req.Uuid).Msg("snapshot already exists")
+		c, err := s.getRoutableCluster(existingClusterID)
+		if err != nil {
+			return nil, err
+		}
+		snapshot, snapshotClusterID = existing, existingClusterID
+		m.run = func(ctx context.Context) error {
+			return s.labelExistingSnapshot(ctx, c, existing, req.GetLabels())
+		}
+	} else {
+		clusterID, err := s.resourceManager.GetResourceCluster(req.GetSrcVolumeUuid())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cluster for resource: %w", err)
+		}
+		c, err := s.getRoutableCluster(clusterID)
+		if err != nil {
+			return nil, err
+		}
+		snapshotClusterID = clusterID
+
+		tenantID := req.GetTenantId()
+		if tenantID == "" {
+			owner, _ := s.resourceManager.GetOwnership(req.GetSrcVolumeUuid())
+			tenantID = owner.TenantID
+		}
+		err = s.requireTenant(tenantID, errTenantRequired)
+		if err != nil {
+			return nil, err
+		}
+		releaseQuota, err := s.reserveQuota(tenantID, resource.Usage{Snapshots: 1})
+		if err != nil {
+			return nil, err
+		}
+		release = releaseBoth(release, releaseQuota)
+
+		m.run = func(ctx context.Context) error {
+			resp, err := s.clientTranslator.CreateSnapshot(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to create snapshot in translation layer: %w", err))
+			}
+			snapshot = resp.GetSnapshot()
+			r := &resource.Resource{ID: req.Uuid, ClusterID: clusterID, ResourceType: resource.TypeSnapshot}
+			err = s.resourceManager.Map(r)
+			if err != nil {
+				log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to map resource")
+			}
+			s.recordOwnership(req.Uuid, tenantID, 0)
+
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("created snapshot")
+
+			return s.labelCreatedSnapshot(ctx, c, snapshot, req.GetUuid(), req.GetLabels())
+		}
 	}
-	r := &resource.Resource{ID: req.Uuid, ClusterID: clusterID, ResourceType: resource.TypeSnapshot}
-	err = s.resourceManager.Map(r)
+
+	m.clusterIDs = []string{snapshotClusterID}
+	m.release, release = release, nil
+	opID, err := s.runMutation(ctx, m)
 	if err != nil {
-		log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to map resource")
+		return nil, err
 	}
 
-	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("created snapshot")
+	if m.async {
+		return &storms.CreateSnapshotResponse{OperationId: opID}, nil
+	}
+	createdSnapshot, _ := m.result(ctx).(*storms.Snapshot)
 
-	return resp, nil
+	return &storms.CreateSnapshotResponse{
+		Snapshot:  createdSnapshot,
+		ClusterId: snapshotClusterID,
+	}, nil
 }
 
 func (s *Service) DeleteSnapshot(ctx context.Context, req *storms.DeleteSnapshotRequest,
@@ -249,18 +719,32 @@ func (s *Service) DeleteSnapshot(ctx context.Context, req *storms.DeleteSnapshot
 		return nil, fmt.Errorf("failed to get client for resource: %w", err)
 	}
 
-	resp, err := s.clientTranslator.DeleteSnapshot(ctx, c.Client, req)
+	opID, err := s.runMutation(ctx, mutation{
+		async:        req.GetAsync(),
+		method:       "DeleteSnapshot",
+		resourceType: storms.ResourceType_RESOURCE_TYPE_SNAPSHOT,
+		resourceID:   snapshotID,
+		clusterIDs:   []string{clusterID},
+		run: func(ctx context.Context) error {
+			_, err := s.clientTranslator.DeleteSnapshot(ctx, c.Client, req)
+			if err != nil {
+				return clientError(c, fmt.Errorf("failed to delete volume in translation layer: %w", err))
+			}
+			err = s.resourceManager.Unmap(req.GetUuid())
+			if err != nil {
+				log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to unmap resource")
+			}
+
+			log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("deleted snapshot")
+
+			return nil
+		},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete volume in translation layer: %w", err)
+		return nil, err
 	}
-	err = s.resourceManager.Unmap(req.GetUuid())
-	if err != nil {
-		log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to unmap resource")
-	}
-
-	log.Info().Str("cluster_id", clusterID).Str("resource_id", req.Uuid).Msg("deleted snapshot")
 
-	return resp, nil
+	return &storms.DeleteSnapshotResponse{OperationId: opID}, nil
 }
 
 func (s *Service) SyncResource(ctx context.Context, req *storms.SyncResourceRequest,
@@ -273,17 +757,53 @@ func (s *Service) SyncResource(ctx context.Context, req *storms.SyncResourceRequ
 		targetClusterIDs = []string{req.ClusterUuid}
 	}
 
-	found := false
-	for _, targetClusterID := range targetClusterIDs {
-		r := &resource.Resource{ID: req.Uuid, ClusterID: targetClusterID}
-		if req.ResourceType == storms.ResourceType_RESOURCE_TYPE_SNAPSHOT {
-			r.ResourceType = resource.TypeSnapshot
-		} else if req.ResourceType == storms.ResourceType_RESOURCE_TYPE_VOLUME {
-			r.ResourceType = resource.TypeVolume
+	var resourceType resource.Type
+	if req.ResourceType == storms.ResourceType_RESOURCE_TYPE_SNAPSHOT {
+		resourceType = resource.TypeSnapshot
+	} else if req.ResourceType == storms.ResourceType_RESOURCE_TYPE_VOLUME {
+		resourceType = resource.TypeVolume
+	}
+
+	// Labels stored by StorMS and ownership are dropped when the resource is unmapped below, so they are restored once
+	// it is found, or with its previous mapping if the sync fails.
+	labels := s.resourceManager.GetLabels(req.Uuid)
+	owner, owned := s.resourceManager.GetOwnership(req.Uuid)
+	prevClusterID, prevErr := s.resourceManager.GetResourceCluster(req.Uuid)
+	restore := func() {
+		if len(labels) > 0 {
+			if err := s.resourceManager.SetLabels(req.Uuid, labels); err != nil {
+				log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to restore labels")
+			}
+		}
+		if owned {
+			s.recordOwnership(req.Uuid, owner.TenantID, owner.SizeBytes)
 		}
+	}
+	restorePrevious := func() {
+		if prevErr != nil {
+			if err := s.resourceManager.Unmap(req.Uuid); err != nil {
+				log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to unmap resource")
+			}
 
+			return
+		}
+		prev := &resource.Resource{ID: req.Uuid, ClusterID: prevClusterID, ResourceType: resourceType}
+		if err := s.resourceManager.Map(prev); err != nil {
+			log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to restore resource mapping")
+		}
+		restore()
+	}
+
+	found := false
+	// Set if a cluster could not be checked, in which case the resource may still be there.
+	var unavailableErr error
+	for _, targetClusterID := range targetClusterIDs {
 		// Map resource to client to enable request to be routed to cluster
-		err := s.resourceManager.Map(r)
+		err := s.resourceManager.Map(&resource.Resource{
+			ID:           req.Uuid,
+			ClusterID:    targetClusterID,
+			ResourceType: resourceType,
+		})
 		if err != nil {
 			log.Warn().Str("resource_id", req.Uuid).Interface("err", err).Msg("failed to map resource")
 		}
@@ -293,13 +813,21 @@ func (s *Service) SyncResource(ctx context.Context, req *storms.SyncResourceRequ
 			ClusterUuid:  targetClusterID,
 		}
 		found, err = s.syncResourceHelper(ctx, reqWithClusterID)
-		if err != nil {
+		switch {
+		case errors.Is(err, errClusterUnavailable):
+			log.Warn().Str("resource_id", req.Uuid).Str("cluster_id", targetClusterID).Err(err).
+				Msg("skipping unavailable cluster in resource sync")
+			unavailableErr = err
+		case err != nil:
+			restorePrevious()
+
 			return nil, fmt.Errorf("failed to sync resource: %w", err)
 		}
 
 		// If resource is found, keep it in mapped.
 		if found {
 			log.Info().Str("resource_id", req.Uuid).Str("cluster_id", targetClusterID).Msg("resource found and added")
+			restore()
 
 			break
 		}
@@ -311,6 +839,12 @@ func (s *Service) SyncResource(ctx context.Context, req *storms.SyncResourceRequ
 		}
 	}
 
+	if !found && unavailableErr != nil {
+		// The resource may be on a cluster that could not be checked, so its mapping is left as it was.
+		restorePrevious()
+
+		return nil, fmt.Errorf("failed to sync resource: %w", unavailableErr)
+	}
 	if !found {
 		log.Info().Str("resource_id", req.Uuid).Msg("resource not found and removed")
 	}
@@ -327,6 +861,9 @@ func (s *Service) syncResourceHelper(ctx context.Context, req *storms.SyncResour
 			Uuid: req.Uuid,
 		}
 		getVolResp, err := s.GetVolume(ctx, getVolReq)
+		if errors.Is(err, errClusterUnavailable) {
+			return false, err
+		}
 		if err != nil {
 			return false, nil //nolint:nilerr // non-nil error means not found
 		}
@@ -339,6 +876,9 @@ func (s *Service) syncResourceHelper(ctx context.Context, req *storms.SyncResour
 			Uuid: req.Uuid,
 		}
 		getSnapshotResp, err := s.GetSnapshot(ctx, getSnapshotReq)
+		if errors.Is(err, errClusterUnavailable) {
+			return false, err
+		}
 		if err != nil {
 			return false, nil //nolint:nilerr // non-nil error means not found
 		}
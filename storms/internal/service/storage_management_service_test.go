@@ -12,12 +12,17 @@ import (
 	clientmocks "gitlab.com/crusoeenergy/island/storage/storms/client/mocks"
 	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	alloc "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator"
 	allocatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/allocator/mocks"
 	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster"
 	clustermocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/cluster/mocks"
+	"gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health"
+	healthmocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/health/mocks"
 	resource "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource"
 	resourcemocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/resource/mocks"
 	translatormocks "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -91,6 +96,7 @@ var (
 		},
 	}
 	resourceID2  = uuid.NewString()
+	errUnmapped  = status.Error(codes.NotFound, "unmapped resource")
 	mockCluster2 = &cluster.Cluster{
 		Config: &cluster.Config{
 			Vendor:       vendor2,
@@ -217,10 +223,38 @@ func Test_GetVolumes(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	require.Len(t, resp.Volumes, 2)
+	require.Empty(t, resp.NextPageToken)
 	for _, v := range resp.Volumes {
 		require.NotNil(t, v.CreatedAt)
 		require.Equal(t, expectedTimestamp.AsTime(), v.CreatedAt.AsTime())
 	}
+
+	// Filter by vendor.
+	resp, err = s.GetVolumes(context.Background(), &storms.GetVolumesRequest{
+		Filter: &storms.VolumeFilter{Vendor: vendor2},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Volumes, 1)
+	require.Equal(t, resourceID2, resp.Volumes[0].Uuid)
+
+	// Page through one volume at a time.
+	seen := []string{}
+	pageToken := ""
+	for {
+		resp, err = s.GetVolumes(context.Background(), &storms.GetVolumesRequest{PageSize: 1, PageToken: pageToken})
+		require.NoError(t, err)
+		require.Len(t, resp.Volumes, 1)
+		seen = append(seen, resp.Volumes[0].Uuid)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	require.ElementsMatch(t, []string{resourceID1, resourceID2}, seen)
+	require.IsIncreasing(t, seen)
+
+	_, err = s.GetVolumes(context.Background(), &storms.GetVolumesRequest{PageToken: "%%%"})
+	require.Error(t, err)
 }
 
 func Test_CreateVolume(t *testing.T) {
@@ -255,33 +289,42 @@ func Test_CreateVolume(t *testing.T) {
 		},
 	}
 
-	s := &Service{
-		clusterManager: &clustermocks.MockClusterManager{
-			MockGet: func(clusterID string) (*cluster.Cluster, error) {
-				return mockCluster1, nil
-			},
-		},
-		resourceManager: &resourcemocks.MockResourceManager{
-			MockGetResourceCluster: func(resourceID string) (string, error) {
-				return clusterID1, nil
-			},
-			MockMap: func(r *resource.Resource) error { return nil },
-		},
-		allocator: &allocatormocks.MockAllocator{
-			MockAllocateCluster: func(affinityTags map[string]string) (string, error) {
-				return clusterID1, nil
-			},
-		},
-		clientTranslator: &translatormocks.MockClientTranslator{
-			MockCreateVolume: func(ctx context.Context, c client.Client, req *storms.CreateVolumeRequest,
-			) (*storms.CreateVolumeResponse, error) {
-				return &storms.CreateVolumeResponse{}, nil
-			},
-		},
-	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet: func(clusterID string) (*cluster.Cluster, error) {
+						return mockCluster1, nil
+					},
+					MockAllIDs: func() []string { return []string{clusterID1} },
+				},
+				resourceManager: &resourcemocks.MockResourceManager{
+					MockGetResourceCluster: func(resourceID string) (string, error) {
+						if resourceID == tt.input.GetUuid() {
+							return "", errUnmapped
+						}
+
+						return clusterID1, nil
+					},
+					MockMap: func(r *resource.Resource) error { return nil },
+				},
+				allocator: &allocatormocks.MockAllocator{
+					MockAllocateCluster: func(_ context.Context, _ *alloc.Request) (string, error) {
+						return clusterID1, nil
+					},
+				},
+				clientTranslator: &translatormocks.MockClientTranslator{
+					MockGetVolume: func(ctx context.Context, c client.Client, req *storms.GetVolumeRequest,
+					) (*storms.GetVolumeResponse, error) {
+						return nil, models.ErrNotFound
+					},
+					MockCreateVolume: func(ctx context.Context, c client.Client, req *storms.CreateVolumeRequest,
+					) (*storms.CreateVolumeResponse, error) {
+						return &storms.CreateVolumeResponse{}, nil
+					},
+				},
+			}
+
 			resp, err := s.CreateVolume(context.Background(), tt.input)
 			if tt.expectErr {
 				require.Error(t, err)
@@ -321,6 +364,87 @@ func Test_ResizeVolume(t *testing.T) {
 	require.NotNil(t, resp)
 }
 
+func Test_RevertVolumeToSnapshot(t *testing.T) {
+	volID := uuid.NewString()
+	snapshotID := uuid.NewString()
+
+	tests := []struct {
+		name           string
+		snapshotClusID string
+		revertErr      error
+		expectCode     codes.Code
+		expectReverted bool
+	}{
+		{
+			name:           "reverted",
+			snapshotClusID: clusterID1,
+			expectCode:     codes.OK,
+			expectReverted: true,
+		},
+		{
+			name:           "snapshot on another cluster",
+			snapshotClusID: clusterID2,
+			expectCode:     codes.FailedPrecondition,
+		},
+		{
+			name:           "rejected by client",
+			snapshotClusID: clusterID1,
+			revertErr:      fmt.Errorf("volume is attached: %w", models.ErrFailedPrecondition),
+			expectCode:     codes.FailedPrecondition,
+			expectReverted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reverted := false
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet: func(clusterID string) (*cluster.Cluster, error) {
+						return mockCluster1, nil
+					},
+				},
+				resourceManager: &resourcemocks.MockResourceManager{
+					MockGetResourceCluster: func(resourceID string) (string, error) {
+						if resourceID == snapshotID {
+							return tt.snapshotClusID, nil
+						}
+
+						return clusterID1, nil
+					},
+				},
+				clientTranslator: &translatormocks.MockClientTranslator{
+					MockRevertVolumeToSnapshot: func(_ context.Context, _ client.Client,
+						req *storms.RevertVolumeToSnapshotRequest,
+					) (*storms.RevertVolumeToSnapshotResponse, error) {
+						reverted = true
+						require.Equal(t, snapshotID, req.GetSnapshotUuid())
+						if tt.revertErr != nil {
+							return nil, tt.revertErr
+						}
+
+						return &storms.RevertVolumeToSnapshotResponse{}, nil
+					},
+					MockGetVolume: func(_ context.Context, _ client.Client, req *storms.GetVolumeRequest,
+					) (*storms.GetVolumeResponse, error) {
+						return &storms.GetVolumeResponse{Volume: &storms.Volume{Uuid: req.GetUuid()}}, nil
+					},
+				},
+			}
+
+			resp, err := s.RevertVolumeToSnapshot(context.Background(), &storms.RevertVolumeToSnapshotRequest{
+				Uuid:         volID,
+				SnapshotUuid: snapshotID,
+			})
+			require.Equal(t, tt.expectCode, status.Code(err))
+			require.Equal(t, tt.expectReverted, reverted)
+			if err == nil {
+				require.Equal(t, volID, resp.GetVolume().GetUuid())
+			}
+		})
+	}
+}
+
 func Test_DeleteVolume(t *testing.T) {
 	s := &Service{
 		clusterManager: &clustermocks.MockClusterManager{
@@ -532,34 +656,43 @@ func Test_GetSnapshots(t *testing.T) {
 }
 
 func Test_CreateSnapshot(t *testing.T) {
+	req := &storms.CreateSnapshotRequest{
+		Uuid:          uuid.NewString(),
+		SrcVolumeUuid: uuid.NewString(),
+	}
 	s := &Service{
 		clusterManager: &clustermocks.MockClusterManager{
 			MockGet: func(clusterID string) (*cluster.Cluster, error) {
 				return mockCluster1, nil
 			},
+			MockAllIDs: func() []string { return []string{clusterID1} },
 		},
 		resourceManager: &resourcemocks.MockResourceManager{
 			MockGetResourceCluster: func(resourceID string) (string, error) {
+				if resourceID == req.GetUuid() {
+					return "", errUnmapped
+				}
+
 				return clusterID1, nil
 			},
 			MockMap: func(r *resource.Resource) error { return nil },
 		},
 		allocator: &allocatormocks.MockAllocator{
-			MockAllocateCluster: func(affinityTags map[string]string) (string, error) {
+			MockAllocateCluster: func(_ context.Context, _ *alloc.Request) (string, error) {
 				return clusterID1, nil
 			},
 		},
 		clientTranslator: &translatormocks.MockClientTranslator{
+			MockGetSnapshot: func(ctx context.Context, c client.Client, req *storms.GetSnapshotRequest,
+			) (*storms.GetSnapshotResponse, error) {
+				return nil, models.ErrNotFound
+			},
 			MockCreateSnapshot: func(ctx context.Context, c client.Client, req *storms.CreateSnapshotRequest) (*storms.CreateSnapshotResponse, error) {
 				return &storms.CreateSnapshotResponse{}, nil
 			},
 		},
 	}
 
-	req := &storms.CreateSnapshotRequest{
-		Uuid:          uuid.NewString(),
-		SrcVolumeUuid: uuid.NewString(),
-	}
 	resp, err := s.CreateSnapshot(context.Background(), req)
 
 	require.NoError(t, err)
@@ -703,7 +836,63 @@ func Test_SyncResource(t *testing.T) {
 	}
 }
 
+func Test_SyncResource_Unavailable(t *testing.T) {
+	tests := []struct {
+		name      string
+		clusterID string
+	}{
+		{name: "targeted cluster unavailable", clusterID: clusterID2},
+		{name: "not found elsewhere"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := resource.NewInMemoryManager()
+			require.NoError(t, resources.Map(&resource.Resource{
+				ID:           resourceID1,
+				ClusterID:    clusterID2,
+				ResourceType: resource.TypeVolume,
+			}))
+			require.NoError(t, resources.SetLabels(resourceID1, map[string]string{"tenant": "a"}))
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockAllIDs: func() []string { return []string{clusterID1, clusterID2} },
+					MockGet: func(clusterID string) (*cluster.Cluster, error) {
+						if clusterID == clusterID2 {
+							return &cluster.Cluster{Config: mockCluster2.Config, State: cluster.StateFailed}, nil
+						}
+
+						return mockCluster1, nil
+					},
+				},
+				resourceManager: resources,
+				clientTranslator: &translatormocks.MockClientTranslator{
+					MockGetVolume: func(_ context.Context, _ client.Client, _ *storms.GetVolumeRequest,
+					) (*storms.GetVolumeResponse, error) {
+						return nil, models.ErrNotFound
+					},
+				},
+			}
+
+			_, err := s.SyncResource(context.Background(), &storms.SyncResourceRequest{
+				ResourceType: storms.ResourceType_RESOURCE_TYPE_VOLUME,
+				Uuid:         resourceID1,
+				ClusterUuid:  tt.clusterID,
+			})
+			require.Equal(t, codes.Unavailable, status.Code(err))
+
+			// The volume may still be on the cluster that could not be checked, so it stays mapped there.
+			clusterID, err := resources.GetResourceCluster(resourceID1)
+			require.NoError(t, err)
+			require.Equal(t, clusterID2, clusterID)
+			require.Equal(t, map[string]string{"tenant": "a"}, resources.GetLabels(resourceID1))
+		})
+	}
+}
+
 func Test_SyncAllResources(t *testing.T) {
+	staleResourceID := uuid.NewString()
+	unmapped := make(chan string, 4)
 	s := &Service{
 		clusterManager: &clustermocks.MockClusterManager{
 			MockGet: func(clusterID string) (*cluster.Cluster, error) {
@@ -722,17 +911,31 @@ func Test_SyncAllResources(t *testing.T) {
 			},
 		},
 		resourceManager: &resourcemocks.MockResourceManager{
-			MockMap:   func(r *resource.Resource) error { return nil },
-			MockUnmap: func(resourceID string) error { return nil },
+			MockMap: func(r *resource.Resource) error { return nil },
+			MockUnmap: func(resourceID string) error {
+				unmapped <- resourceID
+
+				return nil
+			},
 			MockGetResourceCluster: func(resourceID string) (string, error) {
 				switch resourceID {
-				case resourceID1:
+				case resourceID1, staleResourceID:
 					return clusterID1, nil
 				case resourceID2:
 					return clusterID2, nil
 				}
 				return "", fmt.Errorf("error")
 			},
+			MockGetResourcesOfCluster: func(clusterID string) []*resource.Resource {
+				if clusterID != clusterID1 {
+					return nil
+				}
+
+				return []*resource.Resource{
+					{ID: resourceID1, ClusterID: clusterID1, ResourceType: resource.TypeVolume},
+					{ID: staleResourceID, ClusterID: clusterID1, ResourceType: resource.TypeVolume},
+				}
+			},
 			MockGetResourcesOfAllClusters: func() map[string][]*resource.Resource {
 				return map[string][]*resource.Resource{
 					clusterID1: {
@@ -758,4 +961,56 @@ func Test_SyncAllResources(t *testing.T) {
 	resp, err := s.SyncAllResources(context.Background(), &storms.SyncAllResourcesRequest{})
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+
+	// Only the resource no longer reported by its cluster is unmapped.
+	require.Len(t, unmapped, 1)
+	require.Equal(t, staleResourceID, <-unmapped)
+}
+
+func Test_getRoutableCluster(t *testing.T) {
+	noClientCluster := &cluster.Cluster{Config: mockCluster2.Config}
+	failedCluster := &cluster.Cluster{Config: mockCluster2.Config, State: cluster.StateFailed, LastError: "no such host"}
+	removingCluster := &cluster.Cluster{Config: mockCluster1.Config, Client: mockCluster1.Client,
+		State: cluster.StateRemoving}
+	tests := []struct {
+		name        string
+		cluster     *cluster.Cluster
+		state       health.State
+		expectError bool
+	}{
+		{name: "not yet probed", cluster: mockCluster1, state: health.StateUnknown},
+		{name: "healthy", cluster: mockCluster1, state: health.StateHealthy},
+		{name: "degraded is still routed", cluster: mockCluster1, state: health.StateDegraded},
+		{name: "unreachable", cluster: mockCluster1, state: health.StateUnreachable, expectError: true},
+		{name: "no client", cluster: noClientCluster, state: health.StateUnknown, expectError: true},
+		{name: "failed", cluster: failedCluster, state: health.StateUnknown, expectError: true},
+		{name: "removing", cluster: removingCluster, state: health.StateHealthy, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				clusterManager: &clustermocks.MockClusterManager{
+					MockGet: func(_ string) (*cluster.Cluster, error) {
+						return tt.cluster, nil
+					},
+				},
+				health: &healthmocks.MockHealthChecker{
+					MockStatus: func(_ string) health.Status {
+						return health.Status{State: tt.state, LastError: "connection refused"}
+					},
+				},
+			}
+
+			c, err := s.getRoutableCluster(tt.cluster.Config.ClusterID)
+			if tt.expectError {
+				require.ErrorIs(t, err, errClusterUnavailable)
+				require.Equal(t, codes.Unavailable, status.Code(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.cluster, c)
+		})
+	}
 }
@@ -0,0 +1,36 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func Test_NewProvider(t *testing.T) {
+	provider, err := NewProvider(context.Background(), Config{
+		// Nothing listens here; spans are dropped on shutdown rather than failing the test.
+		Endpoint:    "127.0.0.1:1",
+		Insecure:    true,
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, provider, otel.GetTracerProvider())
+
+	// Trace context of callers is picked up from incoming headers.
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	_, span := otel.Tracer("test").Start(ctx, "test")
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	require.True(t, span.SpanContext().IsSampled())
+	span.End()
+
+	shutdownCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_ = provider.Shutdown(shutdownCtx)
+	otel.SetTracerProvider(noop.NewTracerProvider())
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"gitlab.com/crusoeenergy/island/storage/storms/client"
+	"gitlab.com/crusoeenergy/island/storage/storms/client/models"
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	translator "gitlab.com/crusoeenergy/island/storage/storms/storms/internal/service/translator"
 )
 
 type MockClientTranslator struct {
@@ -14,6 +16,10 @@ type MockClientTranslator struct {
 	) (*storms.CreateSnapshotResponse, error)
 	MockCreateVolume func(ctx context.Context, c client.Client, req *storms.CreateVolumeRequest,
 	) (*storms.CreateVolumeResponse, error)
+	MockCloneVolume func(ctx context.Context, c client.Client, req *storms.CloneVolumeRequest,
+	) (*storms.CloneVolumeResponse, error)
+	MockRevertVolumeToSnapshot func(ctx context.Context, c client.Client, req *storms.RevertVolumeToSnapshotRequest,
+	) (*storms.RevertVolumeToSnapshotResponse, error)
 	MockDeleteSnapshot func(ctx context.Context, c client.Client, req *storms.DeleteSnapshotRequest,
 	) (*storms.DeleteSnapshotResponse, error)
 	MockDeleteVolume func(ctx context.Context, c client.Client, req *storms.DeleteVolumeRequest,
@@ -30,6 +36,14 @@ type MockClientTranslator struct {
 	) (*storms.GetVolumesResponse, error)
 	MockResizeVolume func(ctx context.Context, c client.Client, req *storms.ResizeVolumeRequest,
 	) (*storms.ResizeVolumeResponse, error)
+	MockCopySnapshotToVolume   func(ctx context.Context, src, dst client.Client, snapshotUUID, dstUUID string) (string, error)
+	MockCopySnapshotToSnapshot func(ctx context.Context, src, dst client.Client, snapshotUUID, dstUUID string) (string, error)
+	MockGetWorkflow            func(ctx context.Context, c client.Client, workflowID string) (*models.Workflow, error)
+	// Unset label functions behave as a client that does not support labels.
+	MockGetLabels func(ctx context.Context, c client.Client, resourceType storms.ResourceType, uuids []string,
+	) (map[string]map[string]string, error)
+	MockSetLabels func(ctx context.Context, c client.Client, resourceType storms.ResourceType, uuid string,
+		labels map[string]string) error
 }
 
 func (m *MockClientTranslator) AttachVolume(ctx context.Context, c client.Client, req *storms.AttachVolumeRequest,
@@ -47,6 +61,17 @@ func (m *MockClientTranslator) CreateVolume(ctx context.Context, c client.Client
 	return m.MockCreateVolume(ctx, c, req)
 }
 
+func (m *MockClientTranslator) CloneVolume(ctx context.Context, c client.Client, req *storms.CloneVolumeRequest,
+) (*storms.CloneVolumeResponse, error) {
+	return m.MockCloneVolume(ctx, c, req)
+}
+
+func (m *MockClientTranslator) RevertVolumeToSnapshot(ctx context.Context, c client.Client,
+	req *storms.RevertVolumeToSnapshotRequest,
+) (*storms.RevertVolumeToSnapshotResponse, error) {
+	return m.MockRevertVolumeToSnapshot(ctx, c, req)
+}
+
 func (m *MockClientTranslator) DeleteSnapshot(ctx context.Context, c client.Client, req *storms.DeleteSnapshotRequest,
 ) (*storms.DeleteSnapshotResponse, error) {
 	return m.MockDeleteSnapshot(ctx, c, req)
@@ -86,3 +111,40 @@ func (m *MockClientTranslator) ResizeVolume(ctx context.Context, c client.Client
 ) (*storms.ResizeVolumeResponse, error) {
 	return m.MockResizeVolume(ctx, c, req)
 }
+
+func (m *MockClientTranslator) CopySnapshotToVolume(ctx context.Context, src, dst client.Client,
+	snapshotUUID, dstUUID string,
+) (string, error) {
+	return m.MockCopySnapshotToVolume(ctx, src, dst, snapshotUUID, dstUUID)
+}
+
+func (m *MockClientTranslator) CopySnapshotToSnapshot(ctx context.Context, src, dst client.Client,
+	snapshotUUID, dstUUID string,
+) (string, error) {
+	return m.MockCopySnapshotToSnapshot(ctx, src, dst, snapshotUUID, dstUUID)
+}
+
+func (m *MockClientTranslator) GetWorkflow(ctx context.Context, c client.Client, workflowID string,
+) (*models.Workflow, error) {
+	return m.MockGetWorkflow(ctx, c, workflowID)
+}
+
+func (m *MockClientTranslator) GetLabels(ctx context.Context, c client.Client, resourceType storms.ResourceType,
+	uuids []string,
+) (map[string]map[string]string, error) {
+	if m.MockGetLabels == nil {
+		return nil, translator.ErrLabelsUnsupported
+	}
+
+	return m.MockGetLabels(ctx, c, resourceType, uuids)
+}
+
+func (m *MockClientTranslator) SetLabels(ctx context.Context, c client.Client, resourceType storms.ResourceType,
+	uuid string, labels map[string]string,
+) error {
+	if m.MockSetLabels == nil {
+		return translator.ErrLabelsUnsupported
+	}
+
+	return m.MockSetLabels(ctx, c, resourceType, uuid, labels)
+}
@@ -18,6 +18,12 @@ var (
 	errNilNewVolumeSpecs            = errors.New("nil new volume specs")
 	errNilSnapshotSourceVolumeSpecs = errors.New("nil snapshot-source volume specs")
 	errUnsupportVolumeSource        = errors.New("unsupported or missing volume source in request")
+	errCopyUnsupported              = fmt.Errorf("%w: cluster does not support copies to other clusters",
+		models.ErrFailedPrecondition)
+	errUnsupportedResourceType = errors.New("unsupported resource type")
+
+	// ErrLabelsUnsupported is returned for clients that cannot store labels, whose labels are stored by StorMS.
+	ErrLabelsUnsupported = errors.New("cluster does not support labels")
 )
 
 // The ClientTranslator translates federation service requests/responses to and from generic client request/responses.
@@ -57,13 +63,13 @@ func (ct *ClientTranslator) CreateSnapshot(ctx context.Context, c client.Client,
 		SourceVolumeUUID: req.SrcVolumeUuid,
 	}
 
-	_, err := c.CreateSnapshot(ctx, translatedReq)
+	resp, err := c.CreateSnapshot(ctx, translatedReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snapshot: %w", err)
 	}
 
 	return &storms.CreateSnapshotResponse{
-		// Empty; ACK
+		Snapshot: translateSnapshot(resp.Snapshot),
 	}, nil
 }
 
@@ -105,13 +111,48 @@ func (ct *ClientTranslator) CreateVolume(ctx context.Context, c client.Client, r
 		return nil, errUnsupportVolumeSource
 	}
 
-	_, err := c.CreateVolume(ctx, translatedReq)
+	resp, err := c.CreateVolume(ctx, translatedReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create volume: %w", err)
 	}
 
 	return &storms.CreateVolumeResponse{
-		// Empty; ACK
+		Volume: translateVolume(resp.Volume),
+	}, nil
+}
+
+func (ct *ClientTranslator) CloneVolume(ctx context.Context, c client.Client, req *storms.CloneVolumeRequest,
+) (*storms.CloneVolumeResponse, error) {
+	translatedReq := &models.CloneVolumeRequest{
+		UUID:             req.GetUuid(),
+		SourceVolumeUUID: req.GetSrcVolumeUuid(),
+	}
+
+	resp, err := c.CloneVolume(ctx, translatedReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone volume: %w", err)
+	}
+
+	return &storms.CloneVolumeResponse{
+		Volume: translateVolume(resp.Volume),
+	}, nil
+}
+
+func (ct *ClientTranslator) RevertVolumeToSnapshot(ctx context.Context, c client.Client,
+	req *storms.RevertVolumeToSnapshotRequest,
+) (*storms.RevertVolumeToSnapshotResponse, error) {
+	translatedReq := &models.RevertVolumeToSnapshotRequest{
+		UUID:         req.GetUuid(),
+		SnapshotUUID: req.GetSnapshotUuid(),
+	}
+
+	resp, err := c.RevertVolumeToSnapshot(ctx, translatedReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert volume to snapshot: %w", err)
+	}
+
+	return &storms.RevertVolumeToSnapshotResponse{
+		Volume: translateVolume(resp.Volume),
 	}, nil
 }
 
@@ -174,32 +215,12 @@ func (ct *ClientTranslator) GetSnapshot(ctx context.Context, c client.Client, re
 	if err != nil {
 		return nil, fmt.Errorf("failed to get snapshot: %w", err)
 	}
-	snapshot := s.Snapshot
-	if snapshot == nil {
-		return &storms.GetSnapshotResponse{Snapshot: nil}, nil
-	}
-
-	sectorSizeEnum := translateUint32ToSectorSizeEnum(snapshot.SectorSize)
-
-	var createdAt *timestamppb.Timestamp
-	if !snapshot.CreatedAt.IsZero() {
-		createdAt = timestamppb.New(snapshot.CreatedAt)
-	}
 
 	return &storms.GetSnapshotResponse{
-		Snapshot: &storms.Snapshot{
-			Uuid:             snapshot.UUID,
-			VendorSnapshotId: snapshot.VendorSnapshotID,
-			Size:             snapshot.Size,
-			SectorSize:       sectorSizeEnum,
-			IsAvailable:      snapshot.IsAvailable,
-			SourceVolumeUuid: snapshot.SourceVolumeUUID,
-			CreatedAt:        createdAt,
-		},
+		Snapshot: translateSnapshot(s.Snapshot),
 	}, nil
 }
 
-//nolint:dupl // Similar mapping logic to GetVolumes but for different types
 func (ct *ClientTranslator) GetSnapshots(ctx context.Context, c client.Client, _ *storms.GetSnapshotsRequest,
 ) (*storms.GetSnapshotsResponse, error) {
 	translatedReq := &models.GetSnapshotsRequest{}
@@ -208,26 +229,9 @@ func (ct *ClientTranslator) GetSnapshots(ctx context.Context, c client.Client, _
 		return nil, fmt.Errorf("failed to get snapshots: %w", err)
 	}
 
-	ss := lo.Map[*models.Snapshot, *storms.Snapshot](
-		snapshots.Snapshots,
-		func(s *models.Snapshot, _ int) *storms.Snapshot {
-			sectorSizeEnum := translateUint32ToSectorSizeEnum(s.SectorSize)
-
-			var createdAt *timestamppb.Timestamp
-			if !s.CreatedAt.IsZero() {
-				createdAt = timestamppb.New(s.CreatedAt)
-			}
-
-			return &storms.Snapshot{
-				Uuid:             s.UUID,
-				VendorSnapshotId: s.VendorSnapshotID,
-				Size:             s.Size,
-				SectorSize:       sectorSizeEnum,
-				IsAvailable:      s.IsAvailable,
-				SourceVolumeUuid: s.SourceVolumeUUID,
-				CreatedAt:        createdAt,
-			}
-		})
+	ss := lo.Map[*models.Snapshot, *storms.Snapshot](snapshots.Snapshots, func(s *models.Snapshot, _ int) *storms.Snapshot {
+		return translateSnapshot(s)
+	})
 
 	return &storms.GetSnapshotsResponse{
 		Snapshots: ss,
@@ -244,60 +248,22 @@ func (ct *ClientTranslator) GetVolume(ctx context.Context, c client.Client, req
 	if err != nil {
 		return nil, fmt.Errorf("failed to get volume: %w", err)
 	}
-	vol := clientResp.Volume
-	if vol == nil {
-		return &storms.GetVolumeResponse{Volume: nil}, nil
-	}
-
-	sectorSizeEnum := translateUint32ToSectorSizeEnum(vol.SectorSize)
-
-	var createdAt *timestamppb.Timestamp
-	if !vol.CreatedAt.IsZero() {
-		createdAt = timestamppb.New(vol.CreatedAt)
-	}
 
-	resp := &storms.GetVolumeResponse{
-		Volume: &storms.Volume{
-			Uuid:               vol.UUID,
-			VendorVolumeId:     vol.VendorVolumeID,
-			Size:               vol.Size,
-			SectorSize:         sectorSizeEnum,
-			Acl:                vol.ACL,
-			IsAvailable:        vol.IsAvailable,
-			SourceSnapshotUuid: vol.SourceSnapshotUUID,
-			CreatedAt:          createdAt,
-		},
-	}
-
-	return resp, nil
+	return &storms.GetVolumeResponse{
+		Volume: translateVolume(clientResp.Volume),
+	}, nil
 }
 
-//nolint:dupl // Similar mapping logic to GetSnapshots but for different types
 func (ct *ClientTranslator) GetVolumes(ctx context.Context, c client.Client, _ *storms.GetVolumesRequest,
 ) (*storms.GetVolumesResponse, error) {
 	translatedReq := &models.GetVolumesRequest{}
 	volumes, err := c.GetVolumes(ctx, translatedReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get snapshots: %w", err)
+		return nil, fmt.Errorf("failed to get volumes: %w", err)
 	}
 
 	vs := lo.Map[*models.Volume, *storms.Volume](volumes.Volumes, func(v *models.Volume, _ int) *storms.Volume {
-		sectorSizeEnum := translateUint32ToSectorSizeEnum(v.SectorSize)
-
-		var createdAt *timestamppb.Timestamp
-		if !v.CreatedAt.IsZero() {
-			createdAt = timestamppb.New(v.CreatedAt)
-		}
-
-		return &storms.Volume{
-			Uuid:               v.UUID,
-			VendorVolumeId:     v.VendorVolumeID,
-			Size:               v.Size,
-			SectorSize:         sectorSizeEnum,
-			IsAvailable:        v.IsAvailable,
-			SourceSnapshotUuid: v.SourceSnapshotUUID,
-			CreatedAt:          createdAt,
-		}
+		return translateVolume(v)
 	})
 
 	return &storms.GetVolumesResponse{
@@ -322,8 +288,183 @@ func (ct *ClientTranslator) ResizeVolume(ctx context.Context, c client.Client, r
 	}, nil
 }
 
+// CopySnapshotToVolume starts copying a snapshot on src to a new volume on dst, and returns the ID of the workflow
+// performing the copy.
+func (ct *ClientTranslator) CopySnapshotToVolume(ctx context.Context, src, dst client.Client,
+	snapshotUUID, dstUUID string,
+) (string, error) {
+	return copySnapshot(ctx, src, dst, snapshotUUID, dstUUID, client.SnapshotCopier.CopySnapshotToVolume)
+}
+
+// CopySnapshotToSnapshot starts copying a snapshot on src to a new snapshot on dst, and returns the ID of the workflow
+// performing the copy.
+func (ct *ClientTranslator) CopySnapshotToSnapshot(ctx context.Context, src, dst client.Client,
+	snapshotUUID, dstUUID string,
+) (string, error) {
+	return copySnapshot(ctx, src, dst, snapshotUUID, dstUUID, client.SnapshotCopier.CopySnapshotToSnapshot)
+}
+
+// GetWorkflow returns the state of a workflow started on c.
+func (ct *ClientTranslator) GetWorkflow(ctx context.Context, c client.Client, workflowID string,
+) (*models.Workflow, error) {
+	copier, ok := c.(client.SnapshotCopier)
+	if !ok {
+		return nil, errCopyUnsupported
+	}
+
+	resp, err := copier.GetWorkflow(ctx, &models.GetWorkflowRequest{ID: workflowID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	return resp.Workflow, nil
+}
+
+// GetLabels returns labels of volumes or snapshots on c by UUID, for the given UUIDs or every resource of the type if
+// none are given.
+func (ct *ClientTranslator) GetLabels(ctx context.Context, c client.Client, resourceType storms.ResourceType,
+	uuids []string,
+) (map[string]map[string]string, error) {
+	labeler, ok := c.(client.Labeler)
+	if !ok {
+		return nil, ErrLabelsUnsupported
+	}
+	translatedType, err := translateResourceType(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := labeler.GetLabels(ctx, &models.GetLabelsRequest{
+		ResourceType: translatedType,
+		UUIDs:        uuids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	return resp.Labels, nil
+}
+
+// SetLabels replaces the labels of a volume or snapshot on c.
+func (ct *ClientTranslator) SetLabels(ctx context.Context, c client.Client, resourceType storms.ResourceType,
+	uuid string, labels map[string]string,
+) error {
+	labeler, ok := c.(client.Labeler)
+	if !ok {
+		return ErrLabelsUnsupported
+	}
+	translatedType, err := translateResourceType(resourceType)
+	if err != nil {
+		return err
+	}
+
+	_, err = labeler.SetLabels(ctx, &models.SetLabelsRequest{
+		ResourceType: translatedType,
+		UUID:         uuid,
+		Labels:       labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set labels: %w", err)
+	}
+
+	return nil
+}
+
 // Begin -- Helper
 
+func copySnapshot(
+	ctx context.Context,
+	src, dst client.Client,
+	snapshotUUID, dstUUID string,
+	start func(client.SnapshotCopier, context.Context, *models.CopySnapshotRequest) (*models.CopySnapshotResponse, error),
+) (string, error) {
+	srcCopier, ok := src.(client.SnapshotCopier)
+	if !ok {
+		return "", errCopyUnsupported
+	}
+	dstCopier, ok := dst.(client.SnapshotCopier)
+	if !ok {
+		return "", errCopyUnsupported
+	}
+
+	target, err := dstCopier.CopyTarget(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get copy target: %w", err)
+	}
+
+	resp, err := start(srcCopier, ctx, &models.CopySnapshotRequest{
+		SnapshotUUID:         snapshotUUID,
+		DestinationUUID:      dstUUID,
+		DestinationClusterID: target.ClusterID,
+		DestinationProject:   target.Project,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to copy snapshot: %w", err)
+	}
+
+	return resp.Workflow.ID, nil
+}
+
+// Returns nil for a nil volume.
+func translateVolume(v *models.Volume) *storms.Volume {
+	if v == nil {
+		return nil
+	}
+
+	var createdAt *timestamppb.Timestamp
+	if !v.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(v.CreatedAt)
+	}
+
+	return &storms.Volume{
+		Uuid:               v.UUID,
+		VendorVolumeId:     v.VendorVolumeID,
+		Size:               v.Size,
+		SectorSize:         translateUint32ToSectorSizeEnum(v.SectorSize),
+		Acl:                v.ACL,
+		IsAvailable:        v.IsAvailable,
+		IsFailed:           v.IsFailed,
+		SourceSnapshotUuid: v.SourceSnapshotUUID,
+		CreatedAt:          createdAt,
+	}
+}
+
+// Returns nil for a nil snapshot.
+func translateSnapshot(s *models.Snapshot) *storms.Snapshot {
+	if s == nil {
+		return nil
+	}
+
+	var createdAt *timestamppb.Timestamp
+	if !s.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(s.CreatedAt)
+	}
+
+	return &storms.Snapshot{
+		Uuid:             s.UUID,
+		VendorSnapshotId: s.VendorSnapshotID,
+		Size:             s.Size,
+		SectorSize:       translateUint32ToSectorSizeEnum(s.SectorSize),
+		IsAvailable:      s.IsAvailable,
+		IsFailed:         s.IsFailed,
+		SourceVolumeUuid: s.SourceVolumeUUID,
+		CreatedAt:        createdAt,
+	}
+}
+
+func translateResourceType(t storms.ResourceType) (models.ResourceType, error) {
+	switch t {
+	case storms.ResourceType_RESOURCE_TYPE_VOLUME:
+		return models.ResourceTypeVolume, nil
+	case storms.ResourceType_RESOURCE_TYPE_SNAPSHOT:
+		return models.ResourceTypeSnapshot, nil
+	case storms.ResourceType_RESOURCE_TYPE_UNSPECIFIED:
+		return "", errUnsupportedResourceType
+	default:
+		return "", errUnsupportedResourceType
+	}
+}
+
 func translateUint32ToSectorSizeEnum(u uint32) storms.SectorSizeEnum {
 	switch u {
 	case 4096:
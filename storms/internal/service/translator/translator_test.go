@@ -23,14 +23,17 @@ type mockClient struct {
 	mockGetVolume      func(ctx context.Context, req *models.GetVolumeRequest) (*models.GetVolumeResponse, error)
 	mockGetVolumes     func(ctx context.Context, req *models.GetVolumesRequest) (*models.GetVolumesResponse, error)
 	mockCreateVolume   func(ctx context.Context, req *models.CreateVolumeRequest) (*models.CreateVolumeResponse, error)
+	mockCloneVolume    func(ctx context.Context, req *models.CloneVolumeRequest) (*models.CloneVolumeResponse, error)
 	mockResizeVolume   func(ctx context.Context, req *models.ResizeVolumeRequest) (*models.ResizeVolumeResponse, error)
 	mockDeleteVolume   func(ctx context.Context, req *models.DeleteVolumeRequest) (*models.DeleteVolumeResponse, error)
+	mockRevertVolume   func(ctx context.Context, req *models.RevertVolumeToSnapshotRequest) (*models.RevertVolumeToSnapshotResponse, error)
 	mockAttachVolume   func(ctx context.Context, req *models.AttachVolumeRequest) (*models.AttachVolumeResponse, error)
 	mockDetachVolume   func(ctx context.Context, req *models.DetachVolumeRequest) (*models.DetachVolumeResponse, error)
 	mockGetSnapshot    func(ctx context.Context, req *models.GetSnapshotRequest) (*models.GetSnapshotResponse, error)
 	mockGetSnapshots   func(ctx context.Context, req *models.GetSnapshotsRequest) (*models.GetSnapshotsResponse, error)
 	mockCreateSnapshot func(ctx context.Context, req *models.CreateSnapshotRequest) (*models.CreateSnapshotResponse, error)
 	mockDeleteSnapshot func(ctx context.Context, req *models.DeleteSnapshotRequest) (*models.DeleteSnapshotResponse, error)
+	mockGetCapacity    func(ctx context.Context, req *models.GetCapacityRequest) (*models.GetCapacityResponse, error)
 }
 
 func (m *mockClient) GetVolume(ctx context.Context, req *models.GetVolumeRequest) (*models.GetVolumeResponse, error) {
@@ -45,10 +48,18 @@ func (m *mockClient) CreateVolume(ctx context.Context, req *models.CreateVolumeR
 	return m.mockCreateVolume(ctx, req)
 }
 
+func (m *mockClient) CloneVolume(ctx context.Context, req *models.CloneVolumeRequest) (*models.CloneVolumeResponse, error) {
+	return m.mockCloneVolume(ctx, req)
+}
+
 func (m *mockClient) ResizeVolume(ctx context.Context, req *models.ResizeVolumeRequest) (*models.ResizeVolumeResponse, error) {
 	return m.mockResizeVolume(ctx, req)
 }
 
+func (m *mockClient) RevertVolumeToSnapshot(ctx context.Context, req *models.RevertVolumeToSnapshotRequest) (*models.RevertVolumeToSnapshotResponse, error) {
+	return m.mockRevertVolume(ctx, req)
+}
+
 func (m *mockClient) DeleteVolume(ctx context.Context, req *models.DeleteVolumeRequest) (*models.DeleteVolumeResponse, error) {
 	return m.mockDeleteVolume(ctx, req)
 }
@@ -77,6 +88,10 @@ func (m *mockClient) DeleteSnapshot(ctx context.Context, req *models.DeleteSnaps
 	return m.mockDeleteSnapshot(ctx, req)
 }
 
+func (m *mockClient) GetCapacity(ctx context.Context, req *models.GetCapacityRequest) (*models.GetCapacityResponse, error) {
+	return m.mockGetCapacity(ctx, req)
+}
+
 func Test_AttachVolume(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -153,12 +168,19 @@ func Test_CreateSnapshot(t *testing.T) {
 	ct := NewClientTranslator()
 	mc := &mockClient{
 		mockCreateSnapshot: func(ctx context.Context, req *models.CreateSnapshotRequest) (*models.CreateSnapshotResponse, error) {
-			return &models.CreateSnapshotResponse{}, nil
+			return &models.CreateSnapshotResponse{
+				Snapshot: &models.Snapshot{
+					UUID:             req.UUID,
+					VendorSnapshotID: "vendor-snapshot-id",
+					SectorSize:       512,
+					SourceVolumeUUID: req.SourceVolumeUUID,
+				},
+			}, nil
 		},
 	}
 
 	for _, tt := range tests {
-		_, err := ct.CreateSnapshot(context.Background(), mc, tt.input)
+		res, err := ct.CreateSnapshot(context.Background(), mc, tt.input)
 		if tt.expectErr {
 			require.Error(t, err)
 
@@ -166,6 +188,10 @@ func Test_CreateSnapshot(t *testing.T) {
 		}
 
 		require.NoError(t, err)
+		require.Equal(t, tt.input.Uuid, res.GetSnapshot().GetUuid())
+		require.Equal(t, "vendor-snapshot-id", res.GetSnapshot().GetVendorSnapshotId())
+		require.Equal(t, storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512, res.GetSnapshot().GetSectorSize())
+		require.Equal(t, tt.input.SrcVolumeUuid, res.GetSnapshot().GetSourceVolumeUuid())
 	}
 }
 
@@ -208,7 +234,14 @@ func Test_CreateVolume(t *testing.T) {
 	ct := NewClientTranslator()
 	mc := &mockClient{
 		mockCreateVolume: func(ctx context.Context, req *models.CreateVolumeRequest) (*models.CreateVolumeResponse, error) {
-			return &models.CreateVolumeResponse{}, nil
+			return &models.CreateVolumeResponse{
+				Volume: &models.Volume{
+					UUID:           req.UUID,
+					VendorVolumeID: "vendor-volume-id",
+					Size:           defaultOSDiskSizeBytes,
+					SectorSize:     512,
+				},
+			}, nil
 		},
 	}
 
@@ -221,6 +254,72 @@ func Test_CreateVolume(t *testing.T) {
 		}
 		require.NoError(t, err)
 		require.NotNil(t, res)
+		require.Equal(t, tt.input.Uuid, res.GetVolume().GetUuid())
+		require.Equal(t, "vendor-volume-id", res.GetVolume().GetVendorVolumeId())
+		require.Equal(t, uint64(defaultOSDiskSizeBytes), res.GetVolume().GetSize())
+		require.Equal(t, storms.SectorSizeEnum_SECTOR_SIZE_ENUM_512, res.GetVolume().GetSectorSize())
+	}
+}
+
+func Test_CloneVolume(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *storms.CloneVolumeRequest
+		cloneErr  error
+		expectErr bool
+	}{
+		{
+			name: "valid request",
+			input: &storms.CloneVolumeRequest{
+				Uuid:          uuid.NewString(),
+				SrcVolumeUuid: uuid.NewString(),
+			},
+		},
+		{
+			name: "client error",
+			input: &storms.CloneVolumeRequest{
+				Uuid:          uuid.NewString(),
+				SrcVolumeUuid: uuid.NewString(),
+			},
+			cloneErr:  models.ErrNotFound,
+			expectErr: true,
+		},
+	}
+
+	ct := NewClientTranslator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &mockClient{
+				mockCloneVolume: func(_ context.Context, req *models.CloneVolumeRequest,
+				) (*models.CloneVolumeResponse, error) {
+					require.Equal(t, tt.input.Uuid, req.UUID)
+					require.Equal(t, tt.input.SrcVolumeUuid, req.SourceVolumeUUID)
+					if tt.cloneErr != nil {
+						return nil, tt.cloneErr
+					}
+
+					return &models.CloneVolumeResponse{
+						Volume: &models.Volume{
+							UUID:       req.UUID,
+							Size:       defaultOSDiskSizeBytes,
+							SectorSize: sectorSize4096,
+						},
+					}, nil
+				},
+			}
+
+			res, err := ct.CloneVolume(context.Background(), mc, tt.input)
+			if tt.expectErr {
+				require.ErrorIs(t, err, tt.cloneErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.input.Uuid, res.GetVolume().GetUuid())
+			require.Equal(t, uint64(defaultOSDiskSizeBytes), res.GetVolume().GetSize())
+			require.Equal(t, storms.SectorSizeEnum_SECTOR_SIZE_ENUM_4096, res.GetVolume().GetSectorSize())
+		})
 	}
 }
 
@@ -691,6 +790,63 @@ func Test_ResizeVolume(t *testing.T) {
 	}
 }
 
+func Test_RevertVolumeToSnapshot(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *storms.RevertVolumeToSnapshotRequest
+		revertErr error
+		expectErr bool
+	}{
+		{
+			name: "valid request",
+			input: &storms.RevertVolumeToSnapshotRequest{
+				Uuid:         uuid.NewString(),
+				SnapshotUuid: uuid.NewString(),
+			},
+		},
+		{
+			name: "volume attached",
+			input: &storms.RevertVolumeToSnapshotRequest{
+				Uuid:         uuid.NewString(),
+				SnapshotUuid: uuid.NewString(),
+			},
+			revertErr: models.ErrFailedPrecondition,
+			expectErr: true,
+		},
+	}
+
+	ct := NewClientTranslator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &mockClient{
+				mockRevertVolume: func(_ context.Context, req *models.RevertVolumeToSnapshotRequest,
+				) (*models.RevertVolumeToSnapshotResponse, error) {
+					require.Equal(t, tt.input.Uuid, req.UUID)
+					require.Equal(t, tt.input.SnapshotUuid, req.SnapshotUUID)
+					if tt.revertErr != nil {
+						return nil, tt.revertErr
+					}
+
+					return &models.RevertVolumeToSnapshotResponse{
+						Volume: &models.Volume{UUID: req.UUID, Size: defaultOSDiskSizeBytes, SectorSize: sectorSize512},
+					}, nil
+				},
+			}
+
+			res, err := ct.RevertVolumeToSnapshot(context.Background(), mc, tt.input)
+			if tt.expectErr {
+				require.ErrorIs(t, err, tt.revertErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.input.Uuid, res.GetVolume().GetUuid())
+			require.Equal(t, uint64(defaultOSDiskSizeBytes), res.GetVolume().GetSize())
+		})
+	}
+}
+
 func Test_translateUint32ToSectorSizeEnum(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -773,3 +929,112 @@ func Test_translateSectorSizeEnumToUint32(t *testing.T) {
 		})
 	}
 }
+
+type mockCopierClient struct {
+	*mockClient
+	copyReq  *models.CopySnapshotRequest
+	workflow *models.Workflow
+}
+
+func (m *mockCopierClient) CopyTarget(_ context.Context) (*models.CopyTarget, error) {
+	return &models.CopyTarget{ClusterID: "dst-cluster", Project: "dst-project"}, nil
+}
+
+func (m *mockCopierClient) CopySnapshotToVolume(_ context.Context, req *models.CopySnapshotRequest,
+) (*models.CopySnapshotResponse, error) {
+	m.copyReq = req
+
+	return &models.CopySnapshotResponse{Workflow: &models.Workflow{ID: "volume-workflow"}}, nil
+}
+
+func (m *mockCopierClient) CopySnapshotToSnapshot(_ context.Context, req *models.CopySnapshotRequest,
+) (*models.CopySnapshotResponse, error) {
+	m.copyReq = req
+
+	return &models.CopySnapshotResponse{Workflow: &models.Workflow{ID: "snapshot-workflow"}}, nil
+}
+
+func (m *mockCopierClient) GetWorkflow(_ context.Context, _ *models.GetWorkflowRequest,
+) (*models.GetWorkflowResponse, error) {
+	return &models.GetWorkflowResponse{Workflow: m.workflow}, nil
+}
+
+func Test_CopySnapshot(t *testing.T) {
+	ct := NewClientTranslator()
+	src := &mockCopierClient{mockClient: &mockClient{}}
+	dst := &mockCopierClient{mockClient: &mockClient{}}
+	snapshotID := uuid.NewString()
+	dstID := uuid.NewString()
+	expectReq := &models.CopySnapshotRequest{
+		SnapshotUUID:         snapshotID,
+		DestinationUUID:      dstID,
+		DestinationClusterID: "dst-cluster",
+		DestinationProject:   "dst-project",
+	}
+
+	workflowID, err := ct.CopySnapshotToVolume(context.Background(), src, dst, snapshotID, dstID)
+	require.NoError(t, err)
+	require.Equal(t, "volume-workflow", workflowID)
+	require.Equal(t, expectReq, src.copyReq)
+
+	workflowID, err = ct.CopySnapshotToSnapshot(context.Background(), src, dst, snapshotID, dstID)
+	require.NoError(t, err)
+	require.Equal(t, "snapshot-workflow", workflowID)
+	require.Equal(t, expectReq, src.copyReq)
+
+	src.workflow = &models.Workflow{ID: "volume-workflow", State: models.WorkflowStateSucceeded}
+	workflow, err := ct.GetWorkflow(context.Background(), src, "volume-workflow")
+	require.NoError(t, err)
+	require.Equal(t, src.workflow, workflow)
+
+	_, err = ct.CopySnapshotToVolume(context.Background(), src, &mockClient{}, snapshotID, dstID)
+	require.ErrorIs(t, err, models.ErrFailedPrecondition)
+	_, err = ct.GetWorkflow(context.Background(), &mockClient{}, "volume-workflow")
+	require.ErrorIs(t, err, models.ErrFailedPrecondition)
+}
+
+type mockLabelerClient struct {
+	*mockClient
+	getReq *models.GetLabelsRequest
+	setReq *models.SetLabelsRequest
+}
+
+func (m *mockLabelerClient) GetLabels(_ context.Context, req *models.GetLabelsRequest,
+) (*models.GetLabelsResponse, error) {
+	m.getReq = req
+
+	return &models.GetLabelsResponse{Labels: map[string]map[string]string{"id": {"tenant": "a"}}}, nil
+}
+
+func (m *mockLabelerClient) SetLabels(_ context.Context, req *models.SetLabelsRequest,
+) (*models.SetLabelsResponse, error) {
+	m.setReq = req
+
+	return &models.SetLabelsResponse{}, nil
+}
+
+func Test_Labels(t *testing.T) {
+	ct := NewClientTranslator()
+	c := &mockLabelerClient{mockClient: &mockClient{}}
+
+	labels, err := ct.GetLabels(context.Background(), c, storms.ResourceType_RESOURCE_TYPE_SNAPSHOT, []string{"id"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]string{"id": {"tenant": "a"}}, labels)
+	require.Equal(t, &models.GetLabelsRequest{ResourceType: models.ResourceTypeSnapshot, UUIDs: []string{"id"}}, c.getReq)
+
+	err = ct.SetLabels(context.Background(), c, storms.ResourceType_RESOURCE_TYPE_VOLUME, "id",
+		map[string]string{"tenant": "b"})
+	require.NoError(t, err)
+	require.Equal(t, &models.SetLabelsRequest{
+		ResourceType: models.ResourceTypeVolume,
+		UUID:         "id",
+		Labels:       map[string]string{"tenant": "b"},
+	}, c.setReq)
+
+	_, err = ct.GetLabels(context.Background(), c, storms.ResourceType_RESOURCE_TYPE_UNSPECIFIED, nil)
+	require.Error(t, err)
+	_, err = ct.GetLabels(context.Background(), &mockClient{}, storms.ResourceType_RESOURCE_TYPE_VOLUME, nil)
+	require.ErrorIs(t, err, ErrLabelsUnsupported)
+	err = ct.SetLabels(context.Background(), &mockClient{}, storms.ResourceType_RESOURCE_TYPE_VOLUME, "id", nil)
+	require.ErrorIs(t, err, ErrLabelsUnsupported)
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Implemented by requests with protoc-gen-validate rules.
+type validatable interface {
+	ValidateAll() error
+}
+
+// Implemented by the single-field errors generated by protoc-gen-validate.
+type fieldValidationError interface {
+	error
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// Implemented by the aggregate errors returned by ValidateAll.
+type multiValidationError interface {
+	error
+	AllErrors() []error
+}
+
+// Rejects requests that violate the validation rules declared in the proto definitions before they reach a handler.
+func validationUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	v, ok := req.(validatable)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	if err := v.ValidateAll(); err != nil {
+		return nil, validationStatus(err)
+	}
+
+	return handler(ctx, req)
+}
+
+// Converts a protoc-gen-validate error into an InvalidArgument status with a BadRequest detail listing every
+// violated field.
+func validationStatus(err error) error {
+	violations := fieldViolations("", err)
+
+	descriptions := make([]string, 0, len(violations))
+	for _, v := range violations {
+		descriptions = append(descriptions, v.GetField()+": "+v.GetDescription())
+	}
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(descriptions, "; "))
+
+	withDetails, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// Flattens nested validation errors into violations keyed by the proto path of the offending field,
+// e.g. "from_new.size".
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiValidationError
+	if errors.As(err, &multi) {
+		out := []*errdetails.BadRequest_FieldViolation{}
+		for _, e := range multi.AllErrors() {
+			out = append(out, fieldViolations(prefix, e)...)
+		}
+
+		return out
+	}
+
+	var fieldErr fieldValidationError
+	if !errors.As(err, &fieldErr) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+
+	field := protoFieldName(fieldErr.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+
+	// Errors on embedded messages carry the nested message's own validation errors as the cause.
+	var nestedMulti multiValidationError
+	var nestedField fieldValidationError
+	if cause := fieldErr.Cause(); cause != nil && (errors.As(cause, &nestedMulti) || errors.As(cause, &nestedField)) {
+		return fieldViolations(field, cause)
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: fieldErr.Reason()}}
+}
+
+// Converts a Go field name as reported by protoc-gen-validate (e.g. "SourceSnapshotUuid" or "Volumes[2]") to the
+// proto field name ("source_snapshot_uuid", "volumes[2]").
+func protoFieldName(goName string) string {
+	b := strings.Builder{}
+	for i, r := range goName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+)
+
+func Test_validationUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name             string
+		req              interface{}
+		expectViolations map[string]string // field -> description
+	}{
+		{
+			name: "valid",
+			req:  &storms.GetVolumeRequest{Uuid: uuid.NewString()},
+		},
+		{
+			name: "request without rules",
+			req:  &admin.ShowClustersRequest{},
+		},
+		{
+			name:             "invalid uuid",
+			req:              &storms.GetVolumeRequest{Uuid: "not-a-uuid"},
+			expectViolations: map[string]string{"uuid": "value must be a valid UUID"},
+		},
+		{
+			name: "nested violations are all reported",
+			req: &storms.CreateVolumeRequest{
+				Uuid: "not-a-uuid",
+				Source: &storms.CreateVolumeRequest_FromNew{
+					FromNew: &storms.NewVolumeSpec{Size: 0, SectorSize: storms.SectorSizeEnum(42)},
+				},
+			},
+			expectViolations: map[string]string{
+				"uuid":                 "value must be a valid UUID",
+				"from_new.size":        "value must be greater than 0",
+				"from_new.sector_size": "value must be one of the defined enum values",
+			},
+		},
+		{
+			name: "filter",
+			req: &storms.GetVolumesRequest{
+				Filter: &storms.VolumeFilter{ClusterId: "cluster-1"},
+			},
+			expectViolations: map[string]string{"filter.cluster_id": "value must be a valid UUID"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				called = true
+
+				return "ok", nil
+			}
+
+			resp, err := validationUnaryInterceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{}, handler)
+			if tt.expectViolations == nil {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Equal(t, "ok", resp)
+
+				return
+			}
+
+			require.False(t, called)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+
+			actual := map[string]string{}
+			for _, v := range badRequest.GetFieldViolations() {
+				actual[v.GetField()] = v.GetDescription()
+			}
+			require.Equal(t, tt.expectViolations, actual)
+		})
+	}
+}
+
+func Test_protoFieldName(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{input: "Uuid", expect: "uuid"},
+		{input: "SourceSnapshotUuid", expect: "source_snapshot_uuid"},
+		{input: "Volumes[2]", expect: "volumes[2]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			require.Equal(t, tt.expect, protoFieldName(tt.input))
+		})
+	}
+}
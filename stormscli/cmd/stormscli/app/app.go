@@ -14,6 +14,7 @@ func NewAppCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	appCmd.AddCommand(
 		NewReloadCmd(cmdFactory),
 		NewShowCmd(cmdFactory),
+		NewAuditCmd(cmdFactory),
 	)
 
 	return appCmd
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+const (
+	resourceIDFlag = "resource-id"
+	sinceFlag      = "since"
+	untilFlag      = "until"
+	limitFlag      = "limit"
+)
+
+func NewAuditCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Query the audit log of mutating requests.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.AdminClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create admin client: %w", err)
+			}
+			defer conn.Close()
+
+			err = auditCmdFn(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(resourceIDFlag, "", "only show requests on this resource", false).
+		String(sinceFlag, "", "only show requests received at or after this time (RFC3339)", false).
+		String(untilFlag, "", "only show requests received before this time (RFC3339)", false).
+		Uint(limitFlag, "", "maximum number of entries to show, keeping the most recent; 0 uses the server default", false)
+
+	return cmd
+}
+
+func auditCmdFn(cmd *cobra.Command, client admin.AdminServiceClient) error {
+	req := &admin.QueryAuditLogRequest{
+		ResourceUuid: utils.MustGetStringFlag(cmd, resourceIDFlag),
+		Limit:        uint32(utils.MustGetUintFlag(cmd, limitFlag)), //nolint:gosec // limits are small
+	}
+	var err error
+	if req.StartTime, err = parseTimeFlag(cmd, sinceFlag); err != nil {
+		return err
+	}
+	if req.EndTime, err = parseTimeFlag(cmd, untilFlag); err != nil {
+		return err
+	}
+
+	resp, err := client.QueryAuditLog(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to query audit log: %w", err)
+	}
+
+	if err := utils.RenderAuditEntries(resp.GetEntries()); err != nil {
+		return fmt.Errorf("failed to render audit entries: %w", err)
+	}
+
+	return nil
+}
+
+// Parses an RFC3339 time flag. An empty flag is nil.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*timestamppb.Timestamp, error) {
+	s := utils.MustGetStringFlag(cmd, flag)
+	if s == "" {
+		return nil, nil //nolint:nilnil // unset flags do not filter
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse --%s: %w", flag, err)
+	}
+
+	return timestamppb.New(t), nil
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
+	testutil "gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/testutil"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_NewAuditCmd(t *testing.T) {
+	expectedTime := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		args      []string
+		expectReq *admin.QueryAuditLogRequest
+		expectErr bool
+	}{
+		{
+			name:      "valid; no filters",
+			args:      []string{},
+			expectReq: &admin.QueryAuditLogRequest{},
+			expectErr: false,
+		},
+		{
+			name: "valid; filters",
+			args: []string{
+				"--resource-id",
+				"4141c8b6-9a6d-47ff-9bba-e047d131c9a6",
+				"--since",
+				"2026-09-01T12:00:00Z",
+				"--until",
+				"2026-09-01T13:00:00Z",
+				"--limit",
+				"10",
+			},
+			expectReq: &admin.QueryAuditLogRequest{
+				ResourceUuid: "4141c8b6-9a6d-47ff-9bba-e047d131c9a6",
+				StartTime:    timestamppb.New(expectedTime),
+				EndTime:      timestamppb.New(expectedTime.Add(time.Hour)),
+				Limit:        10,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid; time",
+			args: []string{
+				"--since",
+				"yesterday",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []*admin.QueryAuditLogRequest
+			mockCmdFactory := &utils.CmdFactory{
+				AdminClientProvider: func(context.Context) (admin.AdminServiceClient, io.Closer, error) {
+					return &testutil.MockAdminClient{
+						MockQueryAuditLog: func(ctx context.Context, in *admin.QueryAuditLogRequest, opts ...grpc.CallOption) (*admin.QueryAuditLogResponse, error) {
+							requests = append(requests, in)
+
+							return &admin.QueryAuditLogResponse{
+								Entries: []*admin.AuditEntry{{
+									Time:         timestamppb.New(expectedTime),
+									Caller:       "ci",
+									Method:       "/storms.v1.StorageManagementService/DeleteVolume",
+									ResourceUuid: "4141c8b6-9a6d-47ff-9bba-e047d131c9a6",
+									Code:         "OK",
+									Duration:     durationpb.New(time.Second),
+								}},
+							}, nil
+						},
+					}, &testutil.MockCloser{}, nil
+				},
+			}
+
+			cmd := NewAuditCmd(mockCmdFactory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectErr {
+				require.Error(t, err)
+				require.Empty(t, requests)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, requests, 1)
+			require.Equal(t, tt.expectReq.GetResourceUuid(), requests[0].GetResourceUuid())
+			require.Equal(t, tt.expectReq.GetStartTime().AsTime(), requests[0].GetStartTime().AsTime())
+			require.Equal(t, tt.expectReq.GetEndTime().AsTime(), requests[0].GetEndTime().AsTime())
+			require.Equal(t, tt.expectReq.GetLimit(), requests[0].GetLimit())
+		})
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/app"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/operation"
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/snapshot"
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/snapshots"
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/sync"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/tenant"
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/volume"
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/stormscli/volumes"
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
@@ -45,6 +47,10 @@ func newRootCmd() *cobra.Command {
 				return fmt.Errorf("failed to validate target addr: %w", err)
 			}
 			cmdFactory.TargetAddr = targetAddr
+			cmdFactory.TLSCAFile = cmd.Flags().Lookup("tls-ca").Value.String()
+			cmdFactory.TLSCertFile = cmd.Flags().Lookup("tls-cert").Value.String()
+			cmdFactory.TLSKeyFile = cmd.Flags().Lookup("tls-key").Value.String()
+			cmdFactory.TokenFile = cmd.Flags().Lookup("token-file").Value.String()
 
 			return nil
 		},
@@ -57,9 +63,16 @@ func newRootCmd() *cobra.Command {
 		snapshot.NewSnapshotCmd(cmdFactory),
 		snapshots.NewSnapshotsCmd(cmdFactory),
 		sync.NewSyncCmd(cmdFactory),
+		operation.NewOperationCmd(cmdFactory),
+		tenant.NewTenantCmd(cmdFactory),
 	)
 
 	rootCmd.PersistentFlags().StringP("target-addr", "", "", "target address of StorMS service")
+	rootCmd.PersistentFlags().StringP("tls-ca", "", "",
+		"PEM CA bundle to verify the StorMS server certificate against; enables TLS")
+	rootCmd.PersistentFlags().StringP("tls-cert", "", "", "PEM client certificate for mTLS; enables TLS")
+	rootCmd.PersistentFlags().StringP("tls-key", "", "", "PEM private key of --tls-cert")
+	rootCmd.PersistentFlags().StringP("token-file", "", "", "file holding a bearer token to authenticate with; needs TLS")
 
 	return rootCmd
 }
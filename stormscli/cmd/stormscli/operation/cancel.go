@@ -0,0 +1,54 @@
+package operation
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewCancelOperationCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel an operation in progress.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = cancelOperation(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of operation", true)
+
+	return cmd
+}
+
+func cancelOperation(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	resp, err := client.CancelOperation(cmd.Context(), &storms.CancelOperationRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel operation [id=%s]: %w", id, err)
+	}
+
+	if err := utils.RenderOperations([]*storms.Operation{resp.Operation}); err != nil {
+		return fmt.Errorf("failed to render operations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package operation
+
+const (
+	idFlag         = "id"
+	resourceIDFlag = "resource-id"
+	stateFlag      = "state"
+	timeoutFlag    = "timeout"
+	pageSizeFlag   = "page-size"
+)
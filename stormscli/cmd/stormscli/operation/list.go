@@ -0,0 +1,88 @@
+package operation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+var errInvalidState = errors.New("invalid operation state")
+
+func NewListOperationsCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List operations.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = listOperations(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(resourceIDFlag, "", "only list operations on this resource", false).
+		String(stateFlag, "", "only list operations in this state [in_progress,succeeded,failed,cancelled]", false).
+		Uint(pageSizeFlag, "", "number of operations to fetch per request; 0 fetches all at once", false)
+
+	return cmd
+}
+
+func listOperations(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	state, err := parseOperationState(utils.MustGetStringFlag(cmd, stateFlag))
+	if err != nil {
+		return err
+	}
+
+	req := &storms.ListOperationsRequest{
+		PageSize:     uint32(utils.MustGetUintFlag(cmd, pageSizeFlag)), //nolint:gosec // page sizes are small
+		ResourceUuid: utils.MustGetStringFlag(cmd, resourceIDFlag),
+		State:        state,
+	}
+	var operations []*storms.Operation
+	for {
+		resp, err := client.ListOperations(cmd.Context(), req)
+		if err != nil {
+			return fmt.Errorf("failed to list operations: %w", err)
+		}
+		operations = append(operations, resp.Operations...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	if err := utils.RenderOperations(operations); err != nil {
+		return fmt.Errorf("failed to print operation table: %w", err)
+	}
+
+	return nil
+}
+
+// Parses a state such as "in_progress" into its enum value. An empty state is unspecified.
+func parseOperationState(s string) (storms.OperationState, error) {
+	if s == "" {
+		return storms.OperationState_OPERATION_STATE_UNSPECIFIED, nil
+	}
+
+	value, ok := storms.OperationState_value["OPERATION_STATE_"+strings.ToUpper(s)]
+	if !ok {
+		return storms.OperationState_OPERATION_STATE_UNSPECIFIED, fmt.Errorf("%w: %q", errInvalidState, s)
+	}
+
+	return storms.OperationState(value), nil
+}
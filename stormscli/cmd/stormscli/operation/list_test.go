@@ -0,0 +1,98 @@
+package operation
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	testutil "gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/testutil"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_NewListOperationsCmd(t *testing.T) {
+	expectedTime := time.Date(2025, 11, 20, 14, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectState storms.OperationState
+		expectErr   bool
+	}{
+		{
+			name:      "valid; no filters",
+			args:      []string{},
+			expectErr: false,
+		},
+		{
+			name: "valid; filters",
+			args: []string{
+				"--resource-id",
+				"4141c8b6-9a6d-47ff-9bba-e047d131c9a6",
+				"--state",
+				"in_progress",
+				"--page-size",
+				"1",
+			},
+			expectState: storms.OperationState_OPERATION_STATE_IN_PROGRESS,
+			expectErr:   false,
+		},
+		{
+			name: "invalid; state",
+			args: []string{
+				"--state",
+				"paused",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []*storms.ListOperationsRequest
+			mockCmdFactory := &utils.CmdFactory{
+				StorMSClientProvider: func(context.Context) (storms.StorageManagementServiceClient, io.Closer, error) {
+					return &testutil.MockStorMSClient{
+						MockListOperations: func(ctx context.Context, in *storms.ListOperationsRequest, opts ...grpc.CallOption) (*storms.ListOperationsResponse, error) {
+							requests = append(requests, in)
+							resp := &storms.ListOperationsResponse{
+								Operations: []*storms.Operation{{
+									Id:           "0fb8966f-9224-40dd-9b9c-febbf21e163d",
+									Method:       "CreateVolume",
+									ResourceUuid: "4141c8b6-9a6d-47ff-9bba-e047d131c9a6",
+									State:        storms.OperationState_OPERATION_STATE_IN_PROGRESS,
+									CreatedAt:    timestamppb.New(expectedTime),
+									UpdatedAt:    timestamppb.New(expectedTime),
+								}},
+							}
+							if in.PageToken == "" {
+								resp.NextPageToken = "next"
+							}
+
+							return resp, nil
+						},
+					}, &testutil.MockCloser{}, nil
+				},
+			}
+
+			cmd := NewListOperationsCmd(mockCmdFactory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, requests, 2)
+			require.Equal(t, tt.expectState, requests[0].GetState())
+			require.Equal(t, "next", requests[1].GetPageToken())
+		})
+	}
+}
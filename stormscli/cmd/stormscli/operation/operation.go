@@ -0,0 +1,22 @@
+package operation
+
+import (
+	"github.com/spf13/cobra"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewOperationCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	operationCmd := &cobra.Command{
+		Use:   "operation",
+		Short: "Manage long-running operations.",
+	}
+
+	operationCmd.AddCommand(
+		NewGetOperationCmd(cmdFactory),
+		NewListOperationsCmd(cmdFactory),
+		NewWaitOperationCmd(cmdFactory),
+		NewCancelOperationCmd(cmdFactory),
+	)
+
+	return operationCmd
+}
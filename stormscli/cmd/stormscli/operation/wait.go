@@ -0,0 +1,66 @@
+package operation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewWaitOperationCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait",
+		Short: "Wait for an operation to complete.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = waitOperation(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of operation", true).
+		String(timeoutFlag, "", "how long to wait, e.g. 30s; waits until completion if unset", false)
+
+	return cmd
+}
+
+func waitOperation(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	req := &storms.WaitOperationRequest{
+		Id: id,
+	}
+	if timeout := utils.MustGetStringFlag(cmd, timeoutFlag); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse timeout: %w", err)
+		}
+		req.Timeout = durationpb.New(d)
+	}
+
+	resp, err := client.WaitOperation(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to wait for operation [id=%s]: %w", id, err)
+	}
+
+	if err := utils.RenderOperations([]*storms.Operation{resp.Operation}); err != nil {
+		return fmt.Errorf("failed to render operations: %w", err)
+	}
+
+	return nil
+}
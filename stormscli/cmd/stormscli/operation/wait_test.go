@@ -0,0 +1,93 @@
+package operation
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	testutil "gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/testutil"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+	"google.golang.org/grpc"
+)
+
+func Test_NewWaitOperationCmd(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		expectTimeout time.Duration
+		expectErr     bool
+	}{
+		{
+			name: "valid; no timeout",
+			args: []string{
+				"--id",
+				"0fb8966f-9224-40dd-9b9c-febbf21e163d",
+			},
+			expectErr: false,
+		},
+		{
+			name: "valid; timeout",
+			args: []string{
+				"--id",
+				"0fb8966f-9224-40dd-9b9c-febbf21e163d",
+				"--timeout",
+				"30s",
+			},
+			expectTimeout: 30 * time.Second,
+			expectErr:     false,
+		},
+		{
+			name: "invalid; timeout",
+			args: []string{
+				"--id",
+				"0fb8966f-9224-40dd-9b9c-febbf21e163d",
+				"--timeout",
+				"soon",
+			},
+			expectErr: true,
+		},
+		{
+			name:      "invalid; missing id",
+			args:      []string{},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request *storms.WaitOperationRequest
+			mockCmdFactory := &utils.CmdFactory{
+				StorMSClientProvider: func(context.Context) (storms.StorageManagementServiceClient, io.Closer, error) {
+					return &testutil.MockStorMSClient{
+						MockWaitOperation: func(ctx context.Context, in *storms.WaitOperationRequest, opts ...grpc.CallOption) (*storms.WaitOperationResponse, error) {
+							request = in
+
+							return &storms.WaitOperationResponse{
+								Operation: &storms.Operation{
+									Id:    in.Id,
+									State: storms.OperationState_OPERATION_STATE_SUCCEEDED,
+								},
+							}, nil
+						},
+					}, &testutil.MockCloser{}, nil
+				},
+			}
+
+			cmd := NewWaitOperationCmd(mockCmdFactory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectTimeout, request.GetTimeout().AsDuration())
+		})
+	}
+}
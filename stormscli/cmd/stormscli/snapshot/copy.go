@@ -0,0 +1,70 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewCopySnapshotCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "copy",
+		Short: "Copy a snapshot to another cluster.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = copySnapshot(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of the new snapshot", true).
+		String(srcSnapshotIDFlag, "", "id of the snapshot to copy", true).
+		String(dstClusterIDFlag, "", "id of the cluster to copy the snapshot to", true).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
+
+	return cmd
+}
+
+func copySnapshot(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	resp, err := client.CopySnapshot(cmd.Context(), &storms.CopySnapshotRequest{
+		Uuid:            id,
+		SrcSnapshotUuid: utils.MustGetStringFlag(cmd, srcSnapshotIDFlag),
+		DstClusterId:    utils.MustGetStringFlag(cmd, dstClusterIDFlag),
+		Async:           utils.MustGetBoolFlag(cmd, asyncFlag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy snapshot: %w", err)
+	}
+
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
+	cmd.Printf("Copied snapshot %s to cluster %s\n", id, resp.GetClusterId())
+	if resp.GetSnapshot() == nil {
+		return nil
+	}
+
+	if err := utils.RenderSnapshots([]*storms.Snapshot{resp.GetSnapshot()}); err != nil {
+		return fmt.Errorf("failed to render snapshots: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package snapshot
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	testutil "gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/testutil"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func Test_NewCopySnapshotCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			args: []string{
+				"--id",
+				"493ddf53-1794-450e-a576-09cc11399633",
+				"--src-snapshot-id",
+				"19fe65b9-db48-4bd7-8d39-dc1a0b008bbd",
+				"--dst-cluster-id",
+				"6352656f-d69c-4f4f-8a6c-578fc7e30102",
+			},
+			expectErr: false,
+		},
+		{
+			name: "missing destination cluster",
+			args: []string{
+				"--id",
+				"493ddf53-1794-450e-a576-09cc11399633",
+				"--src-snapshot-id",
+				"19fe65b9-db48-4bd7-8d39-dc1a0b008bbd",
+			},
+			expectErr: true,
+		},
+	}
+
+	mockClientProvider := func(context.Context) (storms.StorageManagementServiceClient, io.Closer, error) {
+		return &testutil.MockStorMSClient{
+			MockCopySnapshot: func(ctx context.Context, in *storms.CopySnapshotRequest, opts ...grpc.CallOption) (*storms.CopySnapshotResponse, error) {
+				return &storms.CopySnapshotResponse{
+					Snapshot:  &storms.Snapshot{Uuid: in.Uuid},
+					ClusterId: in.DstClusterId,
+				}, nil
+			},
+		}, &testutil.MockCloser{}, nil
+	}
+
+	mockCmdFactory := &utils.CmdFactory{
+		StorMSClientProvider: mockClientProvider,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewCopySnapshotCmd(mockCmdFactory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -32,7 +32,11 @@ func NewCreateSnapshotCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 
 	utils.NewFlagBuilder(cmd).
 		String(idFlag, "", "id of snapshot", true).
-		String(srcVolIDFlag, "", "source volume uuid", true)
+		String(srcVolIDFlag, "", "source volume uuid", true).
+		StringToString(labelsFlag, "", "labels of the snapshot in the format key1=val1,key2=val2,...,keyN=valN", false).
+		String(tenantIDFlag, "", "id of the tenant owning the snapshot; defaults to the tenant of the source volume",
+			false).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
 
 	return cmd
 }
@@ -41,15 +45,31 @@ func createSnapshot(cmd *cobra.Command, client storms.StorageManagementServiceCl
 	id := utils.MustGetStringFlag(cmd, idFlag)
 	srcVolID := utils.MustGetStringFlag(cmd, srcVolIDFlag)
 
-	_, err := client.CreateSnapshot(cmd.Context(), &storms.CreateSnapshotRequest{
+	resp, err := client.CreateSnapshot(cmd.Context(), &storms.CreateSnapshotRequest{
 		Uuid:          id,
 		SrcVolumeUuid: srcVolID,
+		Labels:        utils.MustGetStringToStringFlag(cmd, labelsFlag),
+		TenantId:      utils.MustGetStringFlag(cmd, tenantIDFlag),
+		Async:         utils.MustGetBoolFlag(cmd, asyncFlag),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create snapshot: %w", err)
 	}
 
-	cmd.Printf("Created snapshot: %s\n", id)
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
+	cmd.Printf("Created snapshot %s on cluster %s\n", id, resp.GetClusterId())
+	if resp.GetSnapshot() == nil {
+		return nil
+	}
+
+	if err := utils.RenderSnapshots([]*storms.Snapshot{resp.GetSnapshot()}); err != nil {
+		return fmt.Errorf("failed to render snapshots: %w", err)
+	}
 
 	return nil
 }
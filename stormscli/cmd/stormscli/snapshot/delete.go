@@ -31,7 +31,8 @@ func NewDeleteSnapshotCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	}
 
 	utils.NewFlagBuilder(cmd).
-		String("id", "", "id of snapshot", true)
+		String("id", "", "id of snapshot", true).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
 
 	return cmd
 }
@@ -39,13 +40,20 @@ func NewDeleteSnapshotCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 func deleteSnapshot(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
 	id := utils.MustGetStringFlag(cmd, "id")
 
-	_, err := client.DeleteSnapshot(cmd.Context(), &storms.DeleteSnapshotRequest{
-		Uuid: id,
+	resp, err := client.DeleteSnapshot(cmd.Context(), &storms.DeleteSnapshotRequest{
+		Uuid:  id,
+		Async: utils.MustGetBoolFlag(cmd, asyncFlag),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete snapshot: %w", err)
 	}
 
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
 	cmd.Printf("Deleted snapshot: %s\n", id)
 
 	return nil
@@ -1,6 +1,12 @@
 package snapshot
 
 const (
-	idFlag       = "id"
-	srcVolIDFlag = "src-vol-id"
+	idFlag            = "id"
+	srcVolIDFlag      = "src-vol-id"
+	asyncFlag         = "async"
+	srcSnapshotIDFlag = "src-snapshot-id"
+	dstClusterIDFlag  = "dst-cluster-id"
+	labelsFlag        = "labels"
+	removeLabelsFlag  = "remove-labels"
+	tenantIDFlag      = "tenant-id"
 )
@@ -12,9 +12,11 @@ func NewSnapshotCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	}
 
 	snapshotCmd.AddCommand(
+		NewCopySnapshotCmd(cmdFactory),
 		NewCreateSnapshotCmd(cmdFactory),
 		NewDeleteSnapshotCmd(cmdFactory),
 		NewGetSnapshotCmd(cmdFactory),
+		NewUpdateSnapshotLabelsCmd(cmdFactory),
 	)
 
 	return snapshotCmd
@@ -0,0 +1,58 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewUpdateSnapshotLabelsCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-labels",
+		Short: "Set and remove labels of a snapshot.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = updateSnapshotLabels(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of snapshot", true).
+		StringToString(labelsFlag, "", "labels to set in the format key1=val1,key2=val2,...,keyN=valN", false).
+		StringCSV(removeLabelsFlag, "", "keys of labels to remove", false)
+
+	return cmd
+}
+
+func updateSnapshotLabels(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	resp, err := client.UpdateSnapshotLabels(cmd.Context(), &storms.UpdateSnapshotLabelsRequest{
+		Uuid:         id,
+		Labels:       utils.MustGetStringToStringFlag(cmd, labelsFlag),
+		RemoveLabels: utils.MustGetStringCSVFlag(cmd, removeLabelsFlag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update snapshot labels: %w", err)
+	}
+
+	cmd.Printf("Updated labels of snapshot %s\n", id)
+	if err := utils.RenderSnapshots([]*storms.Snapshot{resp.GetSnapshot()}); err != nil {
+		return fmt.Errorf("failed to render snapshots: %w", err)
+	}
+
+	return nil
+}
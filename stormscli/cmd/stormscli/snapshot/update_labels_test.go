@@ -0,0 +1,80 @@
+package snapshot
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	testutil "gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/testutil"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func Test_NewUpdateSnapshotLabelsCmd(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		expectLabels map[string]string
+		expectRemove []string
+		expectErr    bool
+	}{
+		{
+			name: "set and remove",
+			args: []string{
+				"--id",
+				"493ddf53-1794-450e-a576-09cc11399633",
+				"--labels",
+				"tenant=a,vm=b",
+				"--remove-labels",
+				"zone",
+			},
+			expectLabels: map[string]string{"tenant": "a", "vm": "b"},
+			expectRemove: []string{"zone"},
+			expectErr:    false,
+		},
+		{
+			name: "missing id",
+			args: []string{
+				"--labels",
+				"tenant=a",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *storms.UpdateSnapshotLabelsRequest
+			mockClientProvider := func(context.Context) (storms.StorageManagementServiceClient, io.Closer, error) {
+				return &testutil.MockStorMSClient{
+					MockUpdateSnapshotLabels: func(ctx context.Context, in *storms.UpdateSnapshotLabelsRequest,
+						opts ...grpc.CallOption,
+					) (*storms.UpdateSnapshotLabelsResponse, error) {
+						got = in
+
+						return &storms.UpdateSnapshotLabelsResponse{
+							Snapshot: &storms.Snapshot{Uuid: in.Uuid, Labels: in.Labels},
+						}, nil
+					},
+				}, &testutil.MockCloser{}, nil
+			}
+
+			cmd := NewUpdateSnapshotLabelsCmd(&utils.CmdFactory{StorMSClientProvider: mockClientProvider})
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectLabels, got.GetLabels())
+			require.Equal(t, tt.expectRemove, got.GetRemoveLabels())
+		})
+	}
+}
@@ -9,7 +9,14 @@ import (
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
 )
 
-const idsFlag = "ids"
+const (
+	idsFlag           = "ids"
+	clusterIDFlag     = "cluster-id"
+	vendorFlag        = "vendor"
+	srcVolIDFlag      = "src-vol-id"
+	labelSelectorFlag = "label-selector"
+	pageSizeFlag      = "page-size"
+)
 
 func NewListSnapshotsCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,7 +40,13 @@ func NewListSnapshotsCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	}
 
 	utils.NewFlagBuilder(cmd).
-		StringCSV(idsFlag, "", "uuid of snapshot(s)", false)
+		StringCSV(idsFlag, "", "uuid of snapshot(s)", false).
+		String(clusterIDFlag, "", "only list snapshots on this cluster", false).
+		String(vendorFlag, "", "only list snapshots on clusters of this vendor", false).
+		String(srcVolIDFlag, "", "only list snapshots of this volume", false).
+		String(labelSelectorFlag, "",
+			"only list snapshots whose labels match this selector, e.g. 'tenant=a,env!=prod,vm,!legacy'", false).
+		Uint(pageSizeFlag, "", "number of snapshots to fetch per request; 0 fetches all at once", false)
 
 	return cmd
 }
@@ -55,11 +68,29 @@ func listSnapshots(cmd *cobra.Command, client storms.StorageManagementServiceCli
 			snapshots = append(snapshots, resp.Snapshot)
 		}
 	} else {
-		resp, err := client.GetSnapshots(cmd.Context(), &storms.GetSnapshotsRequest{})
-		if err != nil {
-			return fmt.Errorf("failed to get snapshots: %w", err)
+		req := &storms.GetSnapshotsRequest{
+			PageSize: uint32(utils.MustGetUintFlag(cmd, pageSizeFlag)), //nolint:gosec // page sizes are small
+			Filter: &storms.SnapshotFilter{
+				ClusterId:        utils.MustGetStringFlag(cmd, clusterIDFlag),
+				Vendor:           utils.MustGetStringFlag(cmd, vendorFlag),
+				SourceVolumeUuid: utils.MustGetStringFlag(cmd, srcVolIDFlag),
+				LabelSelector:    utils.MustGetStringFlag(cmd, labelSelectorFlag),
+			},
+		}
+		for {
+			resp, err := client.GetSnapshots(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to get snapshots: %w", err)
+			}
+			for _, f := range resp.ClusterFailures {
+				cmd.PrintErrf("warning: snapshots missing from cluster %s (%s): %s\n", f.ClusterId, f.Vendor, f.Error)
+			}
+			snapshots = append(snapshots, resp.Snapshots...)
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
 		}
-		snapshots = resp.Snapshots
 	}
 
 	if err := utils.RenderSnapshots(snapshots); err != nil {
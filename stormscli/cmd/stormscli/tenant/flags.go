@@ -0,0 +1,5 @@
+package tenant
+
+const (
+	idFlag = "id"
+)
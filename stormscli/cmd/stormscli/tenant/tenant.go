@@ -0,0 +1,19 @@
+package tenant
+
+import (
+	"github.com/spf13/cobra"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewTenantCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	tenantCmd := &cobra.Command{
+		Use:   "tenant",
+		Short: "Inspect the resources and quotas of tenants.",
+	}
+
+	tenantCmd.AddCommand(
+		NewGetTenantUsageCmd(cmdFactory),
+	)
+
+	return tenantCmd
+}
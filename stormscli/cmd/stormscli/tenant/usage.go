@@ -0,0 +1,54 @@
+package tenant
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewGetTenantUsageCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Show the resources a tenant owns against its quota.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = getTenantUsage(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of tenant", true)
+
+	return cmd
+}
+
+func getTenantUsage(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	resp, err := client.GetTenantUsage(cmd.Context(), &storms.GetTenantUsageRequest{
+		TenantId: id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get usage of tenant [id=%s]: %w", id, err)
+	}
+
+	if err := utils.RenderTenantUsage(resp.GetUsage(), resp.GetQuota()); err != nil {
+		return fmt.Errorf("failed to render tenant usage: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package tenant
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	testutil "gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/testutil"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func Test_NewGetTenantUsageCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name:      "valid",
+			args:      []string{"--id", "tenant-a"},
+			expectErr: false,
+		},
+		{
+			name:      "missing id",
+			args:      []string{},
+			expectErr: true,
+		},
+	}
+
+	mockClientProvider := func(context.Context) (storms.StorageManagementServiceClient, io.Closer, error) {
+		return &testutil.MockStorMSClient{
+			MockGetTenantUsage: func(ctx context.Context, in *storms.GetTenantUsageRequest, opts ...grpc.CallOption,
+			) (*storms.GetTenantUsageResponse, error) {
+				return &storms.GetTenantUsageResponse{
+					Usage: &storms.TenantUsage{Volumes: 1},
+					Quota: &storms.TenantQuota{MaxVolumes: 10},
+				}, nil
+			},
+		}, &testutil.MockCloser{}, nil
+	}
+
+	mockCmdFactory := &utils.CmdFactory{
+		StorMSClientProvider: mockClientProvider,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewGetTenantUsageCmd(mockCmdFactory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package volume
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewCopyVolumeCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "copy",
+		Short: "Copy a volume to another cluster.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = copyVolume(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of the new volume", true).
+		String(srcVolIDFlag, "", "id of the volume to copy", true).
+		String(dstClusterIDFlag, "", "id of the cluster to copy the volume to", true).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
+
+	return cmd
+}
+
+func copyVolume(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	resp, err := client.CopyVolume(cmd.Context(), &storms.CopyVolumeRequest{
+		Uuid:          id,
+		SrcVolumeUuid: utils.MustGetStringFlag(cmd, srcVolIDFlag),
+		DstClusterId:  utils.MustGetStringFlag(cmd, dstClusterIDFlag),
+		Async:         utils.MustGetBoolFlag(cmd, asyncFlag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy volume: %w", err)
+	}
+
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
+	cmd.Printf("Copied volume %s to cluster %s\n", id, resp.GetClusterId())
+	if resp.GetVolume() == nil {
+		return nil
+	}
+
+	if err := utils.RenderVolumes([]*storms.Volume{resp.GetVolume()}); err != nil {
+		return fmt.Errorf("failed to render volumes: %w", err)
+	}
+
+	return nil
+}
@@ -73,7 +73,10 @@ func NewCreateVolumeCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 				"volumes will be placed on clusters are tagged with all the key-value tags specified;\n"+
 				"if no tags are specified, all clusters can qualified for volume placement;\n"+
 				"if more than 1 clusters qualify, placement is determined by StorMS allocator algorithm",
-			false)
+			false).
+		StringToString(labelsFlag, "", "labels of the volume in the format key1=val1,key2=val2,...,keyN=valN", false).
+		String(tenantIDFlag, "", "id of the tenant owning the volume, whose quota it counts against", false).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
 
 	return cmd
 }
@@ -98,13 +101,30 @@ func createVolume(cmd *cobra.Command, client storms.StorageManagementServiceClie
 		}
 	}
 
+	req.Labels = utils.MustGetStringToStringFlag(cmd, labelsFlag)
+	req.TenantId = utils.MustGetStringFlag(cmd, tenantIDFlag)
+	req.Async = utils.MustGetBoolFlag(cmd, asyncFlag)
+
 	// Send the fully constructed request to the client.
-	_, err = client.CreateVolume(cmd.Context(), req)
+	resp, err := client.CreateVolume(cmd.Context(), req)
 	if err != nil {
 		return fmt.Errorf("failed to create volume: %w", err)
 	}
 
-	cmd.Printf("Created volume: %s\n", id)
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
+	cmd.Printf("Created volume %s on cluster %s\n", id, resp.GetClusterId())
+	if resp.GetVolume() == nil {
+		return nil
+	}
+
+	if err := utils.RenderVolumes([]*storms.Volume{resp.GetVolume()}); err != nil {
+		return fmt.Errorf("failed to render volumes: %w", err)
+	}
 
 	return nil
 }
@@ -30,20 +30,28 @@ func NewDeleteVolumeCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	}
 
 	utils.NewFlagBuilder(cmd).
-		String(idFlag, "", "id of volume", true)
+		String(idFlag, "", "id of volume", true).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
 
 	return cmd
 }
 
 func deleteVolume(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
 	id := utils.MustGetStringFlag(cmd, idFlag)
-	_, err := client.DeleteVolume(cmd.Context(), &storms.DeleteVolumeRequest{
-		Uuid: id,
+	resp, err := client.DeleteVolume(cmd.Context(), &storms.DeleteVolumeRequest{
+		Uuid:  id,
+		Async: utils.MustGetBoolFlag(cmd, asyncFlag),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete volume: %w", err)
 	}
 
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
 	cmd.Printf("Deleted volume: %s\n", id)
 
 	return nil
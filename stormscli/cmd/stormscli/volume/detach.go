@@ -32,7 +32,8 @@ func NewDetachVolumeCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 
 	utils.NewFlagBuilder(cmd).
 		String(idFlag, "", "id of volume", true).
-		StringCSV(aclFlag, "", "comma-separated list uuids to remove from volume ACL", true)
+		StringCSV(aclFlag, "", "comma-separated list uuids to remove from volume ACL", true).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
 
 	return cmd
 }
@@ -41,14 +42,21 @@ func detachVolume(cmd *cobra.Command, client storms.StorageManagementServiceClie
 	id := utils.MustGetStringFlag(cmd, idFlag)
 	acl := utils.MustGetStringCSVFlag(cmd, aclFlag)
 
-	_, err := client.DetachVolume(cmd.Context(), &storms.DetachVolumeRequest{
-		Uuid: id,
-		Acl:  acl,
+	resp, err := client.DetachVolume(cmd.Context(), &storms.DetachVolumeRequest{
+		Uuid:  id,
+		Acl:   acl,
+		Async: utils.MustGetBoolFlag(cmd, asyncFlag),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to attach volume: %w", err)
 	}
 
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
 	cmd.Printf("Removed from ACL of volume %s: %v\n", id, acl)
 
 	return nil
@@ -7,4 +7,11 @@ const (
 	srcSnapshotIDFlag = "src-snapshot-id"
 	aclFlag           = "acl"
 	affinityTagsFlag  = "affinity-tags"
+	asyncFlag         = "async"
+	srcVolIDFlag      = "src-vol-id"
+	dstClusterIDFlag  = "dst-cluster-id"
+	snapshotIDFlag    = "snapshot-id"
+	labelsFlag        = "labels"
+	removeLabelsFlag  = "remove-labels"
+	tenantIDFlag      = "tenant-id"
 )
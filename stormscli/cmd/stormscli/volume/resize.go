@@ -31,7 +31,8 @@ func NewResizeVolumeCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 
 	utils.NewFlagBuilder(cmd).
 		String(idFlag, "", "id of volume", true).
-		String(sizeFlag, "", "size of volume in the format 'X[GiB|TiB]' where X is a non-zero integer", true)
+		String(sizeFlag, "", "size of volume in the format 'X[GiB|TiB]' where X is a non-zero integer", true).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
 
 	return cmd
 }
@@ -45,14 +46,21 @@ func resizeVolume(cmd *cobra.Command, client storms.StorageManagementServiceClie
 		return fmt.Errorf("failed to parse size: %w", err)
 	}
 
-	_, err = client.ResizeVolume(cmd.Context(), &storms.ResizeVolumeRequest{
-		Uuid: id,
-		Size: sizeBytes,
+	resp, err := client.ResizeVolume(cmd.Context(), &storms.ResizeVolumeRequest{
+		Uuid:  id,
+		Size:  sizeBytes,
+		Async: utils.MustGetBoolFlag(cmd, asyncFlag),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to resize volume: %w", err)
 	}
 
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
 	cmd.Printf("Resized volume: %s (size: %s)\n", id, sizeStr)
 
 	return nil
@@ -0,0 +1,68 @@
+package volume
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewRevertVolumeCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revert",
+		Short: "Revert a detached volume in place to one of its snapshots.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = revertVolume(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of volume", true).
+		String(snapshotIDFlag, "", "id of the snapshot of the volume to revert it to", true).
+		Bool(asyncFlag, "", "return an operation ID instead of waiting for the operation to complete", false)
+
+	return cmd
+}
+
+func revertVolume(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	resp, err := client.RevertVolumeToSnapshot(cmd.Context(), &storms.RevertVolumeToSnapshotRequest{
+		Uuid:         id,
+		SnapshotUuid: utils.MustGetStringFlag(cmd, snapshotIDFlag),
+		Async:        utils.MustGetBoolFlag(cmd, asyncFlag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revert volume: %w", err)
+	}
+
+	if resp.GetOperationId() != "" {
+		cmd.Printf("Started operation: %s\n", resp.GetOperationId())
+
+		return nil
+	}
+
+	cmd.Printf("Reverted volume %s to snapshot %s\n", id, utils.MustGetStringFlag(cmd, snapshotIDFlag))
+	if resp.GetVolume() == nil {
+		return nil
+	}
+
+	if err := utils.RenderVolumes([]*storms.Volume{resp.GetVolume()}); err != nil {
+		return fmt.Errorf("failed to render volumes: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package volume
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	testutil "gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/testutil"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func Test_NewRevertVolumeCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			args: []string{
+				"--id",
+				"493ddf53-1794-450e-a576-09cc11399633",
+				"--snapshot-id",
+				"19fe65b9-db48-4bd7-8d39-dc1a0b008bbd",
+			},
+			expectErr: false,
+		},
+		{
+			name: "missing snapshot",
+			args: []string{
+				"--id",
+				"493ddf53-1794-450e-a576-09cc11399633",
+			},
+			expectErr: true,
+		},
+	}
+
+	mockClientProvider := func(context.Context) (storms.StorageManagementServiceClient, io.Closer, error) {
+		return &testutil.MockStorMSClient{
+			MockRevertVolumeToSnapshot: func(ctx context.Context, in *storms.RevertVolumeToSnapshotRequest, opts ...grpc.CallOption) (*storms.RevertVolumeToSnapshotResponse, error) {
+				return &storms.RevertVolumeToSnapshotResponse{
+					Volume: &storms.Volume{Uuid: in.Uuid},
+				}, nil
+			},
+		}, &testutil.MockCloser{}, nil
+	}
+
+	mockCmdFactory := &utils.CmdFactory{
+		StorMSClientProvider: mockClientProvider,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewRevertVolumeCmd(mockCmdFactory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package volume
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
+	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
+)
+
+func NewUpdateVolumeLabelsCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-labels",
+		Short: "Set and remove labels of a volume.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, conn, err := cmdFactory.StorMSClientProvider(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to create StorMS client: %w", err)
+			}
+			defer conn.Close()
+
+			err = updateVolumeLabels(cmd, client)
+			if err != nil {
+				return fmt.Errorf("failed command: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	utils.NewFlagBuilder(cmd).
+		String(idFlag, "", "id of volume", true).
+		StringToString(labelsFlag, "", "labels to set in the format key1=val1,key2=val2,...,keyN=valN", false).
+		StringCSV(removeLabelsFlag, "", "keys of labels to remove", false)
+
+	return cmd
+}
+
+func updateVolumeLabels(cmd *cobra.Command, client storms.StorageManagementServiceClient) error {
+	id := utils.MustGetStringFlag(cmd, idFlag)
+
+	resp, err := client.UpdateVolumeLabels(cmd.Context(), &storms.UpdateVolumeLabelsRequest{
+		Uuid:         id,
+		Labels:       utils.MustGetStringToStringFlag(cmd, labelsFlag),
+		RemoveLabels: utils.MustGetStringCSVFlag(cmd, removeLabelsFlag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update volume labels: %w", err)
+	}
+
+	cmd.Printf("Updated labels of volume %s\n", id)
+	if err := utils.RenderVolumes([]*storms.Volume{resp.GetVolume()}); err != nil {
+		return fmt.Errorf("failed to render volumes: %w", err)
+	}
+
+	return nil
+}
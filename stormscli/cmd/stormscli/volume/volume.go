@@ -13,11 +13,15 @@ func NewVolumeCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 
 	volumesCmd.AddCommand(
 		NewAttachVolumeCmd(cmdFactory),
+		NewCloneVolumeCmd(cmdFactory),
+		NewCopyVolumeCmd(cmdFactory),
 		NewCreateVolumeCmd(cmdFactory),
 		NewDeleteVolumeCmd(cmdFactory),
 		NewDetachVolumeCmd(cmdFactory),
 		NewGetVolumeCmd(cmdFactory),
 		NewResizeVolumeCmd(cmdFactory),
+		NewRevertVolumeCmd(cmdFactory),
+		NewUpdateVolumeLabelsCmd(cmdFactory),
 	)
 
 	return volumesCmd
@@ -9,7 +9,14 @@ import (
 	"gitlab.com/crusoeenergy/island/storage/storms/stormscli/cmd/utils"
 )
 
-const idsFlag = "ids"
+const (
+	idsFlag           = "ids"
+	clusterIDFlag     = "cluster-id"
+	vendorFlag        = "vendor"
+	hostFlag          = "host"
+	labelSelectorFlag = "label-selector"
+	pageSizeFlag      = "page-size"
+)
 
 func NewListVolumesCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,7 +40,13 @@ func NewListVolumesCmd(cmdFactory *utils.CmdFactory) *cobra.Command {
 	}
 
 	utils.NewFlagBuilder(cmd).
-		StringCSV(idsFlag, "", "uuid of volumes", false)
+		StringCSV(idsFlag, "", "uuid of volumes", false).
+		String(clusterIDFlag, "", "only list volumes on this cluster", false).
+		String(vendorFlag, "", "only list volumes on clusters of this vendor", false).
+		String(hostFlag, "", "only list volumes whose ACL contains this host", false).
+		String(labelSelectorFlag, "",
+			"only list volumes whose labels match this selector, e.g. 'tenant=a,env!=prod,vm,!legacy'", false).
+		Uint(pageSizeFlag, "", "number of volumes to fetch per request; 0 fetches all at once", false)
 
 	return cmd
 }
@@ -56,11 +69,29 @@ func listVolumes(cmd *cobra.Command, client storms.StorageManagementServiceClien
 			volumes = append(volumes, resp.Volume)
 		}
 	} else {
-		resp, err := client.GetVolumes(cmd.Context(), &storms.GetVolumesRequest{})
-		if err != nil {
-			return fmt.Errorf("failed to get volumes: %w", err)
+		req := &storms.GetVolumesRequest{
+			PageSize: uint32(utils.MustGetUintFlag(cmd, pageSizeFlag)), //nolint:gosec // page sizes are small
+			Filter: &storms.VolumeFilter{
+				ClusterId:     utils.MustGetStringFlag(cmd, clusterIDFlag),
+				Vendor:        utils.MustGetStringFlag(cmd, vendorFlag),
+				AclContains:   utils.MustGetStringFlag(cmd, hostFlag),
+				LabelSelector: utils.MustGetStringFlag(cmd, labelSelectorFlag),
+			},
+		}
+		for {
+			resp, err := client.GetVolumes(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to get volumes: %w", err)
+			}
+			for _, f := range resp.ClusterFailures {
+				cmd.PrintErrf("warning: volumes missing from cluster %s (%s): %s\n", f.ClusterId, f.Vendor, f.Error)
+			}
+			volumes = append(volumes, resp.Volumes...)
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
 		}
-		volumes = resp.Volumes
 	}
 
 	if err := utils.RenderVolumes(volumes); err != nil {
@@ -43,6 +43,26 @@ func Test_NewListVolumesCmd(t *testing.T) {
 			args:      []string{},
 			expectErr: false,
 		},
+		{
+			name: "valid; filters",
+			args: []string{
+				"--vendor",
+				"lightbits",
+				"--host",
+				"e4c2e3f8-a0bd-4e37-b5d0-a107137651fc",
+				"--page-size",
+				"100",
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid; page size",
+			args: []string{
+				"--page-size",
+				"-1",
+			},
+			expectErr: true,
+		},
 	}
 
 	mockClientProvider := func(context.Context) (storms.StorageManagementServiceClient, io.Closer, error) {
@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
 	"google.golang.org/grpc"
 )
@@ -34,6 +35,28 @@ type MockStorMSClient struct {
 	) (*storms.SyncResourceResponse, error)
 	MockSyncAllResources func(ctx context.Context, in *storms.SyncAllResourcesRequest, opts ...grpc.CallOption,
 	) (*storms.SyncAllResourcesResponse, error)
+	MockGetOperation func(ctx context.Context, in *storms.GetOperationRequest, opts ...grpc.CallOption,
+	) (*storms.GetOperationResponse, error)
+	MockListOperations func(ctx context.Context, in *storms.ListOperationsRequest, opts ...grpc.CallOption,
+	) (*storms.ListOperationsResponse, error)
+	MockWaitOperation func(ctx context.Context, in *storms.WaitOperationRequest, opts ...grpc.CallOption,
+	) (*storms.WaitOperationResponse, error)
+	MockCancelOperation func(ctx context.Context, in *storms.CancelOperationRequest, opts ...grpc.CallOption,
+	) (*storms.CancelOperationResponse, error)
+	MockCopyVolume func(ctx context.Context, in *storms.CopyVolumeRequest, opts ...grpc.CallOption,
+	) (*storms.CopyVolumeResponse, error)
+	MockCloneVolume func(ctx context.Context, in *storms.CloneVolumeRequest, opts ...grpc.CallOption,
+	) (*storms.CloneVolumeResponse, error)
+	MockRevertVolumeToSnapshot func(ctx context.Context, in *storms.RevertVolumeToSnapshotRequest, opts ...grpc.CallOption,
+	) (*storms.RevertVolumeToSnapshotResponse, error)
+	MockCopySnapshot func(ctx context.Context, in *storms.CopySnapshotRequest, opts ...grpc.CallOption,
+	) (*storms.CopySnapshotResponse, error)
+	MockUpdateVolumeLabels func(ctx context.Context, in *storms.UpdateVolumeLabelsRequest, opts ...grpc.CallOption,
+	) (*storms.UpdateVolumeLabelsResponse, error)
+	MockUpdateSnapshotLabels func(ctx context.Context, in *storms.UpdateSnapshotLabelsRequest, opts ...grpc.CallOption,
+	) (*storms.UpdateSnapshotLabelsResponse, error)
+	MockGetTenantUsage func(ctx context.Context, in *storms.GetTenantUsageRequest, opts ...grpc.CallOption,
+	) (*storms.GetTenantUsageResponse, error)
 }
 
 func (m *MockStorMSClient) GetVolume(
@@ -119,3 +142,96 @@ type MockCloser struct{}
 func (m *MockCloser) Close() error {
 	return nil
 }
+
+func (m *MockStorMSClient) GetOperation(
+	ctx context.Context, in *storms.GetOperationRequest, opts ...grpc.CallOption,
+) (*storms.GetOperationResponse, error) {
+	return m.MockGetOperation(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) ListOperations(
+	ctx context.Context, in *storms.ListOperationsRequest, opts ...grpc.CallOption,
+) (*storms.ListOperationsResponse, error) {
+	return m.MockListOperations(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) WaitOperation(
+	ctx context.Context, in *storms.WaitOperationRequest, opts ...grpc.CallOption,
+) (*storms.WaitOperationResponse, error) {
+	return m.MockWaitOperation(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) CancelOperation(
+	ctx context.Context, in *storms.CancelOperationRequest, opts ...grpc.CallOption,
+) (*storms.CancelOperationResponse, error) {
+	return m.MockCancelOperation(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) CopyVolume(
+	ctx context.Context, in *storms.CopyVolumeRequest, opts ...grpc.CallOption,
+) (*storms.CopyVolumeResponse, error) {
+	return m.MockCopyVolume(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) CopySnapshot(
+	ctx context.Context, in *storms.CopySnapshotRequest, opts ...grpc.CallOption,
+) (*storms.CopySnapshotResponse, error) {
+	return m.MockCopySnapshot(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) CloneVolume(
+	ctx context.Context, in *storms.CloneVolumeRequest, opts ...grpc.CallOption,
+) (*storms.CloneVolumeResponse, error) {
+	return m.MockCloneVolume(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) RevertVolumeToSnapshot(
+	ctx context.Context, in *storms.RevertVolumeToSnapshotRequest, opts ...grpc.CallOption,
+) (*storms.RevertVolumeToSnapshotResponse, error) {
+	return m.MockRevertVolumeToSnapshot(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) UpdateVolumeLabels(
+	ctx context.Context, in *storms.UpdateVolumeLabelsRequest, opts ...grpc.CallOption,
+) (*storms.UpdateVolumeLabelsResponse, error) {
+	return m.MockUpdateVolumeLabels(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) UpdateSnapshotLabels(
+	ctx context.Context, in *storms.UpdateSnapshotLabelsRequest, opts ...grpc.CallOption,
+) (*storms.UpdateSnapshotLabelsResponse, error) {
+	return m.MockUpdateSnapshotLabels(ctx, in, opts...)
+}
+
+func (m *MockStorMSClient) GetTenantUsage(
+	ctx context.Context, in *storms.GetTenantUsageRequest, opts ...grpc.CallOption,
+) (*storms.GetTenantUsageResponse, error) {
+	return m.MockGetTenantUsage(ctx, in, opts...)
+}
+
+type MockAdminClient struct {
+	MockReloadConfig func(ctx context.Context, in *admin.ReloadConfigRequest, opts ...grpc.CallOption,
+	) (*admin.ReloadConfigResponse, error)
+	MockShowClusters func(ctx context.Context, in *admin.ShowClustersRequest, opts ...grpc.CallOption,
+	) (*admin.ShowClustersResponse, error)
+	MockQueryAuditLog func(ctx context.Context, in *admin.QueryAuditLogRequest, opts ...grpc.CallOption,
+	) (*admin.QueryAuditLogResponse, error)
+}
+
+func (m *MockAdminClient) ReloadConfig(
+	ctx context.Context, in *admin.ReloadConfigRequest, opts ...grpc.CallOption,
+) (*admin.ReloadConfigResponse, error) {
+	return m.MockReloadConfig(ctx, in, opts...)
+}
+
+func (m *MockAdminClient) ShowClusters(
+	ctx context.Context, in *admin.ShowClustersRequest, opts ...grpc.CallOption,
+) (*admin.ShowClustersResponse, error) {
+	return m.MockShowClusters(ctx, in, opts...)
+}
+
+func (m *MockAdminClient) QueryAuditLog(
+	ctx context.Context, in *admin.QueryAuditLogRequest, opts ...grpc.CallOption,
+) (*admin.QueryAuditLogResponse, error) {
+	return m.MockQueryAuditLog(ctx, in, opts...)
+}
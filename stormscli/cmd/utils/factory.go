@@ -2,17 +2,36 @@ package utils
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"strings"
 
 	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+var (
+	errIncompleteKeyPair = errors.New("--tls-cert and --tls-key must be set together")
+	errNoCACertificates  = errors.New("no certificates found in CA file")
+	errEmptyToken        = errors.New("token file is empty")
+)
+
 type CmdFactory struct {
 	TargetAddr string
+	// PEM CA bundle that the server certificate is verified against. The system roots are used if unset.
+	TLSCAFile string
+	// PEM client certificate and key presented to servers requiring mTLS.
+	TLSCertFile string
+	TLSKeyFile  string
+	// File holding a bearer token sent with every request, for servers that authorize callers by token.
+	TokenFile string
 
 	AdminClientProvider  AdminClientProvider
 	StorMSClientProvider StorMSClientProvider
@@ -29,10 +48,11 @@ func NewCmdFactory() *CmdFactory {
 // Create a Storage Management Service client.
 func (f *CmdFactory) CreateStorMSClient() (storms.StorageManagementServiceClient, *grpc.ClientConn, error) {
 	// Dial gRPC server with modern options
-	conn, err := grpc.NewClient(
-		f.TargetAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()), // insecure; replace with TLS in prod
-	)
+	opts, err := f.dialOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.NewClient(f.TargetAddr, opts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create grpc client: %w", err)
 	}
@@ -51,10 +71,11 @@ func (f *CmdFactory) DefaultStorMSProvider(_ context.Context,
 
 func (f *CmdFactory) CreateAdminClient() (admin.AdminServiceClient, *grpc.ClientConn, error) {
 	// Dial gRPC server with modern options
-	conn, err := grpc.NewClient(
-		f.TargetAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()), // insecure; replace with TLS in prod
-	)
+	opts, err := f.dialOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.NewClient(f.TargetAddr, opts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create grpc client: %w", err)
 	}
@@ -69,3 +90,78 @@ type AdminClientProvider func(context.Context) (admin.AdminServiceClient, io.Clo
 func (f *CmdFactory) DefaultAdminProvider(_ context.Context) (admin.AdminServiceClient, io.Closer, error) {
 	return f.CreateAdminClient()
 }
+
+// Returns the options to dial the StorMS server with.
+func (f *CmdFactory) dialOptions() ([]grpc.DialOption, error) {
+	creds, err := f.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+
+	if f.TokenFile != "" {
+		token, err := readToken(f.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(token))
+	}
+
+	return opts, nil
+}
+
+// Returns TLS credentials if any TLS flag is set, and plaintext credentials otherwise.
+func (f *CmdFactory) transportCredentials() (credentials.TransportCredentials, error) {
+	if f.TLSCAFile == "" && f.TLSCertFile == "" && f.TLSKeyFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if f.TLSCAFile != "" {
+		pem, err := os.ReadFile(f.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%w: %s", errNoCACertificates, f.TLSCAFile)
+		}
+	}
+
+	if f.TLSCertFile != "" || f.TLSKeyFile != "" {
+		if f.TLSCertFile == "" || f.TLSKeyFile == "" {
+			return nil, errIncompleteKeyPair
+		}
+		cert, err := tls.LoadX509KeyPair(f.TLSCertFile, f.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
+
+// A bearer token sent in the authorization header of every request. gRPC refuses to send it without TLS.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (bearerToken) RequireTransportSecurity() bool {
+	return true
+}
+
+func readToken(file string) (bearerToken, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("%w: %s", errEmptyToken, file)
+	}
+
+	return bearerToken(token), nil
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Writes a self-signed certificate and its key as PEM files in dir, returning their paths.
+func writeTestCert(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "stormscli"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+
+	return certFile, keyFile
+}
+
+func Test_transportCredentials(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir)
+	invalidFile := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("not a certificate"), 0o600))
+
+	tests := []struct {
+		name             string
+		factory          CmdFactory
+		expectedProtocol string
+		expectErr        bool
+		expectedErr      error
+	}{
+		{name: "plaintext", factory: CmdFactory{}, expectedProtocol: "insecure"},
+		{name: "TLS", factory: CmdFactory{TLSCAFile: certFile}, expectedProtocol: "tls"},
+		{
+			name:             "mTLS",
+			factory:          CmdFactory{TLSCAFile: certFile, TLSCertFile: certFile, TLSKeyFile: keyFile},
+			expectedProtocol: "tls",
+		},
+		{
+			name:             "mTLS with system roots",
+			factory:          CmdFactory{TLSCertFile: certFile, TLSKeyFile: keyFile},
+			expectedProtocol: "tls",
+		},
+		{
+			name:        "certificate without key",
+			factory:     CmdFactory{TLSCertFile: certFile},
+			expectErr:   true,
+			expectedErr: errIncompleteKeyPair,
+		},
+		{
+			name:        "invalid CA",
+			factory:     CmdFactory{TLSCAFile: invalidFile},
+			expectErr:   true,
+			expectedErr: errNoCACertificates,
+		},
+		{
+			name:      "missing CA",
+			factory:   CmdFactory{TLSCAFile: filepath.Join(dir, "missing.pem")},
+			expectErr: true,
+		},
+		{
+			name:      "invalid key pair",
+			factory:   CmdFactory{TLSCertFile: invalidFile, TLSKeyFile: keyFile},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := tt.factory.transportCredentials()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					require.ErrorIs(t, err, tt.expectedErr)
+				}
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedProtocol, creds.Info().SecurityProtocol)
+		})
+	}
+}
+
+func Test_dialOptions_Token(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0o600))
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("\n"), 0o600))
+
+	opts, err := (&CmdFactory{}).dialOptions()
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+
+	opts, err = (&CmdFactory{TokenFile: tokenFile}).dialOptions()
+	require.NoError(t, err)
+	require.Len(t, opts, 2)
+
+	token, err := readToken(tokenFile)
+	require.NoError(t, err)
+	md, err := token.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"authorization": "Bearer secret"}, md)
+	require.True(t, token.RequireTransportSecurity())
+
+	_, err = (&CmdFactory{TokenFile: emptyFile}).dialOptions()
+	require.ErrorIs(t, err, errEmptyToken)
+
+	_, err = (&CmdFactory{TokenFile: filepath.Join(dir, "missing")}).dialOptions()
+	require.Error(t, err)
+}
@@ -3,11 +3,14 @@ package utils
 import (
 	"fmt"
 	"os"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
+	"github.com/samber/lo"
 	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
 )
@@ -18,7 +21,7 @@ const (
 
 func RenderVolumes(volumes []*storms.Volume) error {
 	table := tablewriter.NewWriter(os.Stdout)
-	table.Header([]string{"ID", "Size (bytes)", "Sector size", "ACL", "Available", "VendorVolumeID", "CreatedAt"})
+	table.Header([]string{"ID", "Size (bytes)", "Sector size", "ACL", "Available", "VendorVolumeID", "Labels", "CreatedAt"})
 
 	for _, v := range volumes {
 		createdAt := "N/A"
@@ -32,6 +35,7 @@ func RenderVolumes(volumes []*storms.Volume) error {
 			strings.Join(v.Acl, ","),
 			strconv.FormatBool(v.IsAvailable),
 			v.GetVendorVolumeId(),
+			formatLabels(v.GetLabels()),
 			createdAt,
 		}); err != nil {
 			return fmt.Errorf("failed to append volume entry to table: %w", err)
@@ -47,7 +51,9 @@ func RenderVolumes(volumes []*storms.Volume) error {
 
 func RenderSnapshots(snapshots []*storms.Snapshot) error {
 	table := tablewriter.NewWriter(os.Stdout)
-	table.Header([]string{"ID", "Size (bytes)", "Sector size", "SrcVolID", "Available", "VendorSnapshotID", "CreatedAt"})
+	table.Header([]string{"ID", "Size (bytes)", "Sector size", "SrcVolID", "Available", "VendorSnapshotID", "Labels",
+		"CreatedAt",
+	})
 
 	for _, v := range snapshots {
 		createdAt := "N/A"
@@ -61,6 +67,7 @@ func RenderSnapshots(snapshots []*storms.Snapshot) error {
 			v.SourceVolumeUuid,
 			strconv.FormatBool(v.IsAvailable),
 			v.GetVendorSnapshotId(),
+			formatLabels(v.GetLabels()),
 			createdAt,
 		}); err != nil {
 			return fmt.Errorf("failed to append snapshot entry to table: %w", err)
@@ -76,14 +83,25 @@ func RenderSnapshots(snapshots []*storms.Snapshot) error {
 
 func RenderClusters(clusters []*admin.Cluster) error {
 	table := tablewriter.NewWriter(os.Stdout)
-	table.Header([]string{"ClusterID", "Vendor", "Num Volumes", "Num Snapshots"})
+	table.Header([]string{
+		"ClusterID", "Vendor", "State", "Num Volumes", "Num Snapshots", "Total", "Used", "Free", "Health",
+	})
 
 	for _, cluster := range clusters {
+		total, used, free := "-", "-", "-"
+		if c := cluster.GetCapacity(); c != nil {
+			total, used, free = formatBytes(c.GetTotalBytes()), formatBytes(c.GetUsedBytes()), formatBytes(c.GetFreeBytes())
+		}
 		if err := table.Append([]string{
 			cluster.Id,
 			cluster.Vendor,
+			formatLifecycle(cluster.GetLifecycle()),
 			strconv.FormatInt(int64(cluster.ResourceCount["volume"]), 10),   // TODO - vheng import this from somewhere..
 			strconv.FormatInt(int64(cluster.ResourceCount["snapshot"]), 10), // TODO - vheng import this from some where
+			total,
+			used,
+			free,
+			formatHealth(cluster.GetHealth()),
 		}); err != nil {
 			return fmt.Errorf("failed to append cluster entry to table: %w", err)
 		}
@@ -96,6 +114,146 @@ func RenderClusters(clusters []*admin.Cluster) error {
 	return nil
 }
 
+func RenderOperations(operations []*storms.Operation) error {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header([]string{"ID", "Method", "ResourceID", "State", "CreatedAt", "UpdatedAt"})
+
+	for _, op := range operations {
+		if err := table.Append([]string{
+			op.GetId(),
+			op.GetMethod(),
+			op.GetResourceUuid(),
+			formatOperationState(op),
+			op.GetCreatedAt().AsTime().Format(time.RFC3339),
+			op.GetUpdatedAt().AsTime().Format(time.RFC3339),
+		}); err != nil {
+			return fmt.Errorf("failed to append operation entry to table: %w", err)
+		}
+	}
+
+	if err := table.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
+
+func RenderAuditEntries(entries []*admin.AuditEntry) error {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header([]string{"Time", "Caller", "Method", "ResourceID", "ClusterID", "Code", "Duration", "OperationID"})
+
+	for _, e := range entries {
+		if err := table.Append([]string{
+			e.GetTime().AsTime().Format(time.RFC3339),
+			e.GetCaller(),
+			path.Base(e.GetMethod()),
+			e.GetResourceUuid(),
+			e.GetClusterId(),
+			e.GetCode(),
+			e.GetDuration().AsDuration().Round(time.Millisecond).String(),
+			e.GetOperationId(),
+		}); err != nil {
+			return fmt.Errorf("failed to append audit entry to table: %w", err)
+		}
+	}
+
+	if err := table.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
+
+func RenderTenantUsage(usage *storms.TenantUsage, quota *storms.TenantQuota) error {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header([]string{"Resource", "Used", "Limit"})
+
+	rows := [][]string{
+		{"Volumes", formatCount(uint64(usage.GetVolumes())), formatLimit(uint64(quota.GetMaxVolumes()), formatCount)},
+		{
+			"Snapshots", formatCount(uint64(usage.GetSnapshots())),
+			formatLimit(uint64(quota.GetMaxSnapshots()), formatCount),
+		},
+		{
+			"Provisioned", formatBytes(usage.GetProvisionedBytes()),
+			formatLimit(quota.GetMaxProvisionedBytes(), formatBytes),
+		},
+	}
+	for _, row := range rows {
+		if err := table.Append(row); err != nil {
+			return fmt.Errorf("failed to append tenant usage entry to table: %w", err)
+		}
+	}
+
+	if err := table.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
+
+func formatCount(n uint64) string {
+	return strconv.FormatUint(n, 10)
+}
+
+// Renders a quota limit with format, or "unlimited" if it is 0.
+func formatLimit(limit uint64, format func(uint64) string) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+
+	return format(limit)
+}
+
+// Renders the state of an operation, with the error if it did not succeed.
+func formatOperationState(op *storms.Operation) string {
+	state := strings.ToLower(strings.TrimPrefix(op.GetState().String(), "OPERATION_STATE_"))
+	if op.GetError() == "" {
+		return state
+	}
+
+	return fmt.Sprintf("%s (%s)", state, op.GetError())
+}
+
+// Renders the health state of a cluster, with the last error if it is not healthy.
+func formatHealth(h *admin.ClusterHealth) string {
+	state := strings.ToLower(strings.TrimPrefix(h.GetState().String(), "HEALTH_STATE_"))
+	if h.GetState() == admin.HealthState_HEALTH_STATE_UNSPECIFIED {
+		state = "unknown"
+	}
+	if h.GetLastError() == "" || h.GetState() == admin.HealthState_HEALTH_STATE_HEALTHY {
+		return state
+	}
+
+	return fmt.Sprintf("%s (%s)", state, h.GetLastError())
+}
+
+func formatLifecycle(l *admin.ClusterLifecycle) string {
+	state := strings.ToLower(strings.TrimPrefix(l.GetState().String(), "CLUSTER_STATE_"))
+	if l.GetState() != admin.ClusterState_CLUSTER_STATE_FAILED {
+		return state
+	}
+	out := fmt.Sprintf("%s after %d attempts (%s)", state, l.GetAttempts(), l.GetLastError())
+	if l.GetNextRetry() != nil {
+		out += ", retrying at " + l.GetNextRetry().AsTime().Format(time.RFC3339)
+	}
+
+	return out
+}
+
+// Renders labels as comma-separated key=value pairs, sorted by key.
+func formatLabels(labels map[string]string) string {
+	keys := lo.Keys(labels)
+	slices.Sort(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+labels[key])
+	}
+
+	return strings.Join(pairs, ",")
+}
+
 func formatBytes(b uint64) string {
 	// EiB is 2^60, and we cannot go any higher with uint64
 	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
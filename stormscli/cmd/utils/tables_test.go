@@ -2,8 +2,12 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	admin "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/admin/v1"
 	storms "gitlab.com/crusoeenergy/island/storage/storms/pkg/api/gen/go/storms/v1"
 )
 
@@ -83,3 +87,152 @@ func Test_formatSectorSize(t *testing.T) {
 		})
 	}
 }
+
+func Test_formatHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *admin.ClusterHealth
+		expect string
+	}{
+		{
+			name:   "nil",
+			input:  nil,
+			expect: "unknown",
+		},
+		{
+			name:   "healthy",
+			input:  &admin.ClusterHealth{State: admin.HealthState_HEALTH_STATE_HEALTHY, LastError: "old error"},
+			expect: "healthy",
+		},
+		{
+			name:   "unreachable",
+			input:  &admin.ClusterHealth{State: admin.HealthState_HEALTH_STATE_UNREACHABLE, LastError: "timeout"},
+			expect: "unreachable (timeout)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatHealth(tt.input)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func Test_formatLifecycle(t *testing.T) {
+	nextRetry := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		input  *admin.ClusterLifecycle
+		expect string
+	}{
+		{
+			name:   "nil",
+			input:  nil,
+			expect: "unspecified",
+		},
+		{
+			name:   "ready",
+			input:  &admin.ClusterLifecycle{State: admin.ClusterState_CLUSTER_STATE_READY, LastError: "old error"},
+			expect: "ready",
+		},
+		{
+			name: "failed",
+			input: &admin.ClusterLifecycle{
+				State:     admin.ClusterState_CLUSTER_STATE_FAILED,
+				LastError: "no such host",
+				Attempts:  3,
+				NextRetry: timestamppb.New(nextRetry),
+			},
+			expect: "failed after 3 attempts (no such host), retrying at 2025-01-02T03:04:05Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatLifecycle(tt.input)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func Test_formatOperationState(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *storms.Operation
+		expect string
+	}{
+		{
+			name:   "in progress",
+			input:  &storms.Operation{State: storms.OperationState_OPERATION_STATE_IN_PROGRESS},
+			expect: "in_progress",
+		},
+		{
+			name: "failed",
+			input: &storms.Operation{
+				State: storms.OperationState_OPERATION_STATE_FAILED,
+				Error: "volume is attached",
+			},
+			expect: "failed (volume is attached)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatOperationState(tt.input)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func Test_formatLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  map[string]string
+		expect string
+	}{
+		{
+			name:   "no labels",
+			input:  nil,
+			expect: "",
+		},
+		{
+			name:   "sorted by key",
+			input:  map[string]string{"vm": "b", "tenant": "a"},
+			expect: "tenant=a,vm=b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatLabels(tt.input)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func Test_formatLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  uint64
+		expect string
+	}{
+		{
+			name:   "unlimited",
+			input:  0,
+			expect: "unlimited",
+		},
+		{
+			name:   "limited",
+			input:  1024,
+			expect: "1.00 KiB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatLimit(tt.input, formatBytes)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}